@@ -0,0 +1,81 @@
+package benchmarks
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/stretchr/testify/require"
+)
+
+// allocationBudget is the maximum number of allocations per operation. Budgets leave about 10% of headroom
+// above measured values, so that small changes do not fail the build, but regressions do.
+type allocationBudget struct {
+	name      string
+	operation func() error
+	budget    float64
+}
+
+func allocationBudgets(wideDB, nestedDB, primitiveDB *sql.DB) []allocationBudget {
+	selectStmt := selectWithJoinsStatement()
+	insertStmt := insertStatement()
+	updateStmt := updateStatement()
+	mysqlStmt := mysqlSelectStatement()
+
+	sqlOperation := func(stmt interface{ Sql() (string, []interface{}) }) func() error {
+		return func() error {
+			stmt.Sql()
+			return nil
+		}
+	}
+
+	return []allocationBudget{
+		{"select with joins build and serialize", func() error { selectWithJoinsStatement().Sql(); return nil }, 255},
+		{"select with joins serialize", sqlOperation(selectStmt), 200},
+		{"insert serialize", sqlOperation(insertStmt), 95},
+		{"update serialize", sqlOperation(updateStmt), 30},
+		{"mysql select serialize", sqlOperation(mysqlStmt), 35},
+		{"scan wide result (100 rows x 12 columns)", func() error { return scanWide(wideDB) }, 3250},
+		{"scan nested result (10 x 10 rows)", func() error { return scanNested(nestedDB) }, 5350},
+		{"scan primitive result (100 rows)", func() error { return scanPrimitive(primitiveDB) }, 130},
+	}
+}
+
+func TestAllocationBudgets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("allocation budgets are not checked in short mode")
+	}
+
+	wideDB, nestedDB, primitiveDB := wideResultDB(), nestedResultDB(), primitiveResultDB()
+	defer wideDB.Close()
+	defer nestedDB.Close()
+	defer primitiveDB.Close()
+
+	for _, budget := range allocationBudgets(wideDB, nestedDB, primitiveDB) {
+		t.Run(budget.name, func(t *testing.T) {
+			require.NoError(t, budget.operation())
+
+			allocs := testing.AllocsPerRun(20, func() {
+				_ = budget.operation()
+			})
+
+			t.Logf("%.0f allocations, budget %.0f", allocs, budget.budget)
+			require.LessOrEqual(t, allocs, budget.budget, "allocation budget exceeded")
+		})
+	}
+}
+
+func TestScanNestedResult(t *testing.T) {
+	db := nestedResultDB()
+	defer db.Close()
+
+	var dest []actorWithFilms
+	_, err := qrm.Query(context.Background(), db, "SELECT", nil, &dest)
+	require.NoError(t, err)
+	require.Len(t, dest, 10)
+
+	for _, actor := range dest {
+		require.Len(t, actor.Films, 10)
+	}
+}
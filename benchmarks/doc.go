@@ -0,0 +1,11 @@
+/*
+Package benchmarks contains benchmarks of statement serialization and query result mapping (qrm) of
+representative statements and result sets.
+
+	go test -bench . -benchmem ./benchmarks/
+
+Each benchmarked operation has an allocation budget (see allocationBudgets in budgets_test.go), enforced by
+TestAllocationBudgets as part of the regular test run, so that allocation regressions fail the build. If the
+change is intended to allocate more, budget has to be raised in the same change, with the explanation why.
+*/
+package benchmarks
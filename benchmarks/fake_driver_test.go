@@ -0,0 +1,65 @@
+package benchmarks
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strconv"
+)
+
+// fakeDriver returns the same in-memory result set for every query, so that benchmarks measure only query
+// result mapping, and not database or network latency.
+type fakeDriver struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) { return &fakeConn{driver: d}, nil }
+
+type fakeConn struct{ driver *fakeDriver }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{driver: c.driver}, nil }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+type fakeStmt struct{ driver *fakeDriver }
+
+func (s *fakeStmt) Close() error                                    { return nil }
+func (s *fakeStmt) NumInput() int                                   { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) { return driver.RowsAffected(0), nil }
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{driver: s.driver}, nil
+}
+
+type fakeRows struct {
+	driver *fakeDriver
+	index  int
+}
+
+func (r *fakeRows) Columns() []string { return r.driver.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.driver.rows) {
+		return io.EOF
+	}
+	copy(dest, r.driver.rows[r.index])
+	r.index++
+	return nil
+}
+
+var fakeDrivers = 0
+
+// openFakeDB returns database connection returning columns and rows for every query
+func openFakeDB(columns []string, rows [][]driver.Value) *sql.DB {
+	fakeDrivers++
+	driverName := "benchmarks_fake_" + strconv.Itoa(fakeDrivers)
+	sql.Register(driverName, &fakeDriver{columns: columns, rows: rows})
+
+	db, err := sql.Open(driverName, "")
+
+	if err != nil {
+		panic(err)
+	}
+
+	return db
+}
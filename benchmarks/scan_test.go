@@ -0,0 +1,110 @@
+package benchmarks
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+var (
+	actorColumns = []string{"actor.actor_id", "actor.first_name", "actor.last_name", "actor.last_update"}
+	filmColumns  = []string{"film.film_id", "film.title", "film.description", "film.release_year", "film.language_id",
+		"film.rental_duration", "film.rental_rate", "film.length", "film.replacement_cost", "film.rating",
+		"film.last_update", "film.special_features"}
+)
+
+func actorRow(id int) []driver.Value {
+	return []driver.Value{int64(id), "John", "Doe", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func filmRow(id int) []driver.Value {
+	return []driver.Value{int64(id), "Academy Dinosaur", "A Epic Drama of a Feminist", int64(2006), int64(1),
+		int64(6), 0.99, int64(86), 20.99, "PG", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), "Deleted Scenes"}
+}
+
+// wideResultDB returns 100 film rows, each with 12 columns
+func wideResultDB() *sql.DB {
+	var rows [][]driver.Value
+
+	for i := 0; i < 100; i++ {
+		rows = append(rows, filmRow(i))
+	}
+
+	return openFakeDB(filmColumns, rows)
+}
+
+// nestedResultDB returns 10 actors, each with 10 films, joined into 100 rows
+func nestedResultDB() *sql.DB {
+	var rows [][]driver.Value
+
+	for a := 0; a < 10; a++ {
+		for f := 0; f < 10; f++ {
+			rows = append(rows, append(actorRow(a), filmRow(f)...))
+		}
+	}
+
+	return openFakeDB(append(append([]string{}, actorColumns...), filmColumns...), rows)
+}
+
+// primitiveResultDB returns 100 rows of a single integer column
+func primitiveResultDB() *sql.DB {
+	var rows [][]driver.Value
+
+	for i := 0; i < 100; i++ {
+		rows = append(rows, []driver.Value{int64(i)})
+	}
+
+	return openFakeDB([]string{"film.film_id"}, rows)
+}
+
+type actorWithFilms struct {
+	Actor
+
+	Films []Film
+}
+
+func scanWide(db *sql.DB) error {
+	var dest []Film
+	_, err := qrm.Query(context.Background(), db, "SELECT", nil, &dest)
+	return err
+}
+
+func scanNested(db *sql.DB) error {
+	var dest []actorWithFilms
+	_, err := qrm.Query(context.Background(), db, "SELECT", nil, &dest)
+	return err
+}
+
+func scanPrimitive(db *sql.DB) error {
+	var dest []int32
+	_, err := qrm.Query(context.Background(), db, "SELECT", nil, &dest)
+	return err
+}
+
+func benchmarkScan(b *testing.B, db *sql.DB, scan func(db *sql.DB) error) {
+	defer db.Close()
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if err := scan(db); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkScanWideResult(b *testing.B) {
+	benchmarkScan(b, wideResultDB(), scanWide)
+}
+
+func BenchmarkScanNestedResult(b *testing.B) {
+	benchmarkScan(b, nestedResultDB(), scanNested)
+}
+
+func BenchmarkScanPrimitiveResult(b *testing.B) {
+	benchmarkScan(b, primitiveResultDB(), scanPrimitive)
+}
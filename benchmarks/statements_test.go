@@ -0,0 +1,116 @@
+package benchmarks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-jet/jet/v2/mysql"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+func selectWithJoinsStatement() Statement {
+	return SELECT(
+		actorAllColumns,
+		filmAllColumns,
+	).FROM(
+		actor.
+			INNER_JOIN(filmActor, filmActorActorID.EQ(actorID)).
+			INNER_JOIN(film, filmID.EQ(filmActorFilmID)),
+	).WHERE(
+		actorFirstName.LIKE(String("J%")).
+			AND(filmLength.GT(Int(60))).
+			AND(filmRating.IN(String("G"), String("PG"), String("PG-13"))),
+	).ORDER_BY(
+		actorID.ASC(),
+		filmTitle.DESC(),
+	).LIMIT(100).OFFSET(20)
+}
+
+func insertStatement() Statement {
+	stmt := actor.INSERT(actorID, actorFirstName, actorLastName, actorLastUpdate)
+
+	for i := 0; i < 10; i++ {
+		stmt = stmt.MODEL(Actor{
+			ActorID:    int32(i),
+			FirstName:  "John",
+			LastName:   "Doe",
+			LastUpdate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+	}
+
+	return stmt.ON_CONFLICT(actorID).DO_UPDATE(
+		SET(actorLastUpdate.SET(LOCALTIMESTAMP())),
+	)
+}
+
+func updateStatement() Statement {
+	return film.UPDATE(filmTitle, filmRentalRate).
+		SET("New title", 2.99).
+		WHERE(filmID.EQ(Int(11)))
+}
+
+var (
+	mysqlActorID        = mysql.IntegerColumn("actor_id")
+	mysqlActorFirstName = mysql.StringColumn("first_name")
+
+	mysqlActor = mysql.NewTable("dvds", "actor", "", mysqlActorID, mysqlActorFirstName)
+)
+
+func mysqlSelectStatement() mysql.Statement {
+	return mysql.SELECT(mysqlActorID, mysqlActorFirstName).
+		FROM(mysqlActor).
+		WHERE(mysqlActorID.GT(mysql.Int(10))).
+		LIMIT(10)
+}
+
+func BenchmarkSelectWithJoinsBuildAndSerialize(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		selectWithJoinsStatement().Sql()
+	}
+}
+
+func BenchmarkSelectWithJoinsSerialize(b *testing.B) {
+	stmt := selectWithJoinsStatement()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stmt.Sql()
+	}
+}
+
+func BenchmarkInsertSerialize(b *testing.B) {
+	stmt := insertStatement()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stmt.Sql()
+	}
+}
+
+func BenchmarkUpdateSerialize(b *testing.B) {
+	stmt := updateStatement()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stmt.Sql()
+	}
+}
+
+func BenchmarkMySQLSelectSerialize(b *testing.B) {
+	stmt := mysqlSelectStatement()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		stmt.Sql()
+	}
+}
@@ -0,0 +1,70 @@
+package benchmarks
+
+import (
+	"time"
+
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var (
+	actorID         = IntegerColumn("actor_id")
+	actorFirstName  = StringColumn("first_name")
+	actorLastName   = StringColumn("last_name")
+	actorLastUpdate = TimestampColumn("last_update")
+
+	actorAllColumns = ColumnList{actorID, actorFirstName, actorLastName, actorLastUpdate}
+
+	actor = NewTable("dvds", "actor", "", actorAllColumns...)
+)
+
+var (
+	filmID              = IntegerColumn("film_id")
+	filmTitle           = StringColumn("title")
+	filmDescription     = StringColumn("description")
+	filmReleaseYear     = IntegerColumn("release_year")
+	filmLanguageID      = IntegerColumn("language_id")
+	filmRentalDuration  = IntegerColumn("rental_duration")
+	filmRentalRate      = FloatColumn("rental_rate")
+	filmLength          = IntegerColumn("length")
+	filmReplacementCost = FloatColumn("replacement_cost")
+	filmRating          = StringColumn("rating")
+	filmLastUpdate      = TimestampColumn("last_update")
+	filmSpecialFeatures = StringColumn("special_features")
+
+	filmAllColumns = ColumnList{filmID, filmTitle, filmDescription, filmReleaseYear, filmLanguageID,
+		filmRentalDuration, filmRentalRate, filmLength, filmReplacementCost, filmRating, filmLastUpdate,
+		filmSpecialFeatures}
+
+	film = NewTable("dvds", "film", "", filmAllColumns...)
+)
+
+var (
+	filmActorActorID = IntegerColumn("actor_id")
+	filmActorFilmID  = IntegerColumn("film_id")
+
+	filmActor = NewTable("dvds", "film_actor", "", filmActorActorID, filmActorFilmID)
+)
+
+// Actor is actor table model
+type Actor struct {
+	ActorID    int32 `sql:"primary_key"`
+	FirstName  string
+	LastName   string
+	LastUpdate time.Time
+}
+
+// Film is film table model
+type Film struct {
+	FilmID          int32 `sql:"primary_key"`
+	Title           string
+	Description     *string
+	ReleaseYear     *int32
+	LanguageID      int16
+	RentalDuration  int16
+	RentalRate      float64
+	Length          *int16
+	ReplacementCost float64
+	Rating          *string
+	LastUpdate      time.Time
+	SpecialFeatures *string
+}
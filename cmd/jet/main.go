@@ -38,7 +38,12 @@ var (
 	ignoreViews  string
 	ignoreEnums  string
 
+	renameColumns string
+
 	destDir string
+
+	configFile string
+	ddlPath    string
 )
 
 func init() {
@@ -58,15 +63,23 @@ func init() {
 	flag.IntVar(&port, "port", 0, "Database port. Used only if dsn is not set.")
 	flag.StringVar(&user, "user", "", "Database user. Used only if dsn is not set.")
 	flag.StringVar(&password, "password", "", "The user’s password. Used only if dsn is not set.")
-	flag.StringVar(&dbName, "dbname", "", "Database name. Used only if dsn is not set.")
+	flag.StringVar(&dbName, "dbname", "", "Database name. Used only if dsn is not set, or with ddl (postgres and mysql).")
 	flag.StringVar(&schemaName, "schema", "public", `Database schema name. Used only if dsn is not set. (default "public")(PostgreSQL only)`)
 	flag.StringVar(&params, "params", "", "Additional connection string parameters(optional). Used only if dsn is not set.")
 	flag.StringVar(&sslmode, "sslmode", "disable", `Whether or not to use SSL. Used only if dsn is not set. (optional)(default "disable")(PostgreSQL only)`)
 	flag.StringVar(&ignoreTables, "ignore-tables", "", `Comma-separated list of tables to ignore`)
 	flag.StringVar(&ignoreViews, "ignore-views", "", `Comma-separated list of views to ignore`)
 	flag.StringVar(&ignoreEnums, "ignore-enums", "", `Comma-separated list of enums to ignore`)
+	flag.StringVar(&renameColumns, "rename-columns", "", `Comma-separated list of column renames, in the form column=GoName or table.column=GoName
+		Example:
+			usr_nm=UserName,orders.ord_dt=OrderDate`)
 
 	flag.StringVar(&destDir, "path", "", "Destination dir for files generated.")
+	flag.StringVar(&ddlPath, "ddl", "", `Path to .sql file, or directory of .sql files (schema dump or migrations), with DDL statements
+		to generate files from, without database connection. Requires -source flag.`)
+
+	flag.StringVar(&configFile, "config", "", `Path to yaml configuration file (for instance jet.yaml). Flags set on the command line override
+		configuration file values.`)
 }
 
 func main() {
@@ -77,9 +90,9 @@ func main() {
 		fmt.Println("Usage:")
 
 		order := []string{
-			"source", "dsn", "host", "port", "user", "password", "dbname", "schema", "params", "sslmode",
+			"config", "source", "ddl", "dsn", "host", "port", "user", "password", "dbname", "schema", "params", "sslmode",
 			"path",
-			"ignore-tables", "ignore-views", "ignore-enums",
+			"ignore-tables", "ignore-views", "ignore-enums", "rename-columns",
 		}
 		for _, name := range order {
 			flagEntry := flag.CommandLine.Lookup(name)
@@ -94,49 +107,59 @@ func main() {
 	$ jet -source=postgres -dsn="user=jet password=jet host=localhost port=5432 dbname=jetdb" -schema=dvds -path=./gen
 	$ jet -source=mysql -host=localhost -port=3306 -user=jet -password=jet -dbname=jetdb -path=./gen
 	$ jet -source=sqlite -dsn="file://path/to/sqlite/database/file" -path=./gen
+	$ jet -config=jet.yaml -password=$DB_PASSWORD
+	$ jet -source=postgres -ddl=./migrations -dbname=jetdb -schema=public -path=./gen
 		`)
 	}
 
 	flag.Parse()
 
-	if dsn == "" && (source == "" || host == "" || port == 0 || user == "" || dbName == "") {
+	cfg := config{}
+
+	if configFile != "" {
+		var err error
+		cfg, err = loadConfig(configFile)
+
+		if err != nil {
+			printErrorAndExit("ERROR: " + err.Error())
+		}
+	}
+
+	cfg = applyFlags(cfg)
+
+	if ddlPath != "" && source == "" {
+		printErrorAndExit("ERROR: required -source flag missing.")
+	}
+
+	if ddlPath != "" && source != "sqlite" && dbName == "" {
+		printErrorAndExit("ERROR: required -dbname flag missing.")
+	}
+
+	if ddlPath == "" && dsn == "" && (source == "" || host == "" || port == 0 || user == "" || dbName == "") {
 		printErrorAndExit("ERROR: required flag(s) missing")
 	}
 
 	source := getSource()
-	ignoreTablesList := parseList(ignoreTables)
-	ignoreViewsList := parseList(ignoreViews)
-	ignoreEnumsList := parseList(ignoreEnums)
 
 	var err error
 
 	switch source {
 	case "postgresql", "postgres":
-		if dsn != "" {
-			err = postgresgen.GenerateDSN(dsn, schemaName, destDir)
-			break
-		}
-		dbConn := postgresgen.DBConnection{
-			Host:     host,
-			Port:     port,
-			User:     user,
-			Password: password,
-			SslMode:  sslmode,
-			Params:   params,
+		for _, schema := range cfg.Schemas {
+			err = generatePostgres(schema, genTemplate(postgres2.Dialect, cfg))
 
-			DBName:     dbName,
-			SchemaName: schemaName,
+			if err != nil {
+				break
+			}
 		}
 
-		err = postgresgen.Generate(
-			destDir,
-			dbConn,
-			genTemplate(postgres2.Dialect, ignoreTablesList, ignoreViewsList, ignoreEnumsList),
-		)
-
 	case "mysql", "mysqlx", "mariadb":
+		if ddlPath != "" {
+			err = mysqlgen.GenerateDDL(ddlPath, dbName, destDir, genTemplate(mysql.Dialect, cfg))
+			break
+		}
 		if dsn != "" {
-			err = mysqlgen.GenerateDSN(dsn, destDir)
+			err = mysqlgen.GenerateDSN(dsn, destDir, genTemplate(mysql.Dialect, cfg))
 			break
 		}
 		dbConn := mysqlgen.DBConnection{
@@ -151,16 +174,20 @@ func main() {
 		err = mysqlgen.Generate(
 			destDir,
 			dbConn,
-			genTemplate(mysql.Dialect, ignoreTablesList, ignoreViewsList, ignoreEnumsList),
+			genTemplate(mysql.Dialect, cfg),
 		)
 	case "sqlite":
+		if ddlPath != "" {
+			err = sqlitegen.GenerateDDL(ddlPath, destDir, genTemplate(sqlite.Dialect, cfg))
+			break
+		}
 		if dsn == "" {
 			printErrorAndExit("ERROR: required -dsn flag missing.")
 		}
 		err = sqlitegen.GenerateDSN(
 			dsn,
 			destDir,
-			genTemplate(sqlite.Dialect, ignoreTablesList, ignoreViewsList, ignoreEnumsList),
+			genTemplate(sqlite.Dialect, cfg),
 		)
 
 	case "":
@@ -176,6 +203,88 @@ func main() {
 	}
 }
 
+func generatePostgres(schema string, genTemplate template.Template) error {
+	if ddlPath != "" {
+		return postgresgen.GenerateDDL(ddlPath, dbName, schema, destDir, genTemplate)
+	}
+
+	if dsn != "" {
+		return postgresgen.GenerateDSN(dsn, schema, destDir, genTemplate)
+	}
+
+	dbConn := postgresgen.DBConnection{
+		Host:     host,
+		Port:     port,
+		User:     user,
+		Password: password,
+		SslMode:  sslmode,
+		Params:   params,
+
+		DBName:     dbName,
+		SchemaName: schema,
+	}
+
+	return postgresgen.Generate(destDir, dbConn, genTemplate)
+}
+
+// applyFlags merges configuration file values and command line flags. Flag variables not set on the command line
+// are set from configuration file, and configuration file settings are overridden with the flags set on the command
+// line.
+func applyFlags(cfg config) config {
+	setFlags := map[string]bool{}
+
+	flag.Visit(func(f *flag.Flag) {
+		setFlags[f.Name] = true
+	})
+
+	setString := func(name string, value *string, configValue string) {
+		if !setFlags[name] && configValue != "" {
+			*value = configValue
+		}
+	}
+
+	setString("source", &source, cfg.Source)
+	setString("dsn", &dsn, cfg.Connection.DSN)
+	setString("host", &host, cfg.Connection.Host)
+	setString("user", &user, cfg.Connection.User)
+	setString("password", &password, cfg.Connection.Password)
+	setString("dbname", &dbName, cfg.Connection.DBName)
+	setString("params", &params, cfg.Connection.Params)
+	setString("sslmode", &sslmode, cfg.Connection.SslMode)
+	setString("path", &destDir, cfg.Path)
+	setString("ddl", &ddlPath, cfg.DDL)
+
+	if !setFlags["port"] && cfg.Connection.Port != 0 {
+		port = cfg.Connection.Port
+	}
+
+	if setFlags["schema"] || len(cfg.Schemas) == 0 {
+		cfg.Schemas = []string{schemaName}
+	}
+
+	if setFlags["ignore-tables"] {
+		cfg.Tables.Exclude = parseList(ignoreTables)
+	}
+
+	if setFlags["ignore-views"] {
+		cfg.Views.Exclude = parseList(ignoreViews)
+	}
+
+	if setFlags["ignore-enums"] {
+		cfg.Enums.Exclude = parseList(ignoreEnums)
+	}
+
+	if setFlags["rename-columns"] {
+		cfg.RenameColumns = parseRenames(renameColumns)
+	}
+
+	if err := validatePatterns(cfg.Tables, cfg.Views, cfg.Enums); err != nil {
+		printErrorAndExit("ERROR: " + err.Error())
+	}
+
+	return cfg
+}
+
 func printErrorAndExit(error string) {
 	fmt.Println("\n", error)
 	fmt.Println()
@@ -216,18 +325,51 @@ func parseList(list string) []string {
 	return ret
 }
 
-func genTemplate(dialect jet.Dialect, ignoreTables []string, ignoreViews []string, ignoreEnums []string) template.Template {
+// parseRenames parses comma separated list of column renames, in the form column=GoName or table.column=GoName
+func parseRenames(list string) map[string]string {
+	ret := map[string]string{}
+
+	for _, rename := range strings.Split(list, ",") {
+		parts := strings.SplitN(rename, "=", 2)
+
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			if strings.TrimSpace(rename) != "" {
+				printErrorAndExit("ERROR: invalid column rename '" + rename + "', expected column=GoName")
+			}
+			continue
+		}
 
-	shouldSkipTable := func(table metadata.Table) bool {
-		return utils.StringSliceContains(ignoreTables, strings.ToLower(table.Name))
+		ret[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
 	}
 
-	shouldSkipView := func(view metadata.Table) bool {
-		return utils.StringSliceContains(ignoreViews, strings.ToLower(view.Name))
+	return ret
+}
+
+func genTemplate(dialect jet.Dialect, cfg config) template.Template {
+
+	tableModel := func(table metadata.Table) template.TableModel {
+		return template.DefaultTableModel(table).
+			UseFileName(utils.ToGoFileName(cfg.Naming.trimName(table.Name))).
+			UseField(func(column metadata.Column) template.TableModelField {
+				field := template.DefaultTableModelField(column)
+
+				if fieldType, ok := cfg.fieldType(table, column); ok {
+					field = field.UseType(fieldType)
+				}
+
+				return field
+			}).
+			UseFileTemplate(cfg.Templates.TableModel)
 	}
 
-	shouldSkipEnum := func(enum metadata.Enum) bool {
-		return utils.StringSliceContains(ignoreEnums, strings.ToLower(enum.Name))
+	tableSQLBuilder := func(table metadata.Table, sqlBuilder template.TableSQLBuilder) template.TableSQLBuilder {
+		name := cfg.Naming.trimName(table.Name)
+
+		return sqlBuilder.
+			UseFileName(utils.ToGoFileName(name)).
+			UseInstanceName(utils.ToGoIdentifier(name)).
+			UseTypeName(utils.ToGoIdentifier(name) + "Table").
+			UseFileTemplate(cfg.Templates.TableSQLBuilder)
 	}
 
 	return template.Default(dialect).
@@ -235,43 +377,51 @@ func genTemplate(dialect jet.Dialect, ignoreTables []string, ignoreViews []strin
 			return template.DefaultSchema(schemaMetaData).
 				UseModel(template.DefaultModel().
 					UseTable(func(table metadata.Table) template.TableModel {
-						if shouldSkipTable(table) {
+						if cfg.Tables.skip(table.Name) {
 							return template.TableModel{Skip: true}
 						}
-						return template.DefaultTableModel(table)
+						return tableModel(table)
 					}).
 					UseView(func(view metadata.Table) template.ViewModel {
-						if shouldSkipView(view) {
+						if cfg.Views.skip(view.Name) {
 							return template.ViewModel{Skip: true}
 						}
-						return template.DefaultViewModel(view)
+						return tableModel(view)
 					}).
 					UseEnum(func(enum metadata.Enum) template.EnumModel {
-						if shouldSkipEnum(enum) {
+						if cfg.Enums.skip(enum.Name) {
 							return template.EnumModel{Skip: true}
 						}
-						return template.DefaultEnumModel(enum)
+						return template.DefaultEnumModel(enum).UseFileTemplate(cfg.Templates.EnumModel)
 					}),
 				).
 				UseSQLBuilder(template.DefaultSQLBuilder().
 					UseTable(func(table metadata.Table) template.TableSQLBuilder {
-						if shouldSkipTable(table) {
+						if cfg.Tables.skip(table.Name) {
 							return template.TableSQLBuilder{Skip: true}
 						}
-						return template.DefaultTableSQLBuilder(table)
+						return tableSQLBuilder(table, template.DefaultTableSQLBuilder(table))
 					}).
-					UseView(func(table metadata.Table) template.ViewSQLBuilder {
-						if shouldSkipView(table) {
+					UseView(func(view metadata.Table) template.ViewSQLBuilder {
+						if cfg.Views.skip(view.Name) {
 							return template.ViewSQLBuilder{Skip: true}
 						}
-						return template.DefaultViewSQLBuilder(table)
+						return tableSQLBuilder(view, template.DefaultViewSQLBuilder(view))
 					}).
 					UseEnum(func(enum metadata.Enum) template.EnumSQLBuilder {
-						if shouldSkipEnum(enum) {
+						if cfg.Enums.skip(enum.Name) {
 							return template.EnumSQLBuilder{Skip: true}
 						}
-						return template.DefaultEnumSQLBuilder(enum)
+						return template.DefaultEnumSQLBuilder(enum).UseFileTemplate(cfg.Templates.EnumSQLBuilder)
 					}),
-				)
+				).
+				UseColumnRenames(cfg.RenameColumns).
+				UseModelFieldHook(func(table metadata.Table, column metadata.Column, field template.TableModelField) template.TableModelField {
+					for _, tag := range cfg.Models.Tags {
+						field = field.UseTags(tag + `:"` + column.Name + `"`)
+					}
+
+					return field.UseSkip(cfg.Models.skipColumn(table, column))
+				})
 		})
 }
@@ -0,0 +1,156 @@
+// Package checker validates jet statements against database schema snapshot, without a live database connection.
+// It can be used as a CI gate to detect statements referencing tables or columns that no longer exist in the
+// database schema, or columns whose type has changed since the sql builder files were generated.
+package checker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/generator/template"
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// Checker validates registered statements against list of schema snapshots
+type Checker struct {
+	dialect    jet.Dialect
+	schemas    []metadata.Schema
+	statements []namedStatement
+}
+
+type namedStatement struct {
+	name      string
+	statement jet.Statement
+}
+
+// New creates new Checker for dialect and list of schema snapshots
+func New(dialect jet.Dialect, schemas ...metadata.Schema) *Checker {
+	return &Checker{
+		dialect: dialect,
+		schemas: schemas,
+	}
+}
+
+// Register adds named statement to the list of statements to check
+func (c *Checker) Register(name string, statement jet.Statement) *Checker {
+	c.statements = append(c.statements, namedStatement{name: name, statement: statement})
+	return c
+}
+
+// Check validates all registered statements and returns an error describing every problem found,
+// or nil if all the statements are valid.
+func (c *Checker) Check() error {
+	var problems []string
+
+	for _, s := range c.statements {
+		for _, problem := range c.check(s.statement) {
+			problems = append(problems, fmt.Sprintf("statement '%s': %s", s.name, problem))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return errors.New("jet: " + strings.Join(problems, "\n"))
+}
+
+// CheckStatement validates statement and returns an error describing every problem found,
+// or nil if the statement is valid.
+func (c *Checker) CheckStatement(statement jet.Statement) error {
+	problems := c.check(statement)
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return errors.New("jet: " + strings.Join(problems, "\n"))
+}
+
+func (c *Checker) check(statement jet.Statement) []string {
+	var problems []string
+
+	references := jet.StatementReferences(c.dialect, statement)
+	tables := map[string]*metadata.Table{} // table name or alias used in query -> table metadata
+
+	for _, tableRef := range references.Tables {
+		table := c.findTable(tableRef.SchemaName, tableRef.TableName)
+
+		if table == nil {
+			problems = append(problems, fmt.Sprintf("table %s does not exist", fullTableName(tableRef)))
+			continue
+		}
+
+		if tableRef.Alias != "" {
+			tables[tableRef.Alias] = table
+		} else {
+			tables[tableRef.TableName] = table
+		}
+	}
+
+	for _, columnRef := range references.Columns {
+		table, ok := tables[columnRef.TableName]
+
+		if !ok {
+			continue // sub-query, common table expression or already reported missing table
+		}
+
+		column := findColumn(table, columnRef.ColumnName)
+
+		if column == nil {
+			problems = append(problems, fmt.Sprintf("column %s.%s does not exist", columnRef.TableName, columnRef.ColumnName))
+			continue
+		}
+
+		if columnRef.Type == "" {
+			continue
+		}
+
+		if columnType := template.SQLBuilderColumnType(*column); columnType != columnRef.Type {
+			problems = append(problems, fmt.Sprintf("column %s.%s has type %s (%s), but it is used as %s column",
+				columnRef.TableName, columnRef.ColumnName, columnType, column.DataType.Name, columnRef.Type))
+		}
+	}
+
+	return problems
+}
+
+func (c *Checker) findTable(schemaName, tableName string) *metadata.Table {
+	for i := range c.schemas {
+		schema := &c.schemas[i]
+
+		if schemaName != "" && schema.Name != "" && schema.Name != schemaName {
+			continue
+		}
+
+		for _, tables := range [][]metadata.Table{schema.TablesMetaData, schema.ViewsMetaData} {
+			for j := range tables {
+				if tables[j].Name == tableName {
+					return &tables[j]
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func findColumn(table *metadata.Table, columnName string) *metadata.Column {
+	for i := range table.Columns {
+		if table.Columns[i].Name == columnName {
+			return &table.Columns[i]
+		}
+	}
+
+	return nil
+}
+
+func fullTableName(table jet.TableReference) string {
+	if table.SchemaName != "" {
+		return table.SchemaName + "." + table.TableName
+	}
+
+	return table.TableName
+}
@@ -0,0 +1,65 @@
+package checker
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	actorID        = IntegerColumn("actor_id")
+	actorFirstName = StringColumn("first_name")
+	actorUpdate    = TimestampColumn("last_update")
+	actor          = NewTable("dvds", "actor", "", actorID, actorFirstName, actorUpdate)
+
+	filmActorID = IntegerColumn("actor_id")
+	filmID      = IntegerColumn("film_id")
+	filmActor   = NewTable("dvds", "film_actor", "", filmActorID, filmID)
+)
+
+var dvdsSchema = metadata.Schema{
+	Name: "dvds",
+	TablesMetaData: []metadata.Table{
+		{
+			Name: "actor",
+			Columns: []metadata.Column{
+				{Name: "actor_id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				{Name: "first_name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+				{Name: "last_update", DataType: metadata.DataType{Name: "date", Kind: metadata.BaseType}},
+			},
+		},
+	},
+}
+
+func TestChecker_Valid(t *testing.T) {
+	err := New(Dialect, dvdsSchema).
+		Register("select", SELECT(actorID, actorFirstName).FROM(actor).WHERE(actorID.EQ(Int(1)))).
+		Register("update", actor.UPDATE(actorFirstName).SET(String("John")).WHERE(actorID.EQ(Int(1)))).
+		Register("insert", actor.INSERT(actorID, actorFirstName).VALUES(1, "John")).
+		Check()
+
+	require.NoError(t, err)
+}
+
+func TestChecker_MissingTable(t *testing.T) {
+	err := New(Dialect, dvdsSchema).
+		Register("join", SELECT(actorID, filmID).FROM(actor.INNER_JOIN(filmActor, filmActorID.EQ(actorID)))).
+		Check()
+
+	require.EqualError(t, err, "jet: statement 'join': table dvds.film_actor does not exist")
+}
+
+func TestChecker_MissingColumnAndTypeMismatch(t *testing.T) {
+	lastName := StringColumn("last_name")
+	lastUpdate := TimestampColumn("last_update")
+	actorAlias := NewTable("dvds", "actor", "a", lastName, lastUpdate)
+
+	err := New(Dialect, dvdsSchema).CheckStatement(
+		SELECT(lastName, lastUpdate).FROM(actorAlias),
+	)
+
+	require.EqualError(t, err, "jet: column a.last_name does not exist\n"+
+		"column a.last_update has type Date (date), but it is used as Timestamp column")
+}
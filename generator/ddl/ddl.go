@@ -0,0 +1,401 @@
+// Package ddl reads database schema metadata from DDL statements (schema dumps or migration files), so jet files can
+// be generated without a live database connection.
+package ddl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+)
+
+// Parse parses DDL statements and returns metadata of the tables and enums of schemaName created by the statements.
+// CREATE TABLE, ALTER TABLE and DROP TABLE statements are applied in order, as well as PostgreSQL enum types
+// (CREATE TYPE ... AS ENUM, ALTER TYPE) and domains. Unqualified table and type names are assumed to be in the
+// schemaName schema, and if schemaName is empty all the tables are returned. Other statements are ignored. Views
+// and check constraints are not read from DDL, because their columns can not be determined without a database.
+func Parse(ddl string, dialect Dialect, schemaName string) (metadata.Schema, error) {
+	builder := newSchemaBuilder(dialect, schemaName)
+
+	if err := builder.parse(ddl); err != nil {
+		return metadata.Schema{}, err
+	}
+
+	return builder.build(), nil
+}
+
+// ParseFiles parses DDL statements of the .sql file at ddlPath, or of all the .sql files in the ddlPath directory,
+// in file name order (the usual order of migration files). Migration rollback files (*.down.sql) are skipped.
+func ParseFiles(ddlPath string, dialect Dialect, schemaName string) (metadata.Schema, error) {
+	files, err := ddlFiles(ddlPath)
+
+	if err != nil {
+		return metadata.Schema{}, err
+	}
+
+	builder := newSchemaBuilder(dialect, schemaName)
+
+	for _, file := range files {
+		ddl, err := ioutil.ReadFile(file)
+
+		if err != nil {
+			return metadata.Schema{}, err
+		}
+
+		if err := builder.parse(string(ddl)); err != nil {
+			return metadata.Schema{}, fmt.Errorf("%w, in file %s", err, file)
+		}
+	}
+
+	return builder.build(), nil
+}
+
+func ddlFiles(ddlPath string) ([]string, error) {
+	info, err := os.Stat(ddlPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{ddlPath}, nil
+	}
+
+	entries, err := ioutil.ReadDir(ddlPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+
+	for _, entry := range entries {
+		name := strings.ToLower(entry.Name())
+
+		if entry.IsDir() || !strings.HasSuffix(name, ".sql") || strings.HasSuffix(name, ".down.sql") {
+			continue
+		}
+
+		files = append(files, filepath.Join(ddlPath, entry.Name()))
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+type column struct {
+	metadata.Column
+
+	columnType columnType
+}
+
+type table struct {
+	name        string
+	columns     []*column
+	foreignKeys []*metadata.ForeignKey
+}
+
+func (t *table) column(name string) *column {
+	for _, column := range t.columns {
+		if column.Name == name {
+			return column
+		}
+	}
+
+	return nil
+}
+
+func (t *table) replaceColumn(name string, newColumn *column) {
+	for i, column := range t.columns {
+		if column.Name == name {
+			t.columns[i] = newColumn
+			return
+		}
+	}
+}
+
+func (t *table) dropColumn(name string) {
+	for i, column := range t.columns {
+		if column.Name == name {
+			t.columns = append(t.columns[:i], t.columns[i+1:]...)
+			break
+		}
+	}
+
+	// foreign keys of the dropped column are dropped as well
+	var foreignKeys []*metadata.ForeignKey
+
+	for _, foreignKey := range t.foreignKeys {
+		if !containsString(foreignKey.Columns, name) {
+			foreignKeys = append(foreignKeys, foreignKey)
+		}
+	}
+
+	t.foreignKeys = foreignKeys
+}
+
+func (t *table) dropForeignKey(name string) {
+	for i, foreignKey := range t.foreignKeys {
+		if foreignKey.Name == name {
+			t.foreignKeys = append(t.foreignKeys[:i], t.foreignKeys[i+1:]...)
+			return
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// schemaBuilder accumulates schema objects, as DDL statements are applied one after another
+type schemaBuilder struct {
+	dialect    Dialect
+	schemaName string
+	source     []rune
+
+	tables  []*table
+	enums   []*metadata.Enum
+	domains map[string]columnType
+}
+
+func newSchemaBuilder(dialect Dialect, schemaName string) *schemaBuilder {
+	return &schemaBuilder{
+		dialect:    dialect,
+		schemaName: schemaName,
+		domains:    map[string]columnType{},
+	}
+}
+
+func (b *schemaBuilder) parse(ddl string) error {
+	tokens, err := tokenize(ddl, b.dialect)
+
+	if err != nil {
+		return err
+	}
+
+	b.source = []rune(ddl)
+
+	for _, statement := range splitStatements(tokens) {
+		p := &parser{tokens: statement, builder: b}
+
+		if err := p.statement(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inSchema returns true if object qualified with schema belongs to generated schema
+func (b *schemaBuilder) inSchema(schema string) bool {
+	return schema == "" || b.schemaName == "" || schema == b.schemaName
+}
+
+func (b *schemaBuilder) table(name string) *table {
+	for _, table := range b.tables {
+		if table.name == name {
+			return table
+		}
+	}
+
+	return nil
+}
+
+func (b *schemaBuilder) setTable(newTable *table) {
+	for i, table := range b.tables {
+		if table.name == newTable.name {
+			b.tables[i] = newTable
+			return
+		}
+	}
+
+	b.tables = append(b.tables, newTable)
+}
+
+func (b *schemaBuilder) dropTable(name string) {
+	for i, table := range b.tables {
+		if table.name == name {
+			b.tables = append(b.tables[:i], b.tables[i+1:]...)
+			return
+		}
+	}
+}
+
+func (b *schemaBuilder) enum(name string) *metadata.Enum {
+	for _, enum := range b.enums {
+		if enum.Name == name {
+			return enum
+		}
+	}
+
+	return nil
+}
+
+func (b *schemaBuilder) dropEnum(name string) {
+	for i, enum := range b.enums {
+		if enum.Name == name {
+			b.enums = append(b.enums[:i], b.enums[i+1:]...)
+			return
+		}
+	}
+}
+
+// renameType changes type of the columns of renamed enum or domain type
+func (b *schemaBuilder) renameType(oldName, newName string) {
+	for _, t := range b.tables {
+		for _, c := range t.columns {
+			if c.columnType.name() == oldName {
+				c.columnType.words = []string{newName}
+			}
+		}
+	}
+}
+
+// renameTableReferences changes referenced table of foreign keys referencing renamed table
+func (b *schemaBuilder) renameTableReferences(oldName, newName string) {
+	for _, t := range b.tables {
+		for _, foreignKey := range t.foreignKeys {
+			if foreignKey.ReferencedTable == oldName {
+				foreignKey.ReferencedTable = newName
+			}
+		}
+	}
+}
+
+// renameColumnReferences changes foreign key columns and referenced columns of renamed table column
+func (b *schemaBuilder) renameColumnReferences(renamedTable *table, oldName, newName string) {
+	rename := func(columns []string) {
+		for i := range columns {
+			if columns[i] == oldName {
+				columns[i] = newName
+			}
+		}
+	}
+
+	for _, foreignKey := range renamedTable.foreignKeys {
+		rename(foreignKey.Columns)
+	}
+
+	for _, t := range b.tables {
+		for _, foreignKey := range t.foreignKeys {
+			if foreignKey.ReferencedTable == renamedTable.name {
+				rename(foreignKey.ReferencedColumns)
+			}
+		}
+	}
+}
+
+// foreignKeys returns foreign keys of the table referencing existing tables. Omitted referenced columns are resolved
+// to referenced table primary key columns.
+func (b *schemaBuilder) foreignKeys(t *table) []metadata.ForeignKey {
+	var ret []metadata.ForeignKey
+
+	for _, foreignKey := range t.foreignKeys {
+		refTable := b.table(foreignKey.ReferencedTable)
+
+		if refTable == nil {
+			continue
+		}
+
+		resolved := *foreignKey
+		resolved.Columns = append([]string{}, foreignKey.Columns...)
+		resolved.ReferencedColumns = append([]string{}, foreignKey.ReferencedColumns...)
+
+		if len(resolved.ReferencedColumns) == 0 {
+			var refColumns []metadata.Column
+
+			for _, column := range refTable.columns {
+				refColumns = append(refColumns, column.Column)
+			}
+
+			for _, column := range (metadata.Table{Columns: refColumns}).PrimaryKeyColumns() {
+				resolved.ReferencedColumns = append(resolved.ReferencedColumns, column.Name)
+			}
+		}
+
+		ret = append(ret, resolved)
+	}
+
+	return ret
+}
+
+func (b *schemaBuilder) build() metadata.Schema {
+	schema := metadata.Schema{Name: b.schemaName}
+
+	for _, enum := range b.enums {
+		schema.EnumsMetaData = append(schema.EnumsMetaData, *enum)
+	}
+
+	for _, table := range b.tables {
+		tableMetaData := metadata.Table{Name: table.name}
+
+		for _, column := range table.columns {
+			columnMetaData := column.Column
+			dataType, enum := b.dialect.dataType(b, table.name, column)
+			columnMetaData.DataType = dataType
+
+			if enum != nil {
+				schema.EnumsMetaData = append(schema.EnumsMetaData, *enum)
+			}
+
+			tableMetaData.Columns = append(tableMetaData.Columns, columnMetaData)
+		}
+
+		tableMetaData.ForeignKeys = b.foreignKeys(table)
+
+		schema.TablesMetaData = append(schema.TablesMetaData, tableMetaData)
+	}
+
+	return schema
+}
+
+// splitStatements splits tokens into statements separated with semicolon. Semicolons inside trigger bodies
+// (BEGIN ... END) do not end a statement.
+func splitStatements(tokens []token) [][]token {
+	var statements [][]token
+
+	start, depth, trigger := 0, 0, false
+
+	for i, t := range tokens {
+		if i == start {
+			trigger = false
+		}
+
+		if t.is("TRIGGER") && tokens[start].is("CREATE") {
+			trigger = true
+		}
+
+		if trigger {
+			switch {
+			case t.is("BEGIN"), t.is("CASE") && depth > 0:
+				depth++
+			case t.is("END") && depth > 0:
+				depth--
+			}
+		}
+
+		if t.isSymbol(";") && depth == 0 {
+			if i > start {
+				statements = append(statements, tokens[start:i])
+			}
+			start = i + 1
+		}
+	}
+
+	if start < len(tokens) {
+		statements = append(statements, tokens[start:])
+	}
+
+	return statements
+}
@@ -0,0 +1,258 @@
+package ddl
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/stretchr/testify/require"
+)
+
+func baseType(name string) metadata.DataType {
+	return metadata.DataType{Name: name, Kind: metadata.BaseType}
+}
+
+func TestParsePostgreSQL(t *testing.T) {
+	schema, err := Parse(`
+-- pg_dump style schema
+CREATE TYPE public.mpaa_rating AS ENUM ('G', 'PG', 'R');
+CREATE DOMAIN public.year AS integer CONSTRAINT year_check CHECK (VALUE >= 1901);
+CREATE TYPE other.ignored AS ENUM ('a');
+
+CREATE TABLE public.film (
+    film_id serial,
+    Title character varying(255) NOT NULL,
+    "Description" text,
+    release_year public.year,
+    rating public.mpaa_rating DEFAULT 'G'::public.mpaa_rating,
+    price numeric(4,2) DEFAULT 4.99 NOT NULL CHECK (price > 0),
+    tags text[],
+    ratings mpaa_rating ARRAY,
+    updated_at timestamp(6) with time zone DEFAULT now() NOT NULL,
+    location citext,
+    CONSTRAINT film_title_key UNIQUE (title)
+);
+
+CREATE TABLE other.film (id int);
+CREATE TABLE tmp (id int);
+
+ALTER TABLE ONLY public.film ADD CONSTRAINT film_pkey PRIMARY KEY (film_id);
+ALTER TABLE public.film OWNER TO postgres;
+ALTER TABLE film ADD COLUMN length int2, DROP COLUMN location, ALTER COLUMN tags SET NOT NULL;
+ALTER TYPE mpaa_rating ADD VALUE 'PG-13' AFTER 'PG';
+DROP TABLE IF EXISTS tmp CASCADE;
+
+CREATE FUNCTION last_updated() RETURNS trigger AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END $$ LANGUAGE plpgsql;
+`, PostgreSQL, "public")
+
+	require.NoError(t, err)
+	require.Equal(t, metadata.Schema{
+		Name: "public",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "film",
+				Columns: []metadata.Column{
+					{Name: "film_id", IsPrimaryKey: true, PrimaryKeyOrdinal: 1, IsAutoIncrement: true, DataType: baseType("integer"), DefaultExpression: "nextval('film_film_id_seq'::regclass)"},
+					{Name: "title", DataType: baseType("character varying")},
+					{Name: "Description", IsNullable: true, DataType: baseType("text")},
+					{Name: "release_year", IsNullable: true, DataType: baseType("integer")},
+					{Name: "rating", IsNullable: true, DataType: metadata.DataType{Name: "mpaa_rating", Kind: metadata.EnumType}, DefaultExpression: "'G'::public.mpaa_rating"},
+					{Name: "price", DataType: baseType("numeric"), DefaultExpression: "4.99"},
+					{Name: "tags", DataType: metadata.DataType{Name: "text", Kind: metadata.ArrayType}},
+					{Name: "ratings", IsNullable: true, DataType: metadata.DataType{Name: "mpaa_rating", Kind: metadata.ArrayType}},
+					{Name: "updated_at", DataType: baseType("timestamp with time zone"), DefaultExpression: "now()"},
+					{Name: "length", IsNullable: true, DataType: baseType("smallint")},
+				},
+			},
+		},
+		EnumsMetaData: []metadata.Enum{
+			{Name: "mpaa_rating", Values: []string{"G", "PG", "PG-13", "R"}},
+		},
+	}, schema)
+}
+
+func TestParseMySQL(t *testing.T) {
+	schema, err := Parse(""+
+		"/*!40101 SET NAMES utf8 */;\n"+
+		"CREATE TABLE IF NOT EXISTS `customer` (\n"+
+		"  `customer_id` smallint(5) unsigned NOT NULL AUTO_INCREMENT,\n"+
+		"  `name` varchar(45) CHARACTER SET utf8mb4 NOT NULL DEFAULT 'it\\'s',\n"+
+		"  `active` tinyint(1) NOT NULL DEFAULT '1', # boolean\n"+
+		"  `size` enum('small','medium','large') DEFAULT NULL,\n"+
+		"  `balance` DECIMAL(10,2),\n"+
+		"  `last_update` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,\n"+
+		"  PRIMARY KEY (`customer_id`),\n"+
+		"  KEY `idx_name` (`name`)\n"+
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;\n"+
+		"ALTER TABLE customer MODIFY name varchar(100) NULL, CHANGE COLUMN balance amount double AFTER name;\n",
+		MySQL, "dvds")
+
+	require.NoError(t, err)
+	require.Equal(t, metadata.Schema{
+		Name: "dvds",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "customer",
+				Columns: []metadata.Column{
+					{Name: "customer_id", IsPrimaryKey: true, PrimaryKeyOrdinal: 1, IsAutoIncrement: true, DataType: metadata.DataType{Name: "smallint", Kind: metadata.BaseType, IsUnsigned: true}},
+					{Name: "name", IsNullable: true, DataType: baseType("varchar")},
+					{Name: "active", DataType: baseType("boolean"), DefaultExpression: "'1'"},
+					{Name: "size", IsNullable: true, DataType: metadata.DataType{Name: "customer_size", Kind: metadata.EnumType}, DefaultExpression: "NULL"},
+					{Name: "amount", IsNullable: true, DataType: baseType("double")},
+					{Name: "last_update", DataType: baseType("timestamp"), DefaultExpression: "CURRENT_TIMESTAMP"},
+				},
+			},
+		},
+		EnumsMetaData: []metadata.Enum{
+			{Name: "customer_size", Values: []string{"small", "medium", "large"}},
+		},
+	}, schema)
+}
+
+func TestParseSQLite(t *testing.T) {
+	schema, err := Parse(`
+CREATE TABLE [link] (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	url VARCHAR (255) NOT NULL UNIQUE,
+	"Name" unsigned big int,
+	data
+) WITHOUT ROWID;
+
+CREATE TRIGGER link_update AFTER UPDATE ON link
+BEGIN
+	UPDATE link SET url = CASE WHEN new.url = '' THEN NULL ELSE new.url END WHERE id = new.id;
+END;
+
+ALTER TABLE link RENAME COLUMN data TO payload;
+ALTER TABLE link RENAME TO links;
+`, SQLite, "")
+
+	require.NoError(t, err)
+	require.Equal(t, metadata.Schema{
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "links",
+				Columns: []metadata.Column{
+					{Name: "id", IsPrimaryKey: true, PrimaryKeyOrdinal: 1, IsAutoIncrement: true, IsNullable: true, DataType: baseType("INTEGER")},
+					{Name: "url", DataType: baseType("VARCHAR")},
+					{Name: "Name", IsNullable: true, DataType: baseType("unsigned big int")},
+					{Name: "payload", IsNullable: true, DataType: baseType("")},
+				},
+			},
+		},
+	}, schema)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse("CREATE TABLE t (id int, 'name' text)", PostgreSQL, "public")
+	require.EqualError(t, err, "ddl: syntax error at or near ''name''")
+
+	_, err = Parse("CREATE TABLE t (id int, ", PostgreSQL, "public")
+	require.EqualError(t, err, "ddl: unexpected end of statement")
+
+	_, err = Parse("CREATE TABLE t (name text DEFAULT 'abc)", PostgreSQL, "public")
+	require.EqualError(t, err, "ddl: unterminated quoted string or identifier")
+
+	_, err = Parse("/* comment", PostgreSQL, "public")
+	require.EqualError(t, err, "ddl: unterminated comment")
+}
+
+func TestParseFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ddl")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"001_create.up.sql":   "CREATE TABLE account (id bigint PRIMARY KEY, email text);",
+		"001_create.down.sql": "DROP TABLE account;",
+		"002_alter.up.sql":    "ALTER TABLE account ADD COLUMN created_at date NOT NULL;",
+		"README.md":           "not sql",
+	}
+
+	for name, content := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+
+	schema, err := ParseFiles(dir, PostgreSQL, "public")
+	require.NoError(t, err)
+	require.Equal(t, []metadata.Table{
+		{
+			Name: "account",
+			Columns: []metadata.Column{
+				{Name: "id", IsPrimaryKey: true, PrimaryKeyOrdinal: 1, DataType: baseType("bigint")},
+				{Name: "email", IsNullable: true, DataType: baseType("text")},
+				{Name: "created_at", DataType: baseType("date")},
+			},
+		},
+	}, schema.TablesMetaData)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "003_invalid.sql"), []byte("CREATE TABLE t (id"), 0644))
+
+	_, err = ParseFiles(dir, PostgreSQL, "public")
+	require.EqualError(t, err, "ddl: unexpected end of statement, in file "+filepath.Join(dir, "003_invalid.sql"))
+}
+
+func TestParseForeignKeys(t *testing.T) {
+	schema, err := Parse(`
+CREATE TABLE customer (id int PRIMARY KEY, name text);
+CREATE TABLE account (id int, region int, PRIMARY KEY (id, region));
+CREATE TABLE orders (
+	id int PRIMARY KEY,
+	customer_id int REFERENCES customer ON DELETE SET NULL,
+	created_by int CONSTRAINT orders_creator_fk REFERENCES customer (id) MATCH FULL,
+	parent_id int,
+	account_id int,
+	account_region int,
+	other_id int REFERENCES other.customer (id),
+	FOREIGN KEY (account_id, account_region) REFERENCES account (id, region) ON UPDATE CASCADE
+);
+
+ALTER TABLE orders ADD CONSTRAINT orders_parent_fk FOREIGN KEY (parent_id) REFERENCES orders (id);
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_creator_fk;
+ALTER TABLE orders RENAME COLUMN parent_id TO parent_order_id;
+ALTER TABLE customer RENAME TO client;
+`, PostgreSQL, "public")
+
+	require.NoError(t, err)
+	require.Equal(t, []metadata.ForeignKey{
+		{Name: "orders_customer_id_fkey", Columns: []string{"customer_id"}, ReferencedTable: "client", ReferencedColumns: []string{"id"}},
+		{Name: "orders_account_id_account_region_fkey", Columns: []string{"account_id", "account_region"}, ReferencedTable: "account", ReferencedColumns: []string{"id", "region"}},
+		{Name: "orders_parent_fk", Columns: []string{"parent_order_id"}, ReferencedTable: "orders", ReferencedColumns: []string{"id"}},
+	}, schema.TablesMetaData[2].ForeignKeys)
+
+	schema, err = Parse("CREATE TABLE `customer` (`id` int PRIMARY KEY);\n"+
+		"CREATE TABLE `orders` (`id` int, `customer_id` int, CONSTRAINT FOREIGN KEY `idx_customer` (`customer_id`) REFERENCES `customer` (`id`));\n"+
+		"ALTER TABLE `orders` ADD FOREIGN KEY (`id`) REFERENCES `customer` (`id`);\n"+
+		"ALTER TABLE `orders` DROP FOREIGN KEY `orders_ibfk_2`;\n",
+		MySQL, "dvds")
+
+	require.NoError(t, err)
+	require.Equal(t, []metadata.ForeignKey{
+		{Name: "orders_ibfk_1", Columns: []string{"customer_id"}, ReferencedTable: "customer", ReferencedColumns: []string{"id"}},
+	}, schema.TablesMetaData[1].ForeignKeys)
+}
+
+func TestParsePrimaryKeyOrder(t *testing.T) {
+	schema, err := Parse(`
+CREATE TABLE account (id int, region int, PRIMARY KEY (region, id));
+CREATE TABLE orders (id int PRIMARY KEY, account_region int, account_id int,
+	FOREIGN KEY (account_region, account_id) REFERENCES account);
+`, PostgreSQL, "public")
+
+	require.NoError(t, err)
+
+	var primaryKey []string
+
+	for _, column := range schema.TablesMetaData[0].PrimaryKeyColumns() {
+		primaryKey = append(primaryKey, column.Name)
+	}
+
+	require.Equal(t, []string{"region", "id"}, primaryKey)
+	require.Equal(t, []string{"region", "id"}, schema.TablesMetaData[1].ForeignKeys[0].ReferencedColumns)
+}
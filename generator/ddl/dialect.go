@@ -0,0 +1,233 @@
+package ddl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+)
+
+// Dialect contains dialect specific DDL parsing rules: quoting, identifier case folding and column type names
+type Dialect struct {
+	name string
+
+	lowerCaseIdentifiers bool // unquoted identifiers are folded to lower case
+	backslashEscapes     bool // backslash escapes quote characters in strings
+	hashComments         bool
+	backtickIdentifiers  bool
+	bracketIdentifiers   bool
+	notNullPrimaryKeys   bool // primary key columns are implicitly NOT NULL
+
+	dataType func(b *schemaBuilder, table string, column *column) (metadata.DataType, *metadata.Enum)
+}
+
+var (
+	// PostgreSQL DDL dialect
+	PostgreSQL = Dialect{
+		name:                 "PostgreSQL",
+		lowerCaseIdentifiers: true,
+		notNullPrimaryKeys:   true,
+		dataType:             postgresDataType,
+	}
+
+	// MySQL and MariaDB DDL dialect
+	MySQL = Dialect{
+		name:                "MySQL",
+		backslashEscapes:    true,
+		hashComments:        true,
+		backtickIdentifiers: true,
+		notNullPrimaryKeys:  true,
+		dataType:            mysqlDataType,
+	}
+
+	// SQLite DDL dialect
+	SQLite = Dialect{
+		name:                "SQLite",
+		backtickIdentifiers: true,
+		bracketIdentifiers:  true,
+		dataType:            sqliteDataType,
+	}
+)
+
+// foreignKeyName returns foreign key constraint name, or the name database generates for unnamed constraint
+func (d Dialect) foreignKeyName(t *table, name string, columnNames []string) string {
+	if name != "" {
+		return name
+	}
+
+	switch d.name {
+	case PostgreSQL.name:
+		return t.name + "_" + strings.Join(columnNames, "_") + "_fkey"
+	case MySQL.name:
+		return fmt.Sprintf("%s_ibfk_%d", t.name, len(t.foreignKeys)+1)
+	}
+
+	return "" // SQLite foreign keys are not named
+}
+
+// columnType is column data type as declared in DDL
+type columnType struct {
+	words     []string // lower case type name words, without type modifiers in parenthesis
+	modifiers []token  // tokens enclosed in type name parenthesis, for instance VARCHAR(20) or ENUM('a', 'b')
+	array     bool
+	source    string // declared type source text
+}
+
+func (c columnType) name() string {
+	return strings.Join(c.words, " ")
+}
+
+func (c columnType) firstModifier() int {
+	for _, modifier := range c.modifiers {
+		if modifier.kind == numberToken {
+			value, _ := strconv.Atoi(modifier.value)
+			return value
+		}
+	}
+
+	return -1
+}
+
+func (c columnType) hasWord(word string) bool {
+	for _, w := range c.words {
+		if w == word {
+			return true
+		}
+	}
+
+	return false
+}
+
+// postgreSQL column data types, as reported in information_schema.columns data_type column
+var postgresTypeNames = map[string]string{
+	"int": "integer", "int4": "integer", "integer": "integer", "serial": "integer", "serial4": "integer",
+	"int2": "smallint", "smallint": "smallint", "smallserial": "smallint", "serial2": "smallint",
+	"int8": "bigint", "bigint": "bigint", "bigserial": "bigint", "serial8": "bigint",
+	"bool": "boolean", "boolean": "boolean",
+	"varchar": "character varying", "character varying": "character varying", "char varying": "character varying",
+	"char": "character", "character": "character", "bpchar": "character",
+	"text": "text", "citext": "citext", "name": "name",
+	"float8": "double precision", "double precision": "double precision",
+	"float4": "real", "real": "real",
+	"decimal": "numeric", "numeric": "numeric", "money": "money",
+	"timestamp": "timestamp without time zone", "timestamp without time zone": "timestamp without time zone",
+	"timestamptz": "timestamp with time zone", "timestamp with time zone": "timestamp with time zone",
+	"time": "time without time zone", "time without time zone": "time without time zone",
+	"timetz": "time with time zone", "time with time zone": "time with time zone",
+	"date": "date", "interval": "interval",
+	"bytea": "bytea", "uuid": "uuid", "json": "json", "jsonb": "jsonb", "xml": "xml",
+	"bit": "bit", "varbit": "bit varying", "bit varying": "bit varying",
+	"tsvector": "tsvector", "tsquery": "tsquery",
+	"point": "point", "line": "line", "lseg": "lseg", "box": "box", "path": "path", "polygon": "polygon", "circle": "circle",
+	"inet": "inet", "cidr": "cidr", "macaddr": "macaddr", "macaddr8": "macaddr8", "oid": "oid",
+	"int4range": "int4range", "int8range": "int8range", "numrange": "numrange", "tsrange": "tsrange",
+	"tstzrange": "tstzrange", "daterange": "daterange",
+}
+
+// postgreSQL internal type names (pg_type.typname), used as array column data type names
+var postgresUdtNames = map[string]string{
+	"integer": "int4", "smallint": "int2", "bigint": "int8", "boolean": "bool",
+	"character varying": "varchar", "character": "bpchar", "double precision": "float8", "real": "float4",
+	"timestamp without time zone": "timestamp", "timestamp with time zone": "timestamptz",
+	"time without time zone": "time", "time with time zone": "timetz", "bit varying": "varbit",
+}
+
+// citext is an extension type, so it is reported as user-defined type
+var postgresExtensionTypes = map[string]bool{"citext": true}
+
+func postgresDataType(b *schemaBuilder, table string, column *column) (metadata.DataType, *metadata.Enum) {
+	typeName := column.columnType.name()
+
+	if domain, ok := b.domains[typeName]; ok {
+		resolved := *column
+		resolved.columnType = domain
+		resolved.columnType.array = column.columnType.array || domain.array
+
+		return postgresDataType(b, table, &resolved)
+	}
+
+	if strings.HasPrefix(typeName, "interval") {
+		typeName = "interval"
+	}
+
+	if typeName == "float" {
+		typeName = "double precision"
+		if precision := column.columnType.firstModifier(); precision > 0 && precision <= 24 {
+			typeName = "real"
+		}
+	}
+
+	kind := metadata.BaseType
+	name, known := postgresTypeNames[typeName]
+
+	switch {
+	case b.enum(typeName) != nil:
+		kind, name = metadata.EnumType, typeName
+	case !known:
+		kind, name = metadata.UserDefinedType, typeName
+	case postgresExtensionTypes[name]:
+		kind = metadata.UserDefinedType
+	}
+
+	if column.columnType.array {
+		kind = metadata.ArrayType
+
+		if udtName, ok := postgresUdtNames[name]; ok {
+			name = udtName
+		}
+	}
+
+	return metadata.DataType{Name: name, Kind: kind}, nil
+}
+
+// mySQL column data types, as reported in information_schema.columns data_type column
+var mysqlTypeNames = map[string]string{
+	"integer": "int", "bool": "boolean", "boolean": "boolean",
+	"dec": "decimal", "numeric": "decimal", "fixed": "decimal",
+	"double precision": "double", "real": "double", "float4": "float", "float8": "double",
+	"character varying": "varchar", "character": "char",
+	"int1": "tinyint", "int2": "smallint", "int3": "mediumint", "int4": "int", "int8": "bigint",
+	"middleint": "mediumint", "serial": "bigint",
+}
+
+func mysqlDataType(b *schemaBuilder, table string, column *column) (metadata.DataType, *metadata.Enum) {
+	var words []string
+
+	for _, word := range column.columnType.words {
+		if word != "unsigned" && word != "signed" && word != "zerofill" {
+			words = append(words, word)
+		}
+	}
+
+	typeName := strings.Join(words, " ")
+	unsigned := column.columnType.hasWord("unsigned") || typeName == "serial"
+
+	if name, ok := mysqlTypeNames[typeName]; ok {
+		typeName = name
+	}
+
+	switch {
+	case typeName == "tinyint" && column.columnType.firstModifier() == 1:
+		typeName = "boolean"
+	case typeName == "enum":
+		enum := &metadata.Enum{Name: table + "_" + column.Name}
+
+		for _, value := range column.columnType.modifiers {
+			if value.kind == stringToken {
+				enum.Values = append(enum.Values, value.value)
+			}
+		}
+
+		return metadata.DataType{Name: enum.Name, Kind: metadata.EnumType}, enum
+	}
+
+	return metadata.DataType{Name: typeName, Kind: metadata.BaseType, IsUnsigned: unsigned}, nil
+}
+
+func sqliteDataType(b *schemaBuilder, table string, column *column) (metadata.DataType, *metadata.Enum) {
+	return metadata.DataType{
+		Name: strings.TrimSpace(strings.Split(column.columnType.source, "(")[0]),
+		Kind: metadata.BaseType,
+	}, nil
+}
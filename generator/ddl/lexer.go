@@ -0,0 +1,223 @@
+package ddl
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	eofToken tokenKind = iota
+	wordToken
+	identifierToken // quoted identifier
+	stringToken
+	numberToken
+	symbolToken
+)
+
+type token struct {
+	kind  tokenKind
+	value string // word, unquoted identifier or string value, or symbol
+	start int    // token start offset in ddl source
+	end   int    // token end offset in ddl source
+}
+
+// is returns true if token is a word (keyword) equal to word, case-insensitively
+func (t token) is(word string) bool {
+	return t.kind == wordToken && strings.EqualFold(t.value, word)
+}
+
+func (t token) isSymbol(symbol string) bool {
+	return t.kind == symbolToken && t.value == symbol
+}
+
+type lexer struct {
+	source  []rune
+	pos     int
+	dialect Dialect
+}
+
+// tokenize splits ddl source into tokens, skipping whitespaces and comments
+func tokenize(source string, dialect Dialect) ([]token, error) {
+	l := &lexer{source: []rune(source), dialect: dialect}
+
+	var tokens []token
+
+	for {
+		t, err := l.next()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if t.kind == eofToken {
+			return tokens, nil
+		}
+
+		tokens = append(tokens, t)
+	}
+}
+
+func (l *lexer) peekRune(offset int) rune {
+	if l.pos+offset >= len(l.source) {
+		return 0
+	}
+
+	return l.source[l.pos+offset]
+}
+
+func (l *lexer) next() (token, error) {
+	if err := l.skipWhitespacesAndComments(); err != nil {
+		return token{}, err
+	}
+
+	start := l.pos
+
+	if l.pos >= len(l.source) {
+		return token{kind: eofToken, start: start, end: start}, nil
+	}
+
+	r := l.source[l.pos]
+
+	switch {
+	case r == '\'':
+		value, err := l.quoted('\'', l.dialect.backslashEscapes)
+		return token{kind: stringToken, value: value, start: start, end: l.pos}, err
+	case (r == 'E' || r == 'e') && l.peekRune(1) == '\'' && l.dialect.name == PostgreSQL.name:
+		l.pos++
+		value, err := l.quoted('\'', true)
+		return token{kind: stringToken, value: value, start: start, end: l.pos}, err
+	case r == '"' || (r == '`' && l.dialect.backtickIdentifiers):
+		value, err := l.quoted(r, false)
+		return token{kind: identifierToken, value: value, start: start, end: l.pos}, err
+	case r == '[' && l.dialect.bracketIdentifiers:
+		end := l.index("]", l.pos+1)
+		if end < 0 {
+			return token{}, errors.New("ddl: unterminated quoted identifier")
+		}
+		value := string(l.source[l.pos+1 : end])
+		l.pos = end + 1
+		return token{kind: identifierToken, value: value, start: start, end: l.pos}, nil
+	case r == '$' && l.dollarQuoteTag() != "":
+		value, err := l.dollarQuoted(l.dollarQuoteTag())
+		return token{kind: stringToken, value: value, start: start, end: l.pos}, err
+	case r == '_' || unicode.IsLetter(r):
+		for l.pos < len(l.source) && isWordRune(l.source[l.pos]) {
+			l.pos++
+		}
+		return token{kind: wordToken, value: string(l.source[start:l.pos]), start: start, end: l.pos}, nil
+	case unicode.IsDigit(r):
+		for l.pos < len(l.source) && (unicode.IsDigit(l.source[l.pos]) || l.source[l.pos] == '.') {
+			l.pos++
+		}
+		return token{kind: numberToken, value: string(l.source[start:l.pos]), start: start, end: l.pos}, nil
+	}
+
+	l.pos++
+
+	return token{kind: symbolToken, value: string(r), start: start, end: l.pos}, nil
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func (l *lexer) skipWhitespacesAndComments() error {
+	for l.pos < len(l.source) {
+		r := l.source[l.pos]
+
+		switch {
+		case unicode.IsSpace(r):
+			l.pos++
+		case r == '-' && l.peekRune(1) == '-', r == '#' && l.dialect.hashComments:
+			for l.pos < len(l.source) && l.source[l.pos] != '\n' {
+				l.pos++
+			}
+		case r == '/' && l.peekRune(1) == '*':
+			end := l.index("*/", l.pos+2)
+			if end < 0 {
+				return errors.New("ddl: unterminated comment")
+			}
+			l.pos = end + 2
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// quoted reads string or identifier enclosed with quote character. Quote character inside value is escaped by
+// doubling it, or with backslash if backslashEscapes is true.
+func (l *lexer) quoted(quote rune, backslashEscapes bool) (string, error) {
+	var value strings.Builder
+
+	for l.pos++; l.pos < len(l.source); l.pos++ {
+		r := l.source[l.pos]
+
+		switch {
+		case backslashEscapes && r == '\\' && l.pos+1 < len(l.source):
+			l.pos++
+			value.WriteRune(l.source[l.pos])
+		case r == quote && l.peekRune(1) == quote:
+			l.pos++
+			value.WriteRune(quote)
+		case r == quote:
+			l.pos++
+			return value.String(), nil
+		default:
+			value.WriteRune(r)
+		}
+	}
+
+	return "", errors.New("ddl: unterminated quoted string or identifier")
+}
+
+// dollarQuoteTag returns PostgreSQL dollar quote tag ($$ or $tag$) at current position, if any
+func (l *lexer) dollarQuoteTag() string {
+	if l.dialect.name != PostgreSQL.name {
+		return ""
+	}
+
+	for i := l.pos + 1; i < len(l.source); i++ {
+		r := l.source[i]
+
+		if r == '$' {
+			return string(l.source[l.pos : i+1])
+		}
+
+		if !(r == '_' || unicode.IsLetter(r) || (i > l.pos+1 && unicode.IsDigit(r))) {
+			return ""
+		}
+	}
+
+	return ""
+}
+
+func (l *lexer) dollarQuoted(tag string) (string, error) {
+	bodyStart := l.pos + len([]rune(tag))
+	end := l.index(tag, bodyStart)
+
+	if end < 0 {
+		return "", errors.New("ddl: unterminated dollar quoted string")
+	}
+
+	l.pos = end + len([]rune(tag))
+
+	return string(l.source[bodyStart:end]), nil
+}
+
+// index returns position of the first occurrence of str in source, starting from position from, or -1
+func (l *lexer) index(str string, from int) int {
+	search := []rune(str)
+
+	for i := from; i+len(search) <= len(l.source); i++ {
+		if string(l.source[i:i+len(search)]) == str {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,859 @@
+package ddl
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+)
+
+// parser parses single DDL statement and applies it to schema builder
+type parser struct {
+	tokens  []token
+	pos     int
+	builder *schemaBuilder
+}
+
+func (p *parser) statement() error {
+	switch {
+	case p.keyword("CREATE"):
+		p.keyword("OR", "REPLACE")
+
+		for p.keyword("TEMP") || p.keyword("TEMPORARY") || p.keyword("UNLOGGED") || p.keyword("GLOBAL") || p.keyword("LOCAL") {
+		}
+
+		switch {
+		case p.keyword("TABLE"):
+			return p.createTable()
+		case p.keyword("TYPE"):
+			return p.createType()
+		case p.keyword("DOMAIN"):
+			return p.createDomain()
+		}
+	case p.keyword("ALTER", "TABLE"):
+		return p.alterTable()
+	case p.keyword("ALTER", "TYPE"):
+		return p.alterType()
+	case p.keyword("DROP", "TABLE"):
+		return p.drop(p.builder.dropTable)
+	case p.keyword("DROP", "TYPE"):
+		return p.drop(p.builder.dropEnum)
+	case p.keyword("DROP", "DOMAIN"):
+		return p.drop(func(name string) {
+			delete(p.builder.domains, name)
+		})
+	}
+
+	return nil
+}
+
+func (p *parser) createTable() error {
+	ifNotExists := p.keyword("IF", "NOT", "EXISTS")
+
+	schema, name, err := p.qualifiedName()
+
+	if err != nil || !p.builder.inSchema(schema) {
+		return err
+	}
+
+	if !p.symbol("(") { // CREATE TABLE ... AS SELECT, PARTITION OF, ...
+		return nil
+	}
+
+	if ifNotExists && p.builder.table(name) != nil {
+		return nil
+	}
+
+	newTable := &table{name: name}
+
+	for !p.symbol(")") {
+		if err := p.tableElement(newTable); err != nil {
+			return err
+		}
+
+		if !p.symbol(",") && !p.peek().isSymbol(")") {
+			return p.unexpected()
+		}
+	}
+
+	p.builder.setTable(newTable)
+
+	return nil
+}
+
+func (p *parser) tableElement(t *table) error {
+	switch {
+	case p.keyword("CONSTRAINT"):
+		name, err := p.constraintName()
+		if err != nil {
+			return err
+		}
+		return p.tableConstraint(t, name)
+	case p.isTableConstraint():
+		return p.tableConstraint(t, "")
+	case p.keyword("LIKE"):
+		p.skipElement()
+		return nil
+	}
+
+	newColumn, err := p.columnDefinition(t)
+
+	if err != nil {
+		return err
+	}
+
+	t.columns = append(t.columns, newColumn)
+
+	return nil
+}
+
+// constraintName parses constraint name following CONSTRAINT keyword. Constraint name is optional in MySQL.
+func (p *parser) constraintName() (string, error) {
+	if p.isTableConstraint() {
+		return "", nil
+	}
+
+	return p.identifier()
+}
+
+func (p *parser) isTableConstraint() bool {
+	for _, word := range []string{"PRIMARY", "UNIQUE", "FOREIGN", "CHECK", "EXCLUDE", "KEY", "INDEX", "FULLTEXT", "SPATIAL"} {
+		if p.peek().is(word) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tableConstraint applies PRIMARY KEY and FOREIGN KEY table constraints, other constraints are skipped
+func (p *parser) tableConstraint(t *table, name string) error {
+	defer p.skipElement()
+
+	if p.keyword("FOREIGN", "KEY") {
+		p.keyword("IF", "NOT", "EXISTS")
+
+		if !p.peek().isSymbol("(") { // MySQL index name
+			p.skipToken()
+		}
+
+		columnNames, err := p.columnNames()
+
+		if err != nil {
+			return err
+		}
+
+		return p.references(t, name, columnNames)
+	}
+
+	if !p.keyword("PRIMARY", "KEY") {
+		return nil
+	}
+
+	columnNames, err := p.columnNames()
+
+	if err != nil {
+		return err
+	}
+
+	for i, columnName := range columnNames {
+		if primaryKeyColumn := t.column(columnName); primaryKeyColumn != nil {
+			p.setPrimaryKey(primaryKeyColumn, i+1)
+		}
+	}
+
+	return nil
+}
+
+// references parses REFERENCES clause of foreign key constraint, and adds foreign key to the table. If referenced
+// columns are omitted, foreign key references primary key columns of the referenced table.
+func (p *parser) references(t *table, name string, columnNames []string) error {
+	if !p.keyword("REFERENCES") {
+		return p.unexpected()
+	}
+
+	schema, refTable, err := p.qualifiedName()
+
+	if err != nil || !p.builder.inSchema(schema) {
+		return err
+	}
+
+	var refColumnNames []string
+
+	if p.peek().isSymbol("(") {
+		if refColumnNames, err = p.columnNames(); err != nil {
+			return err
+		}
+	}
+
+	t.foreignKeys = append(t.foreignKeys, &metadata.ForeignKey{
+		Name:              p.builder.dialect.foreignKeyName(t, name, columnNames),
+		Columns:           columnNames,
+		ReferencedTable:   refTable,
+		ReferencedColumns: refColumnNames,
+	})
+
+	return nil
+}
+
+// setPrimaryKey marks column as primary key column at ordinal position (starting with 1) of the primary key
+func (p *parser) setPrimaryKey(primaryKeyColumn *column, ordinal int) {
+	primaryKeyColumn.IsPrimaryKey = true
+	primaryKeyColumn.PrimaryKeyOrdinal = ordinal
+
+	if p.builder.dialect.notNullPrimaryKeys {
+		primaryKeyColumn.IsNullable = false
+	}
+}
+
+// columnNames parses parenthesized list of column names, for instance (col1, col2 DESC)
+func (p *parser) columnNames() ([]string, error) {
+	if !p.symbol("(") {
+		return nil, p.unexpected()
+	}
+
+	var names []string
+
+	for {
+		name, err := p.identifier()
+
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+
+		for !p.atEnd() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") {
+			p.skipToken() // ASC, DESC, prefix length, ...
+		}
+
+		if p.symbol(")") {
+			return names, nil
+		}
+
+		if !p.symbol(",") {
+			return nil, p.unexpected()
+		}
+	}
+}
+
+func (p *parser) columnDefinition(t *table) (*column, error) {
+	name, err := p.identifier()
+
+	if err != nil {
+		return nil, err
+	}
+
+	newColumn := &column{
+		Column:     metadata.Column{Name: name, IsNullable: true},
+		columnType: p.columnType(),
+	}
+
+	typeName := newColumn.columnType.name()
+
+	if p.builder.dialect.name != SQLite.name && strings.Contains(typeName, "serial") {
+		newColumn.IsNullable = false
+		newColumn.IsAutoIncrement = true
+
+		if p.builder.dialect.name == PostgreSQL.name {
+			newColumn.DefaultExpression = "nextval('" + t.name + "_" + name + "_seq'::regclass)"
+		}
+	}
+
+	constraintName := ""
+
+	for !p.atEnd() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") {
+		switch {
+		case p.peek().is("REFERENCES"):
+			if err := p.references(t, constraintName, []string{name}); err != nil {
+				return nil, err
+			}
+			p.skipReferentialActions()
+		case p.keyword("NOT", "NULL"):
+			newColumn.IsNullable = false
+		case p.keyword("PRIMARY", "KEY"):
+			p.setPrimaryKey(newColumn, 1)
+		case p.keyword("DEFAULT"):
+			newColumn.DefaultExpression = p.expression()
+		case p.keyword("AUTO_INCREMENT"), p.keyword("AUTOINCREMENT"),
+			p.keyword("GENERATED", "ALWAYS", "AS", "IDENTITY"), p.keyword("GENERATED", "BY", "DEFAULT", "AS", "IDENTITY"):
+			newColumn.IsAutoIncrement = true
+		case p.keyword("CONSTRAINT"):
+			if constraintName, err = p.identifier(); err != nil {
+				return nil, err
+			}
+		default:
+			p.skipToken()
+		}
+	}
+
+	return newColumn, nil
+}
+
+// skipReferentialActions skips MATCH, ON DELETE and ON UPDATE clauses of column REFERENCES constraint
+func (p *parser) skipReferentialActions() {
+	for {
+		switch {
+		case p.keyword("MATCH"):
+			p.skipToken()
+		case p.keyword("ON", "DELETE"), p.keyword("ON", "UPDATE"):
+			if !p.keyword("NO", "ACTION") && !p.keyword("SET", "NULL") && !p.keyword("SET", "DEFAULT") {
+				p.skipToken() // CASCADE, RESTRICT
+			}
+		default:
+			return
+		}
+	}
+}
+
+var columnConstraintKeywords = []string{
+	"CONSTRAINT", "NOT", "NULL", "PRIMARY", "DEFAULT", "REFERENCES", "CHECK", "UNIQUE", "GENERATED", "AS",
+	"AUTO_INCREMENT", "AUTOINCREMENT", "COLLATE", "COMMENT", "ON", "CHARSET", "VISIBLE", "INVISIBLE", "STORAGE",
+	"COLUMN_FORMAT", "FIRST", "AFTER", "USING",
+}
+
+func (p *parser) isColumnConstraint() bool {
+	next := p.peek()
+
+	for _, keyword := range columnConstraintKeywords {
+		if next.is(keyword) {
+			return true
+		}
+	}
+
+	// MySQL CHARACTER SET, but not PostgreSQL CHARACTER VARYING type
+	return next.is("CHARACTER") && p.peekAt(1).is("SET")
+}
+
+// columnType parses column data type, for instance VARCHAR(20), INT UNSIGNED, TIMESTAMP WITH TIME ZONE or INT[]
+func (p *parser) columnType() columnType {
+	var ret columnType
+
+	start := p.pos
+
+	for !p.atEnd() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") && !p.isColumnConstraint() {
+		next := p.peek()
+
+		switch {
+		case next.isSymbol("("):
+			group := p.group()
+			if ret.modifiers == nil {
+				ret.modifiers = group
+			}
+		case next.isSymbol("["):
+			ret.array = true
+			p.skipUntil("]")
+		case next.is("ARRAY"):
+			ret.array = true
+			p.pos++
+		case next.isSymbol("."): // schema qualified type name
+			ret.words = nil
+			p.pos++
+		case next.kind == wordToken:
+			ret.words = append(ret.words, strings.ToLower(next.value))
+			p.pos++
+		case next.kind == identifierToken:
+			ret.words = append(ret.words, next.value)
+			p.pos++
+		default:
+			p.pos++
+		}
+	}
+
+	ret.source = p.sourceText(start)
+
+	return ret
+}
+
+// expression skips column default expression and returns its source text
+func (p *parser) expression() string {
+	start := p.pos
+
+	p.skipToken()
+
+	for !p.atEnd() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") && !p.isColumnConstraint() {
+		p.skipToken()
+	}
+
+	return p.sourceText(start)
+}
+
+func (p *parser) alterTable() error {
+	p.keyword("IF", "EXISTS")
+	p.keyword("ONLY")
+
+	schema, name, err := p.qualifiedName()
+
+	if err != nil || !p.builder.inSchema(schema) {
+		return err
+	}
+
+	alteredTable := p.builder.table(name)
+
+	if alteredTable == nil {
+		return nil
+	}
+
+	for {
+		if err := p.alterTableAction(alteredTable); err != nil {
+			return err
+		}
+
+		p.skipElement()
+
+		if !p.symbol(",") {
+			return nil
+		}
+	}
+}
+
+func (p *parser) alterTableAction(t *table) error {
+	switch {
+	case p.keyword("ADD"):
+		if p.keyword("CONSTRAINT") {
+			name, err := p.constraintName()
+			if err != nil {
+				return err
+			}
+			return p.tableConstraint(t, name)
+		}
+
+		if p.isTableConstraint() {
+			return p.tableConstraint(t, "")
+		}
+
+		p.keyword("COLUMN")
+		ifNotExists := p.keyword("IF", "NOT", "EXISTS")
+
+		newColumn, err := p.columnDefinition(t)
+
+		if err != nil || (ifNotExists && t.column(newColumn.Name) != nil) {
+			return err
+		}
+
+		t.columns = append(t.columns, newColumn)
+
+	case p.keyword("DROP"):
+		if p.keyword("PRIMARY", "KEY") {
+			for _, column := range t.columns {
+				column.IsPrimaryKey = false
+				column.PrimaryKeyOrdinal = 0
+			}
+			return nil
+		}
+
+		if p.keyword("CONSTRAINT") || p.keyword("FOREIGN", "KEY") {
+			p.keyword("IF", "EXISTS")
+
+			name, err := p.identifier()
+
+			if err == nil {
+				t.dropForeignKey(name)
+			}
+
+			return err
+		}
+
+		if p.isTableConstraint() {
+			return nil
+		}
+
+		p.keyword("COLUMN")
+		p.keyword("IF", "EXISTS")
+
+		name, err := p.identifier()
+
+		if err != nil {
+			return err
+		}
+
+		t.dropColumn(name)
+
+	case p.keyword("RENAME"):
+		return p.rename(t)
+
+	case p.keyword("ALTER"):
+		p.keyword("COLUMN")
+
+		name, err := p.identifier()
+
+		if err != nil {
+			return err
+		}
+
+		if alteredColumn := t.column(name); alteredColumn != nil {
+			p.alterColumn(alteredColumn)
+		}
+
+	case p.keyword("MODIFY"), p.keyword("CHANGE"):
+		changeColumn := p.tokens[p.pos-1].is("CHANGE")
+		p.keyword("COLUMN")
+
+		name, err := p.identifier()
+
+		if err != nil {
+			return err
+		}
+
+		if !changeColumn {
+			p.pos-- // MODIFY column definition starts with column name
+		}
+
+		newColumn, err := p.columnDefinition(t)
+
+		if err != nil {
+			return err
+		}
+
+		if oldColumn := t.column(name); oldColumn != nil && oldColumn.IsPrimaryKey {
+			p.setPrimaryKey(newColumn, oldColumn.PrimaryKeyOrdinal)
+		}
+
+		t.replaceColumn(name, newColumn)
+	}
+
+	return nil
+}
+
+func (p *parser) rename(t *table) error {
+	if p.keyword("TO") || p.keyword("AS") {
+		_, name, err := p.qualifiedName()
+		p.builder.renameTableReferences(t.name, name)
+		t.name = name
+		return err
+	}
+
+	if p.keyword("CONSTRAINT") || p.keyword("INDEX") || p.keyword("KEY") {
+		return nil
+	}
+
+	p.keyword("COLUMN")
+
+	oldName, err := p.identifier()
+
+	if err != nil {
+		return err
+	}
+
+	if !p.keyword("TO") {
+		return p.unexpected()
+	}
+
+	newName, err := p.identifier()
+
+	if renamedColumn := t.column(oldName); renamedColumn != nil && err == nil {
+		renamedColumn.Name = newName
+		p.builder.renameColumnReferences(t, oldName, newName)
+	}
+
+	return err
+}
+
+func (p *parser) alterColumn(c *column) {
+	switch {
+	case p.keyword("SET", "NOT", "NULL"):
+		c.IsNullable = false
+	case p.keyword("DROP", "NOT", "NULL"):
+		c.IsNullable = true
+	case p.keyword("SET", "DEFAULT"):
+		c.DefaultExpression = p.expression()
+	case p.keyword("DROP", "DEFAULT"):
+		c.DefaultExpression = ""
+	case p.keyword("SET", "DATA", "TYPE"), p.keyword("TYPE"):
+		c.columnType = p.columnType()
+	}
+}
+
+func (p *parser) createType() error {
+	schema, name, err := p.qualifiedName()
+
+	if err != nil || !p.builder.inSchema(schema) || !p.keyword("AS", "ENUM") {
+		return err
+	}
+
+	values, err := p.stringList()
+
+	if err != nil {
+		return err
+	}
+
+	p.builder.dropEnum(name)
+	p.builder.enums = append(p.builder.enums, &metadata.Enum{Name: name, Values: values})
+
+	return nil
+}
+
+func (p *parser) stringList() ([]string, error) {
+	var values []string
+
+	if !p.symbol("(") {
+		return nil, p.unexpected()
+	}
+
+	for !p.symbol(")") {
+		value := p.next()
+
+		if value.kind != stringToken {
+			return nil, p.unexpectedToken(value)
+		}
+
+		values = append(values, value.value)
+
+		if !p.symbol(",") && !p.peek().isSymbol(")") {
+			return nil, p.unexpected()
+		}
+	}
+
+	return values, nil
+}
+
+func (p *parser) alterType() error {
+	schema, name, err := p.qualifiedName()
+
+	if err != nil || !p.builder.inSchema(schema) {
+		return err
+	}
+
+	enum := p.builder.enum(name)
+
+	if enum == nil {
+		return nil
+	}
+
+	switch {
+	case p.keyword("ADD", "VALUE"):
+		ifNotExists := p.keyword("IF", "NOT", "EXISTS")
+		value := p.next()
+
+		if value.kind != stringToken {
+			return p.unexpectedToken(value)
+		}
+
+		if ifNotExists && indexOf(enum.Values, value.value) >= 0 {
+			return nil
+		}
+
+		position := len(enum.Values)
+
+		if before, after := p.keyword("BEFORE"), p.keyword("AFTER"); before || after {
+			if index := indexOf(enum.Values, p.next().value); index >= 0 {
+				position = index
+				if after {
+					position++
+				}
+			}
+		}
+
+		enum.Values = append(enum.Values[:position], append([]string{value.value}, enum.Values[position:]...)...)
+
+	case p.keyword("RENAME", "VALUE"):
+		oldValue := p.next()
+
+		if !p.keyword("TO") {
+			return p.unexpected()
+		}
+
+		if index := indexOf(enum.Values, oldValue.value); index >= 0 {
+			enum.Values[index] = p.next().value
+		}
+
+	case p.keyword("RENAME", "TO"):
+		_, newName, err := p.qualifiedName()
+
+		if err != nil {
+			return err
+		}
+
+		p.builder.renameType(enum.Name, newName)
+		enum.Name = newName
+	}
+
+	return nil
+}
+
+func (p *parser) createDomain() error {
+	schema, name, err := p.qualifiedName()
+
+	if err != nil || !p.builder.inSchema(schema) {
+		return err
+	}
+
+	p.keyword("AS")
+	p.builder.domains[name] = p.columnType()
+
+	return nil
+}
+
+// drop parses list of object names, and drops each of them
+func (p *parser) drop(dropFunc func(name string)) error {
+	p.keyword("IF", "EXISTS")
+
+	for {
+		schema, name, err := p.qualifiedName()
+
+		if err != nil {
+			return err
+		}
+
+		if p.builder.inSchema(schema) {
+			dropFunc(name)
+		}
+
+		if !p.symbol(",") {
+			return nil
+		}
+	}
+}
+
+// identifier parses quoted or unquoted identifier
+func (p *parser) identifier() (string, error) {
+	next := p.next()
+
+	switch next.kind {
+	case identifierToken:
+		return next.value, nil
+	case wordToken:
+		if p.builder.dialect.lowerCaseIdentifiers {
+			return strings.ToLower(next.value), nil
+		}
+		return next.value, nil
+	}
+
+	return "", p.unexpectedToken(next)
+}
+
+// qualifiedName parses object name, optionally qualified with schema name
+func (p *parser) qualifiedName() (schema, name string, err error) {
+	name, err = p.identifier()
+
+	for err == nil && p.symbol(".") {
+		schema = name
+		name, err = p.identifier()
+	}
+
+	return schema, name, err
+}
+
+func (p *parser) atEnd() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	return p.peekAt(0)
+}
+
+func (p *parser) peekAt(offset int) token {
+	if p.pos+offset >= len(p.tokens) {
+		return token{kind: eofToken}
+	}
+
+	return p.tokens[p.pos+offset]
+}
+
+func (p *parser) next() token {
+	next := p.peek()
+
+	if !p.atEnd() {
+		p.pos++
+	}
+
+	return next
+}
+
+// keyword consumes sequence of keywords if the following tokens match it
+func (p *parser) keyword(words ...string) bool {
+	for i, word := range words {
+		if !p.peekAt(i).is(word) {
+			return false
+		}
+	}
+
+	p.pos += len(words)
+
+	return true
+}
+
+// symbol consumes symbol token if the following token matches it
+func (p *parser) symbol(symbol string) bool {
+	if p.peek().isSymbol(symbol) {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+// skipToken skips next token, or a whole parenthesized group of tokens
+func (p *parser) skipToken() {
+	if p.peek().isSymbol("(") {
+		p.group()
+		return
+	}
+
+	p.pos++
+}
+
+// group skips parenthesized group of tokens, and returns tokens inside parenthesis
+func (p *parser) group() []token {
+	start := p.pos + 1
+	depth := 0
+
+	for !p.atEnd() {
+		next := p.next()
+
+		switch {
+		case next.isSymbol("("):
+			depth++
+		case next.isSymbol(")"):
+			depth--
+			if depth == 0 {
+				return p.tokens[start : p.pos-1]
+			}
+		}
+	}
+
+	return p.tokens[start:]
+}
+
+func (p *parser) skipUntil(symbol string) {
+	for !p.atEnd() && !p.next().isSymbol(symbol) {
+	}
+}
+
+// skipElement skips tokens until the end of current list element (',' or ')' outside of parenthesis)
+func (p *parser) skipElement() {
+	for !p.atEnd() && !p.peek().isSymbol(",") && !p.peek().isSymbol(")") {
+		p.skipToken()
+	}
+}
+
+// sourceText returns ddl source text of tokens from start to current position
+func (p *parser) sourceText(start int) string {
+	if start >= p.pos {
+		return ""
+	}
+
+	return string(p.builder.source[p.tokens[start].start:p.tokens[p.pos-1].end])
+}
+
+func (p *parser) unexpected() error {
+	return p.unexpectedToken(p.peek())
+}
+
+func (p *parser) unexpectedToken(t token) error {
+	if t.kind == eofToken {
+		return errors.New("ddl: unexpected end of statement")
+	}
+
+	return errors.New("ddl: syntax error at or near '" + string(p.builder.source[t.start:t.end]) + "'")
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+
+	return -1
+}
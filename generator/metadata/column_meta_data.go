@@ -6,6 +6,14 @@ type Column struct {
 	IsPrimaryKey bool
 	IsNullable   bool
 	DataType     DataType
+	// DefaultExpression is column default value sql expression, as reported by database. Empty if column has no default.
+	DefaultExpression string
+	// IsAutoIncrement is true if column value is generated by the database on insert (serial, identity,
+	// AUTO_INCREMENT or SQLite rowid alias column)
+	IsAutoIncrement bool
+	// PrimaryKeyOrdinal is a position of the column in the table primary key, starting with 1. Zero if column
+	// is not a part of the primary key, or if position is not known.
+	PrimaryKeyOrdinal int
 }
 
 // DataTypeKind is database type kind(base, enum, user-defined, array)
@@ -18,6 +18,7 @@ const (
 type DialectQuerySet interface {
 	GetTablesMetaData(db *sql.DB, schemaName string, tableType TableType) []Table
 	GetEnumsMetaData(db *sql.DB, schemaName string) []Enum
+	GetExtensions(db *sql.DB) []string
 }
 
 // GetSchema retrieves Schema information from database
@@ -27,6 +28,7 @@ func GetSchema(db *sql.DB, querySet DialectQuerySet, schemaName string) Schema {
 		TablesMetaData: querySet.GetTablesMetaData(db, schemaName, BaseTable),
 		ViewsMetaData:  querySet.GetTablesMetaData(db, schemaName, ViewTable),
 		EnumsMetaData:  querySet.GetEnumsMetaData(db, schemaName),
+		Extensions:     querySet.GetExtensions(db),
 	}
 
 	fmt.Println("	FOUND", len(ret.TablesMetaData), "table(s),", len(ret.ViewsMetaData), "view(s),",
@@ -1,14 +1,47 @@
 package metadata
 
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
 // Schema struct
 type Schema struct {
 	Name           string
 	TablesMetaData []Table
 	ViewsMetaData  []Table
 	EnumsMetaData  []Enum
+	// Extensions is the list of installed database extensions (PostgreSQL only)
+	Extensions []string
 }
 
 // IsEmpty returns true if schema info does not contain any table, views or enums metadata
 func (s Schema) IsEmpty() bool {
 	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.EnumsMetaData) == 0
 }
+
+// SaveSchema saves schema metadata snapshot as json file at filePath
+func SaveSchema(filePath string, schema Schema) error {
+	data, err := json.MarshalIndent(schema, "", "\t")
+
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filePath, data, 0644)
+}
+
+// LoadSchema loads schema metadata snapshot from json file at filePath
+func LoadSchema(filePath string) (Schema, error) {
+	var schema Schema
+
+	data, err := ioutil.ReadFile(filePath)
+
+	if err != nil {
+		return schema, err
+	}
+
+	err = json.Unmarshal(data, &schema)
+
+	return schema, err
+}
@@ -1,9 +1,64 @@
 package metadata
 
+import "sort"
+
 // Table metadata struct
 type Table struct {
-	Name    string
-	Columns []Column
+	Name             string
+	Columns          []Column
+	CheckConstraints []CheckConstraint
+	// ForeignKeys are foreign key constraints of the table, referencing tables of the same schema
+	ForeignKeys []ForeignKey
+
+	// Hypertable is true for TimescaleDB hypertables (PostgreSQL only)
+	Hypertable bool
+	// ContinuousAggregate is true for TimescaleDB continuous aggregate views (PostgreSQL only)
+	ContinuousAggregate bool
+	// MaterializedView is true for materialized views (PostgreSQL only)
+	MaterializedView bool
+}
+
+// CheckConstraint metadata struct. Check constraints are not retrieved from SQLite databases.
+type CheckConstraint struct {
+	Name       string
+	Expression string
+}
+
+// ForeignKey metadata struct. Columns are listed in the constraint order, and each column references the column of
+// ReferencedColumns at the same position.
+type ForeignKey struct {
+	Name              string
+	Columns           []string
+	ReferencedTable   string
+	ReferencedColumns []string
+}
+
+// ForeignKeyColumn is a single column of foreign key constraint, as retrieved from database
+type ForeignKeyColumn struct {
+	ConstraintName   string
+	ColumnName       string
+	ReferencedTable  string
+	ReferencedColumn string
+}
+
+// GroupForeignKeys groups foreign key columns, ordered by constraint name and column position, into foreign keys
+func GroupForeignKeys(columns []ForeignKeyColumn) []ForeignKey {
+	var ret []ForeignKey
+
+	for _, column := range columns {
+		if len(ret) == 0 || ret[len(ret)-1].Name != column.ConstraintName {
+			ret = append(ret, ForeignKey{
+				Name:            column.ConstraintName,
+				ReferencedTable: column.ReferencedTable,
+			})
+		}
+
+		foreignKey := &ret[len(ret)-1]
+		foreignKey.Columns = append(foreignKey.Columns, column.ColumnName)
+		foreignKey.ReferencedColumns = append(foreignKey.ReferencedColumns, column.ReferencedColumn)
+	}
+
+	return ret
 }
 
 // MutableColumns returns list of mutable columns for table
@@ -20,3 +75,21 @@ func (t Table) MutableColumns() []Column {
 
 	return ret
 }
+
+// PrimaryKeyColumns returns list of primary key columns for table, in the primary key column order. Columns with
+// unknown primary key position are listed in the table column order.
+func (t Table) PrimaryKeyColumns() []Column {
+	var ret []Column
+
+	for _, column := range t.Columns {
+		if column.IsPrimaryKey {
+			ret = append(ret, column)
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].PrimaryKeyOrdinal < ret[j].PrimaryKeyOrdinal
+	})
+
+	return ret
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/go-jet/jet/v2/generator/ddl"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/generator/template"
 	"github.com/go-jet/jet/v2/internal/utils"
@@ -67,6 +68,25 @@ func GenerateDSN(dsn, destDir string, templates ...template.Template) (err error
 	return nil
 }
 
+// GenerateDDL generates jet files for database dbName at destination dir from DDL statements of the .sql file, or of
+// the directory of .sql files (schema dump or migrations), at ddlPath. Database connection is not needed, but only
+// the tables and enums are generated, see ddl.Parse for details.
+func GenerateDDL(ddlPath, dbName, destDir string, templates ...template.Template) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	fmt.Println("Parsing DDL statements: " + ddlPath)
+	schemaMetaData, err := ddl.ParseFiles(ddlPath, ddl.MySQL, dbName)
+	throw.OnError(err)
+
+	genTemplate := template.Default(mysql.Dialect)
+	if len(templates) > 0 {
+		genTemplate = templates[0]
+	}
+
+	template.ProcessSchema(destDir, schemaMetaData, genTemplate)
+	return nil
+}
+
 func openConnection(connectionString string) *sql.DB {
 	fmt.Println("Connecting to MySQL database: " + connectionString)
 	db, err := sql.Open("mysql", connectionString)
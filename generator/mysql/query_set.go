@@ -25,6 +25,11 @@ WHERE table_schema = ? and table_type = ?;
 
 	for i := range tables {
 		tables[i].Columns = m.GetTableColumnsMetaData(db, schemaName, tables[i].Name)
+
+		if tableType == metadata.BaseTable {
+			tables[i].CheckConstraints = m.GetTableCheckConstraints(db, schemaName, tables[i].Name)
+			tables[i].ForeignKeys = m.GetTableForeignKeys(db, schemaName, tables[i].Name)
+		}
 	}
 
 	return tables
@@ -47,18 +52,65 @@ SELECT COLUMN_NAME AS "column.Name",
 					DATA_TYPE)
 	) AS "dataType.Name", 
 	IF (DATA_TYPE = 'enum', 'enum', 'base') AS "dataType.Kind", 
-	COLUMN_TYPE LIKE '%unsigned%' AS "dataType.IsUnsigned"
+	COLUMN_TYPE LIKE '%unsigned%' AS "dataType.IsUnsigned",
+	COALESCE(COLUMN_DEFAULT, '') AS "column.DefaultExpression",
+	EXTRA LIKE '%auto_increment%' AS "column.IsAutoIncrement",
+	COALESCE((
+		SELECT k.ordinal_position
+		FROM information_schema.table_constraints t
+			JOIN information_schema.key_column_usage k USING(constraint_name,table_schema,table_name)
+		WHERE table_schema = ? AND table_name = ? AND t.constraint_type='PRIMARY KEY' AND k.column_name = columns.column_name
+	), 0) AS "column.PrimaryKeyOrdinal"
 FROM information_schema.columns
 WHERE table_schema = ? AND table_name = ?
 ORDER BY ordinal_position;
 `
 	var columns []metadata.Column
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName, schemaName, tableName}, &columns)
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName, schemaName, tableName, schemaName, tableName}, &columns)
 	throw.OnError(err)
 
 	return columns
 }
 
+func (m mySqlQuerySet) GetTableCheckConstraints(db *sql.DB, schemaName string, tableName string) []metadata.CheckConstraint {
+	query := `
+SELECT cc.CONSTRAINT_NAME AS "checkConstraint.Name",
+	cc.CHECK_CLAUSE AS "checkConstraint.Expression"
+FROM information_schema.CHECK_CONSTRAINTS cc
+	JOIN information_schema.TABLE_CONSTRAINTS tc
+		ON (tc.CONSTRAINT_SCHEMA = cc.CONSTRAINT_SCHEMA AND tc.CONSTRAINT_NAME = cc.CONSTRAINT_NAME)
+WHERE tc.TABLE_SCHEMA = ? AND tc.TABLE_NAME = ? AND tc.CONSTRAINT_TYPE = 'CHECK'
+ORDER BY cc.CONSTRAINT_NAME;
+`
+	var checkConstraints []metadata.CheckConstraint
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &checkConstraints)
+
+	if err != nil {
+		// CHECK_CONSTRAINTS table is not available before MySQL 8.0.16 and MariaDB 10.2, which do not enforce check constraints
+		return nil
+	}
+
+	return checkConstraints
+}
+
+// GetTableForeignKeys returns foreign keys of the table, referencing tables of the same schema
+func (m mySqlQuerySet) GetTableForeignKeys(db *sql.DB, schemaName string, tableName string) []metadata.ForeignKey {
+	query := `
+SELECT CONSTRAINT_NAME AS "foreignKeyColumn.ConstraintName",
+	COLUMN_NAME AS "foreignKeyColumn.ColumnName",
+	REFERENCED_TABLE_NAME AS "foreignKeyColumn.ReferencedTable",
+	REFERENCED_COLUMN_NAME AS "foreignKeyColumn.ReferencedColumn"
+FROM information_schema.KEY_COLUMN_USAGE
+WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND REFERENCED_TABLE_SCHEMA = TABLE_SCHEMA
+ORDER BY CONSTRAINT_NAME, ORDINAL_POSITION;
+`
+	var columns []metadata.ForeignKeyColumn
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &columns)
+	throw.OnError(err)
+
+	return metadata.GroupForeignKeys(columns)
+}
+
 func (m *mySqlQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.Enum {
 	query := `
 SELECT (CASE c.DATA_TYPE WHEN 'enum' then CONCAT(c.TABLE_NAME, '_', c.COLUMN_NAME) ELSE '' END ) as "name", 
@@ -88,3 +140,7 @@ WHERE c.table_schema = ? AND DATA_TYPE = 'enum';
 
 	return ret
 }
+
+func (m mySqlQuerySet) GetExtensions(db *sql.DB) []string {
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"path"
 	"strconv"
 
+	"github.com/go-jet/jet/v2/generator/ddl"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/generator/template"
 	"github.com/go-jet/jet/v2/internal/utils"
@@ -68,6 +69,32 @@ func GenerateDSN(dsn, schema, destDir string, templates ...template.Template) (e
 	return
 }
 
+// GenerateDDL generates jet files for schema of database dbName at destination dir from DDL statements of the .sql
+// file, or of the directory of .sql files (schema dump or migrations), at ddlPath. Files are generated in the same
+// directory as GenerateDSN would generate them. Database connection is not needed, but only the tables and enums are
+// generated, see ddl.Parse for details.
+func GenerateDDL(ddlPath, dbName, schema, destDir string, templates ...template.Template) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	if dbName == "" {
+		panic("database name is required")
+	}
+
+	fmt.Println("Parsing DDL statements: " + ddlPath)
+	schemaMetadata, err := ddl.ParseFiles(ddlPath, ddl.PostgreSQL, schema)
+	throw.OnError(err)
+
+	generatorTemplate := template.Default(postgres.Dialect)
+	if len(templates) > 0 {
+		generatorTemplate = templates[0]
+	}
+
+	dirPath := path.Join(destDir, dbName)
+
+	template.ProcessSchema(dirPath, schemaMetadata, generatorTemplate)
+	return
+}
+
 func openConnection(dsn string) *sql.DB {
 	fmt.Println("Connecting to postgres database: " + dsn)
 
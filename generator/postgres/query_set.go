@@ -22,17 +22,88 @@ WHERE table_schema = $1 and table_type = $2;
 	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableType}, &tables)
 	throw.OnError(err)
 
+	timescaleTables := p.getTimescaleTables(db, schemaName, tableType)
+
 	for i := range tables {
 		tables[i].Columns = p.GetTableColumnsMetaData(db, schemaName, tables[i].Name)
+
+		if tableType == metadata.BaseTable {
+			tables[i].CheckConstraints = p.GetTableCheckConstraints(db, schemaName, tables[i].Name)
+			tables[i].ForeignKeys = p.GetTableForeignKeys(db, schemaName, tables[i].Name)
+			tables[i].Hypertable = timescaleTables[tables[i].Name]
+		} else {
+			tables[i].ContinuousAggregate = timescaleTables[tables[i].Name]
+		}
+	}
+
+	if tableType == metadata.ViewTable {
+		tables = append(tables, p.getMaterializedViews(db, schemaName)...)
 	}
 
 	return tables
 }
 
+// getMaterializedViews returns materialized views metadata. Materialized views are not part of information_schema,
+// so both views and their columns are retrieved from pg_catalog.
+func (p postgresQuerySet) getMaterializedViews(db *sql.DB, schemaName string) []metadata.Table {
+	query := `
+SELECT matviewname as "table.name"
+FROM pg_catalog.pg_matviews
+WHERE schemaname = $1
+ORDER BY matviewname;
+`
+	var views []metadata.Table
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &views)
+	throw.OnError(err)
+
+	for i := range views {
+		views[i].Columns = p.getMaterializedViewColumnsMetaData(db, schemaName, views[i].Name)
+		views[i].MaterializedView = true
+	}
+
+	return views
+}
+
+func (p postgresQuerySet) getMaterializedViewColumnsMetaData(db *sql.DB, schemaName string, viewName string) []metadata.Column {
+	query := `
+SELECT attr.attname as "column.Name",
+	   NOT attr.attnotnull as "column.isNullable",
+	   FALSE as "column.IsPrimaryKey",
+	   dataType.kind as "dataType.Kind",
+	   (case dataType.kind 
+			when 'base' then format_type(coalesce(nullif(typ.typbasetype, 0), typ.oid), NULL) 
+			else LTRIM(typ.typname, '_') 
+		end) as "dataType.Name",
+	   FALSE as "dataType.isUnsigned",
+	   '' as "column.DefaultExpression",
+	   FALSE as "column.IsAutoIncrement",
+	   0 as "column.PrimaryKeyOrdinal"
+FROM pg_catalog.pg_attribute attr
+	JOIN pg_catalog.pg_class rel ON rel.oid = attr.attrelid
+	JOIN pg_catalog.pg_namespace nsp ON nsp.oid = rel.relnamespace
+	JOIN pg_catalog.pg_type typ ON typ.oid = attr.atttypid
+	JOIN pg_catalog.pg_namespace typnsp ON typnsp.oid = typ.typnamespace,
+	LATERAL (select (case 
+				when typ.typcategory = 'A' then 'array'
+				when typ.typtype = 'e' then 'enum'
+				when typ.typtype = 'd' or typnsp.nspname = 'pg_catalog' then 'base'
+				else 'user-defined'
+			end) as kind) as dataType
+WHERE nsp.nspname = $1 AND rel.relname = $2 AND attr.attnum > 0 AND NOT attr.attisdropped
+ORDER BY attr.attnum;
+`
+	var columns []metadata.Column
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, viewName}, &columns)
+	throw.OnError(err)
+
+	return columns
+}
+
 func (p postgresQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, tableName string) []metadata.Column {
 	query := `
 WITH primaryKeys AS (
-	SELECT column_name
+	SELECT column_name, ordinal_position
 	FROM information_schema.key_column_usage AS c
 		LEFT JOIN information_schema.table_constraints AS t
 		ON t.constraint_name = c.constraint_name
@@ -43,7 +114,10 @@ SELECT column_name as "column.Name",
        (EXISTS(SELECT 1 from primaryKeys as pk where pk.column_name = columns.column_name)) as "column.IsPrimaryKey",
 	   dataType.kind as "dataType.Kind",	
 	   (case dataType.Kind when 'base' then data_type else LTRIM(udt_name, '_') end) as "dataType.Name", 
-	   FALSE as "dataType.isUnsigned"
+	   FALSE as "dataType.isUnsigned",
+	   COALESCE(column_default, '') as "column.DefaultExpression",
+	   (COALESCE(column_default, '') LIKE 'nextval(%' OR is_identity = 'YES') as "column.IsAutoIncrement",
+	   COALESCE((SELECT pk.ordinal_position from primaryKeys as pk where pk.column_name = columns.column_name), 0) as "column.PrimaryKeyOrdinal"
 FROM information_schema.columns,
 	 LATERAL (select (case data_type
 				when 'ARRAY' then 'array'
@@ -64,6 +138,85 @@ order by ordinal_position;
 	return columns
 }
 
+func (p postgresQuerySet) GetTableCheckConstraints(db *sql.DB, schemaName string, tableName string) []metadata.CheckConstraint {
+	query := `
+SELECT con.conname as "checkConstraint.Name",
+	   pg_get_constraintdef(con.oid) as "checkConstraint.Expression"
+FROM pg_catalog.pg_constraint con
+	JOIN pg_catalog.pg_class rel ON rel.oid = con.conrelid
+	JOIN pg_catalog.pg_namespace nsp ON nsp.oid = rel.relnamespace
+WHERE nsp.nspname = $1 AND rel.relname = $2 AND con.contype = 'c'
+ORDER BY con.conname;
+`
+	var checkConstraints []metadata.CheckConstraint
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &checkConstraints)
+	throw.OnError(err)
+
+	return checkConstraints
+}
+
+// GetTableForeignKeys returns foreign keys of the table, referencing tables of the same schema
+func (p postgresQuerySet) GetTableForeignKeys(db *sql.DB, schemaName string, tableName string) []metadata.ForeignKey {
+	query := `
+SELECT con.conname as "foreignKeyColumn.ConstraintName",
+	   att.attname as "foreignKeyColumn.ColumnName",
+	   refRel.relname as "foreignKeyColumn.ReferencedTable",
+	   refAtt.attname as "foreignKeyColumn.ReferencedColumn"
+FROM pg_catalog.pg_constraint con
+	JOIN pg_catalog.pg_class rel ON rel.oid = con.conrelid
+	JOIN pg_catalog.pg_namespace nsp ON nsp.oid = rel.relnamespace
+	JOIN pg_catalog.pg_class refRel ON refRel.oid = con.confrelid
+	JOIN pg_catalog.pg_namespace refNsp ON refNsp.oid = refRel.relnamespace
+	CROSS JOIN LATERAL unnest(con.conkey, con.confkey) WITH ORDINALITY AS keys(attnum, refAttnum, position)
+	JOIN pg_catalog.pg_attribute att ON att.attrelid = con.conrelid AND att.attnum = keys.attnum
+	JOIN pg_catalog.pg_attribute refAtt ON refAtt.attrelid = con.confrelid AND refAtt.attnum = keys.refAttnum
+WHERE nsp.nspname = $1 AND rel.relname = $2 AND con.contype = 'f' AND refNsp.nspname = $1
+ORDER BY con.conname, keys.position;
+`
+	var columns []metadata.ForeignKeyColumn
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &columns)
+	throw.OnError(err)
+
+	return metadata.GroupForeignKeys(columns)
+}
+
+// getTimescaleTables returns set of TimescaleDB hypertable names for base tables, or continuous aggregate
+// view names for views. Empty set is returned if TimescaleDB extension is not installed.
+func (p postgresQuerySet) getTimescaleTables(db *sql.DB, schemaName string, tableType metadata.TableType) map[string]bool {
+	query := `
+SELECT hypertable_name
+FROM timescaledb_information.hypertables
+WHERE hypertable_schema = $1;
+`
+	if tableType == metadata.ViewTable {
+		query = `
+SELECT view_name
+FROM timescaledb_information.continuous_aggregates
+WHERE view_schema = $1;
+`
+	}
+
+	var exists bool
+	err := db.QueryRow(`SELECT EXISTS(SELECT 1 FROM pg_catalog.pg_extension WHERE extname = 'timescaledb');`).Scan(&exists)
+	throw.OnError(err)
+
+	ret := map[string]bool{}
+
+	if !exists {
+		return ret
+	}
+
+	var names []string
+	_, err = qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &names)
+	throw.OnError(err)
+
+	for _, name := range names {
+		ret[name] = true
+	}
+
+	return ret
+}
+
 func (p postgresQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.Enum {
 	query := `
 SELECT t.typname as "enum.name",  
@@ -81,3 +234,18 @@ ORDER BY n.nspname, t.typname, e.enumsortorder;`
 
 	return result
 }
+
+func (p postgresQuerySet) GetExtensions(db *sql.DB) []string {
+	query := `
+SELECT extname
+FROM pg_catalog.pg_extension
+WHERE extname <> 'plpgsql'
+ORDER BY extname;
+`
+	var extensions []string
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{}, &extensions)
+	throw.OnError(err)
+
+	return extensions
+}
@@ -35,16 +35,59 @@ func (p sqliteQuerySet) GetTablesMetaData(db *sql.DB, schemaName string, tableTy
 		tables[i].Columns = p.GetTableColumnsMetaData(db, schemaName, tables[i].Name)
 	}
 
+	if tableType == metadata.BaseTable {
+		for i := range tables {
+			tables[i].ForeignKeys = p.GetTableForeignKeys(db, tables, tables[i].Name)
+		}
+	}
+
 	return tables
 }
 
+// GetTableForeignKeys returns foreign keys of the table. SQLite foreign keys are not named, and referenced columns
+// can be omitted, in which case foreign key references primary key of the referenced table.
+func (p sqliteQuerySet) GetTableForeignKeys(db *sql.DB, tables []metadata.Table, tableName string) []metadata.ForeignKey {
+	query := `
+SELECT CAST(id AS TEXT) as "foreignKeyColumn.ConstraintName",
+	"from" as "foreignKeyColumn.ColumnName",
+	"table" as "foreignKeyColumn.ReferencedTable",
+	COALESCE("to", '') as "foreignKeyColumn.ReferencedColumn"
+FROM pragma_foreign_key_list(?)
+ORDER BY id, seq;
+`
+	var columns []metadata.ForeignKeyColumn
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{tableName}, &columns)
+	throw.OnError(err)
+
+	foreignKeys := metadata.GroupForeignKeys(columns)
+
+	for i := range foreignKeys {
+		foreignKeys[i].Name = ""
+
+		for j, referencedColumn := range foreignKeys[i].ReferencedColumns {
+			if referencedColumn != "" {
+				continue
+			}
+
+			for _, table := range tables {
+				if table.Name == foreignKeys[i].ReferencedTable && j < len(table.PrimaryKeyColumns()) {
+					foreignKeys[i].ReferencedColumns[j] = table.PrimaryKeyColumns()[j].Name
+				}
+			}
+		}
+	}
+
+	return foreignKeys
+}
+
 func (p sqliteQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, tableName string) []metadata.Column {
 	query := fmt.Sprintf(`select * from pragma_table_info(?);`)
 	var columnInfos []struct {
-		Name    string
-		Type    string
-		NotNull int32
-		Pk      int32
+		Name      string
+		Type      string
+		NotNull   int32
+		DfltValue *string
+		Pk        int32
 	}
 
 	_, err := qrm.Query(context.Background(), db, query, []interface{}{tableName}, &columnInfos)
@@ -54,22 +97,52 @@ func (p sqliteQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, t
 
 	for _, columnInfo := range columnInfos {
 		columnType := getColumnType(columnInfo.Type)
+		defaultExpression := ""
+
+		if columnInfo.DfltValue != nil {
+			defaultExpression = *columnInfo.DfltValue
+		}
 
 		columns = append(columns, metadata.Column{
-			Name:         columnInfo.Name,
-			IsPrimaryKey: columnInfo.Pk != 0,
-			IsNullable:   columnInfo.NotNull != 1,
+			Name:              columnInfo.Name,
+			IsPrimaryKey:      columnInfo.Pk != 0,
+			IsNullable:        columnInfo.NotNull != 1,
+			PrimaryKeyOrdinal: int(columnInfo.Pk),
 			DataType: metadata.DataType{
 				Name:       columnType,
 				Kind:       metadata.BaseType,
 				IsUnsigned: false,
 			},
+			DefaultExpression: defaultExpression,
 		})
 	}
 
+	markRowIDAlias(columns)
+
 	return columns
 }
 
+// markRowIDAlias marks single INTEGER PRIMARY KEY column as auto increment, because it is an alias of the table rowid
+func markRowIDAlias(columns []metadata.Column) {
+	primaryKey := -1
+
+	for i, column := range columns {
+		if !column.IsPrimaryKey {
+			continue
+		}
+
+		if primaryKey != -1 {
+			return
+		}
+
+		primaryKey = i
+	}
+
+	if primaryKey != -1 && strings.EqualFold(columns[primaryKey].DataType.Name, "INTEGER") {
+		columns[primaryKey].IsAutoIncrement = true
+	}
+}
+
 // will convert VARCHAR(10) -> VARCHAR, etc...
 func getColumnType(columnType string) string {
 	return strings.TrimSpace(strings.Split(columnType, "(")[0])
@@ -78,3 +151,7 @@ func getColumnType(columnType string) string {
 func (p sqliteQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.Enum {
 	return nil
 }
+
+func (p sqliteQuerySet) GetExtensions(db *sql.DB) []string {
+	return nil
+}
@@ -3,6 +3,7 @@ package sqlite
 import (
 	"database/sql"
 	"fmt"
+	"github.com/go-jet/jet/v2/generator/ddl"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/generator/template"
 	"github.com/go-jet/jet/v2/internal/utils"
@@ -30,3 +31,22 @@ func GenerateDSN(dsn, destDir string, templates ...template.Template) (err error
 	template.ProcessSchema(destDir, schemaMetadata, generatorTemplate)
 	return
 }
+
+// GenerateDDL generates jet files at destination dir from DDL statements of the .sql file, or of the directory of
+// .sql files (schema dump or migrations), at ddlPath. Database connection is not needed, but only the tables are
+// generated, see ddl.Parse for details.
+func GenerateDDL(ddlPath, destDir string, templates ...template.Template) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	fmt.Println("Parsing DDL statements: " + ddlPath)
+	schemaMetadata, err := ddl.ParseFiles(ddlPath, ddl.SQLite, "")
+	throw.OnError(err)
+
+	generatorTemplate := template.Default(sqlite.Dialect)
+	if len(templates) > 0 {
+		generatorTemplate = templates[0]
+	}
+
+	template.ProcessSchema(destDir, schemaMetadata, generatorTemplate)
+	return
+}
@@ -32,7 +32,7 @@ type {{tableTemplate.TypeName}} struct {
 	//Columns
 {{- range $i, $c := .Columns}}
 {{- $field := columnField $c}}
-	{{$field.Name}} {{dialect.PackageName}}.Column{{$field.Type}}
+	{{$field.Name}} {{if $field.EnumType}}{{$field.EnumType}}Column{{else}}{{dialect.PackageName}}.Column{{$field.Type}}{{end}}
 {{- end}}
 
 	AllColumns     {{dialect.PackageName}}.ColumnList
@@ -48,12 +48,67 @@ func (a {{tableTemplate.TypeName}}) AS(alias string) {{tableTemplate.TypeName}}
 func (a {{tableTemplate.TypeName}}) FromSchema(schemaName string) {{tableTemplate.TypeName}} {
 	return new{{tableTemplate.TypeName}}(schemaName, a.TableName(), a.Alias())
 }
+{{- with .PrimaryKeyColumns}}
+
+// PrimaryKey returns primary key columns of {{tableTemplate.TypeName}}, in the primary key column order
+func (a {{tableTemplate.TypeName}}) PrimaryKey() {{dialect.PackageName}}.ColumnList {
+	return {{dialect.PackageName}}.ColumnList{ {{- range $i, $c := .}}{{- $field := columnField $c}}{{if gt $i 0}}, {{end}}a.{{$field.Name}}{{end -}} }
+}
+
+// ByPrimaryKey returns condition matching primary key columns with values, listed in the primary key column order
+func (a {{tableTemplate.TypeName}}) ByPrimaryKey(values ...{{dialect.PackageName}}.Expression) {{dialect.PackageName}}.BoolExpression {
+	return a.PrimaryKey().EQ(values...)
+}
+{{- end}}
+{{- range foreignKeys}}
+
+// INNER_JOIN_{{.MethodSuffix}} joins t ({{.RefTypeName}} table or its alias) on {{with .Name}}{{.}} {{end}}foreign key columns
+func (a {{tableTemplate.TypeName}}) INNER_JOIN_{{.MethodSuffix}}(t {{.RefTypeName}}) {{dialect.PackageName}}.ReadableTable {
+	return a.INNER_JOIN(t, a.FK_{{.MethodSuffix}}(t))
+}
+
+// LEFT_JOIN_{{.MethodSuffix}} left joins t ({{.RefTypeName}} table or its alias) on {{with .Name}}{{.}} {{end}}foreign key columns
+func (a {{tableTemplate.TypeName}}) LEFT_JOIN_{{.MethodSuffix}}(t {{.RefTypeName}}) {{dialect.PackageName}}.ReadableTable {
+	return a.LEFT_JOIN(t, a.FK_{{.MethodSuffix}}(t))
+}
+
+// FK_{{.MethodSuffix}} returns condition matching {{with .Name}}{{.}} {{end}}foreign key columns with referenced columns of t
+func (a {{tableTemplate.TypeName}}) FK_{{.MethodSuffix}}(t {{.RefTypeName}}) {{dialect.PackageName}}.BoolExpression {
+	return {{.Condition}}
+}
+{{- end}}
+{{- if tableTemplate.MetaData}}
+
+// ColumnDefaults returns column default value expressions of {{tableTemplate.TypeName}}, keyed by column name
+func (a {{tableTemplate.TypeName}}) ColumnDefaults() map[string]string {
+	return map[string]string{
+{{- range .Columns}}
+{{- if .DefaultExpression}}
+		{{printf "%q" .Name}}: {{printf "%q" .DefaultExpression}},
+{{- end}}
+{{- end}}
+	}
+}
+
+// CheckConstraints returns check constraint expressions of {{tableTemplate.TypeName}}, keyed by constraint name
+func (a {{tableTemplate.TypeName}}) CheckConstraints() map[string]string {
+	return map[string]string{
+{{- range .CheckConstraints}}
+		{{printf "%q" .Name}}: {{printf "%q" .Expression}},
+{{- end}}
+	}
+}
+{{- end}}
 
 func new{{tableTemplate.TypeName}}(schemaName, tableName, alias string) {{tableTemplate.TypeName}} {
 	var (
 {{- range $i, $c := .Columns}}
 {{- $field := columnField $c}}
+{{- if $field.EnumType}}
+		{{$field.Name}}Column = {{$field.EnumType}}Column{ {{- dialect.PackageName}}.{{$field.Type}}Column("{{$c.Name}}")}
+{{- else}}
 		{{$field.Name}}Column = {{dialect.PackageName}}.{{$field.Type}}Column("{{$c.Name}}")
+{{- end}}
 {{- end}}
 		allColumns     = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .Columns}} }
 		mutableColumns = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .MutableColumns}} }
@@ -96,14 +151,17 @@ type {{structImplName}} struct {
 	//Columns
 {{- range $i, $c := .Columns}}
 {{- $field := columnField $c}}
-	{{$field.Name}} {{dialect.PackageName}}.Column{{$field.Type}}
+	{{$field.Name}} {{if $field.EnumType}}{{$field.EnumType}}Column{{else}}{{dialect.PackageName}}.Column{{$field.Type}}{{end}}
 {{- end}}
 
 	AllColumns     {{dialect.PackageName}}.ColumnList
 	MutableColumns {{dialect.PackageName}}.ColumnList
 }
 
-type {{tableTemplate.TypeName}} struct {
+{{if .Hypertable}}// {{tableTemplate.TypeName}} is TimescaleDB hypertable
+{{else if .ContinuousAggregate}}// {{tableTemplate.TypeName}} is TimescaleDB continuous aggregate view
+{{else if .MaterializedView}}// {{tableTemplate.TypeName}} is materialized view
+{{end}}type {{tableTemplate.TypeName}} struct {
 	{{structImplName}}
 
 	EXCLUDED {{structImplName}}
@@ -118,6 +176,57 @@ func (a {{tableTemplate.TypeName}}) AS(alias string) *{{tableTemplate.TypeName}}
 func (a {{tableTemplate.TypeName}}) FromSchema(schemaName string) *{{tableTemplate.TypeName}} {
 	return new{{tableTemplate.TypeName}}(schemaName, a.TableName(), a.Alias())
 }
+{{- with .PrimaryKeyColumns}}
+
+// PrimaryKey returns primary key columns of {{structImplName}}, in the primary key column order
+func (a {{structImplName}}) PrimaryKey() {{dialect.PackageName}}.ColumnList {
+	return {{dialect.PackageName}}.ColumnList{ {{- range $i, $c := .}}{{- $field := columnField $c}}{{if gt $i 0}}, {{end}}a.{{$field.Name}}{{end -}} }
+}
+
+// ByPrimaryKey returns condition matching primary key columns with values, listed in the primary key column order
+func (a {{structImplName}}) ByPrimaryKey(values ...{{dialect.PackageName}}.Expression) {{dialect.PackageName}}.BoolExpression {
+	return a.PrimaryKey().EQ(values...)
+}
+{{- end}}
+{{- range foreignKeys}}
+
+// INNER_JOIN_{{.MethodSuffix}} joins t ({{.RefTypeName}} table or its alias) on {{with .Name}}{{.}} {{end}}foreign key columns
+func (a {{structImplName}}) INNER_JOIN_{{.MethodSuffix}}(t *{{.RefTypeName}}) {{dialect.PackageName}}.ReadableTable {
+	return a.INNER_JOIN(t, a.FK_{{.MethodSuffix}}(t))
+}
+
+// LEFT_JOIN_{{.MethodSuffix}} left joins t ({{.RefTypeName}} table or its alias) on {{with .Name}}{{.}} {{end}}foreign key columns
+func (a {{structImplName}}) LEFT_JOIN_{{.MethodSuffix}}(t *{{.RefTypeName}}) {{dialect.PackageName}}.ReadableTable {
+	return a.LEFT_JOIN(t, a.FK_{{.MethodSuffix}}(t))
+}
+
+// FK_{{.MethodSuffix}} returns condition matching {{with .Name}}{{.}} {{end}}foreign key columns with referenced columns of t
+func (a {{structImplName}}) FK_{{.MethodSuffix}}(t *{{.RefTypeName}}) {{dialect.PackageName}}.BoolExpression {
+	return {{.Condition}}
+}
+{{- end}}
+{{- if tableTemplate.MetaData}}
+
+// ColumnDefaults returns column default value expressions of {{tableTemplate.TypeName}}, keyed by column name
+func (a {{structImplName}}) ColumnDefaults() map[string]string {
+	return map[string]string{
+{{- range .Columns}}
+{{- if .DefaultExpression}}
+		{{printf "%q" .Name}}: {{printf "%q" .DefaultExpression}},
+{{- end}}
+{{- end}}
+	}
+}
+
+// CheckConstraints returns check constraint expressions of {{tableTemplate.TypeName}}, keyed by constraint name
+func (a {{structImplName}}) CheckConstraints() map[string]string {
+	return map[string]string{
+{{- range .CheckConstraints}}
+		{{printf "%q" .Name}}: {{printf "%q" .Expression}},
+{{- end}}
+	}
+}
+{{- end}}
 
 func new{{tableTemplate.TypeName}}(schemaName, tableName, alias string) *{{tableTemplate.TypeName}} {
 	return &{{tableTemplate.TypeName}}{
@@ -130,7 +239,11 @@ func new{{tableTemplate.TypeName}}Impl(schemaName, tableName, alias string) {{st
 	var (
 {{- range $i, $c := .Columns}}
 {{- $field := columnField $c}}
+{{- if $field.EnumType}}
+		{{$field.Name}}Column = {{$field.EnumType}}Column{ {{- dialect.PackageName}}.{{$field.Type}}Column("{{$c.Name}}")}
+{{- else}}
 		{{$field.Name}}Column = {{dialect.PackageName}}.{{$field.Type}}Column("{{$c.Name}}")
+{{- end}}
 {{- end}}
 		allColumns     = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .Columns}} }
 		mutableColumns = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .MutableColumns}} }
@@ -168,12 +281,60 @@ type {{$modelTableTemplate.TypeName}} struct {
 	{{$field.Name}} {{$field.Type.Name}} ` + "{{$field.TagsString}}" + `
 {{- end}}
 }
+{{- if $modelTableTemplate.Scanner}}
+{{- $tableName := .Name}}
+
+// ScanColumns returns result set columns ScanRow expects, in the order of {{.Name}} AllColumns projection.
+func (m *{{$modelTableTemplate.TypeName}}) ScanColumns() []string {
+	return []string{
+{{- range scanColumns}}
+		"{{$tableName}}.{{.Name}}",
+{{- end}}
+	}
+}
+
+// ScanRow scans current result set row into the model, without reflection. Values of columns without model field
+// are discarded.
+func (m *{{$modelTableTemplate.TypeName}}) ScanRow(rows *sql.Rows) error {
+	return rows.Scan(
+{{- range scanColumns}}
+{{- $field := structField .}}
+{{- if $field.Skip}}
+		new(interface{}), // {{.Name}}
+{{- else}}
+		&m.{{$field.Name}},
+{{- end}}
+{{- end}}
+	)
+}
+{{- end}}
 
 `
 
 var enumSQLBuilderTemplate = `package {{package}}
+{{- $typeName := enumTypeName}}
 
 import "github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+{{- if typedEnums}}
+
+// {{$typeName}}Value is {{.Name}} enum value
+type {{$typeName}}Value struct {
+	{{dialect.PackageName}}.StringExpression
+}
+
+// Is{{$typeName}}Enum marks {{$typeName}}Value as {{.Name}} enum expression
+func ({{$typeName}}Value) Is{{$typeName}}Enum() {}
+
+var {{enumTemplate.InstanceName}} = &struct {
+{{- range $index, $value := .Values}}
+	{{enumValueName $value}} {{$typeName}}Value
+{{- end}}
+} {
+{{- range $index, $value := .Values}}
+	{{enumValueName $value}}: {{$typeName}}Value{ {{- dialect.PackageName}}.NewEnumValue("{{$value}}")},
+{{- end}}
+}
+{{- else}}
 
 var {{enumTemplate.InstanceName}} = &struct {
 {{- range $index, $value := .Values}}
@@ -184,12 +345,17 @@ var {{enumTemplate.InstanceName}} = &struct {
 	{{enumValueName $value}}: {{dialect.PackageName}}.NewEnumValue("{{$value}}"),
 {{- end}}
 }
+{{- end}}
 `
 
 var enumModelTemplate = `package {{package}}
 {{- $enumTemplate := enumTemplate}}
 
-import "errors"
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+)
 
 type {{$enumTemplate.TypeName}} string
 
@@ -199,6 +365,15 @@ const (
 {{- end}}
 )
 
+// AllValues returns all the {{$enumTemplate.TypeName}} enum values, in the enum declaration order
+func (e {{$enumTemplate.TypeName}}) AllValues() []{{$enumTemplate.TypeName}} {
+	return []{{$enumTemplate.TypeName}}{
+{{- range $_, $value := .Values}}
+		{{valueName $value}},
+{{- end}}
+	}
+}
+
 func (e *{{$enumTemplate.TypeName}}) Scan(value interface{}) error {
 	var enumValue string
 	switch val := value.(type) {
@@ -207,7 +382,7 @@ func (e *{{$enumTemplate.TypeName}}) Scan(value interface{}) error {
 	case []byte:
 		enumValue = string(val)
 	default:
-		return errors.New("jet: Invalid scan value for AllTypesEnum enum. Enum value has to be of type string or []byte")
+		return errors.New("jet: Invalid scan value for {{$enumTemplate.TypeName}} enum. Enum value has to be of type string or []byte")
 	}
 
 	switch enumValue {
@@ -222,8 +397,225 @@ func (e *{{$enumTemplate.TypeName}}) Scan(value interface{}) error {
 	return nil
 }
 
+func (e {{$enumTemplate.TypeName}}) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
 func (e {{$enumTemplate.TypeName}}) String() string {
 	return string(e)
 }
 
+func (e {{$enumTemplate.TypeName}}) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(e))
+}
+
+func (e *{{$enumTemplate.TypeName}}) UnmarshalJSON(data []byte) error {
+	var value string
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return e.Scan(value)
+}
+
+`
+
+var tableRepositoryTemplate = `package {{package}}
+{{- $repository := repositoryTemplate}}
+{{- $model := modelType}}
+{{- $table := tableInstance}}
+
+import (
+	"context"
+	"database/sql"
+{{- range extraImports}}
+	"{{.}}"
+{{- end}}
+
+	. "github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+	"github.com/go-jet/jet/v2/qrm"
+
+	"{{modelImportPath}}"
+	"{{tableImportPath}}"
+)
+
+// {{$repository.TypeName}} contains basic CRUD functions for the {{.Name}} table. For anything more complex,
+// use {{$table}} sql builder directly.
+type {{$repository.TypeName}} struct {
+	DB qrm.DB
+}
+{{- if primaryKeys}}
+
+// GetByPK returns {{.Name}} row with the matching primary key.
+func (r {{$repository.TypeName}}) GetByPK(ctx context.Context, {{pkParams}}) ({{$model}}, error) {
+	var dest {{$model}}
+
+	err := SELECT({{$table}}.AllColumns).
+		FROM({{$table}}).
+		WHERE({{pkCondition false}}).
+		QueryContext(ctx, r.DB, &dest)
+
+	return dest, err
+}
+{{- end}}
+
+// List returns {{.Name}} rows matching the condition (if not nil), ordered by orderBy clauses.
+func (r {{$repository.TypeName}}) List(ctx context.Context, condition BoolExpression, orderBy ...OrderByClause) ([]{{$model}}, error) {
+	stmt := SELECT({{$table}}.AllColumns).
+		FROM({{$table}})
+
+	if condition != nil {
+		stmt = stmt.WHERE(condition)
+	}
+
+	var dest []{{$model}}
+
+	err := stmt.ORDER_BY(orderBy...).QueryContext(ctx, r.DB, &dest)
+
+	return dest, err
+}
+
+// Insert inserts {{.Name}} model into the table.{{if autoIncrement}} Primary key column is skipped, because it is expected to be auto generated.{{end}}
+func (r {{$repository.TypeName}}) Insert(ctx context.Context, m {{$model}}) (sql.Result, error) {
+	return {{$table}}.INSERT({{$table}}.{{if autoIncrement}}MutableColumns{{else}}AllColumns{{end}}).
+		MODEL(m).
+		ExecContext(ctx, r.DB)
+}
+{{- if primaryKeys}}
+{{- if .MutableColumns}}
+
+// Update updates all the non primary key columns of the {{.Name}} row with the primary key of the model.
+func (r {{$repository.TypeName}}) Update(ctx context.Context, m {{$model}}) (sql.Result, error) {
+	return {{$table}}.UPDATE({{$table}}.MutableColumns).
+		MODEL(m).
+		WHERE({{pkCondition true}}).
+		ExecContext(ctx, r.DB)
+}
+{{- end}}
+
+// Delete deletes {{.Name}} row with the matching primary key.
+func (r {{$repository.TypeName}}) Delete(ctx context.Context, {{pkParams}}) (sql.Result, error) {
+	return {{$table}}.DELETE().
+		WHERE({{pkCondition false}}).
+		ExecContext(ctx, r.DB)
+}
+{{- end}}
+`
+
+var tableProtoConversionTemplate = `package {{package}}
+{{- $proto := protoTemplate}}
+{{- $model := modelType}}
+
+import (
+{{- range extraImports}}
+	"{{.}}"
+{{- end}}
+
+	pb "{{protoImportPath}}"
+
+	"{{modelImportPath}}"
+)
+
+// {{modelTypeName}}ToProto converts {{.Name}} model into {{$proto.MessageName}} protobuf message.
+func {{modelTypeName}}ToProto(m *{{$model}}) *pb.{{$proto.MessageName}} {
+	if m == nil {
+		return nil
+	}
+
+	p := &pb.{{$proto.MessageName}}{}
+{{- range fields}}
+{{- if .Unsupported}}
+	// {{.Unsupported}} is not supported
+{{- else}}
+	{{.ToProto}}
+{{- end}}
+{{- end}}
+
+	return p
+}
+
+// {{modelTypeName}}FromProto converts {{$proto.MessageName}} protobuf message into {{.Name}} model.
+func {{modelTypeName}}FromProto(p *pb.{{$proto.MessageName}}) (*{{$model}}, error) {
+	if p == nil {
+		return nil, nil
+	}
+{{- if needsErr}}
+
+	var err error
+{{- end}}
+
+	m := &{{$model}}{}
+{{- range fields}}
+{{- if .Unsupported}}
+	// {{.Unsupported}} is not supported
+{{- else}}
+	{{.FromProto}}
+{{- end}}
+{{- end}}
+
+	return m, nil
+}
+`
+
+var enumColumnsTemplate = `package {{package}}
+
+import "github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+{{- range .}}
+
+// {{.}}Expression is expression of {{.}} enum type. It is implemented by {{.}}Column and by {{.}} enum values.
+type {{.}}Expression interface {
+	{{dialect.PackageName}}.Expression
+	Is{{.}}Enum()
+}
+
+// {{.}}Column is column of {{.}} enum type, which can be compared only with {{.}} enum expressions
+type {{.}}Column struct {
+	{{dialect.PackageName}}.ColumnString
+}
+
+// Is{{.}}Enum marks {{.}}Column as {{.}} enum expression
+func ({{.}}Column) Is{{.}}Enum() {}
+
+// EQ checks if column is equal to rhs
+func (c {{.}}Column) EQ(rhs {{.}}Expression) {{dialect.PackageName}}.BoolExpression {
+	return c.ColumnString.EQ({{dialect.PackageName}}.StringExp(rhs))
+}
+
+// NOT_EQ checks if column is not equal to rhs
+func (c {{.}}Column) NOT_EQ(rhs {{.}}Expression) {{dialect.PackageName}}.BoolExpression {
+	return c.ColumnString.NOT_EQ({{dialect.PackageName}}.StringExp(rhs))
+}
+
+// IS_DISTINCT_FROM checks if column is distinct from rhs, treating NULL like an ordinary value
+func (c {{.}}Column) IS_DISTINCT_FROM(rhs {{.}}Expression) {{dialect.PackageName}}.BoolExpression {
+	return c.ColumnString.IS_DISTINCT_FROM({{dialect.PackageName}}.StringExp(rhs))
+}
+
+// IS_NOT_DISTINCT_FROM checks if column is not distinct from rhs, treating NULL like an ordinary value
+func (c {{.}}Column) IS_NOT_DISTINCT_FROM(rhs {{.}}Expression) {{dialect.PackageName}}.BoolExpression {
+	return c.ColumnString.IS_NOT_DISTINCT_FROM({{dialect.PackageName}}.StringExp(rhs))
+}
+
+// SET creates column assigment, for UPDATE and ON CONFLICT/ON DUPLICATE KEY clauses
+func (c {{.}}Column) SET(rhs {{.}}Expression) {{dialect.PackageName}}.ColumnAssigment {
+	return c.ColumnString.SET({{dialect.PackageName}}.StringExp(rhs))
+}
+
+// From creates new {{.}}Column exported from sub-query
+func (c {{.}}Column) From(subQuery {{dialect.PackageName}}.SelectTable) {{.}}Column {
+	return {{.}}Column{c.ColumnString.From(subQuery)}
+}
+{{- end}}
+`
+
+var installedExtensionsTemplate = `package {{package}}
+
+// InstalledExtensions is the set of database extensions installed at the time of generation. Functions depending
+// on an extension, like postgres.SIMILARITY of pg_trgm extension, can be gated on it.
+var InstalledExtensions = map[string]bool{
+{{- range .}}
+	{{printf "%q" .}}: true,
+{{- end}}
+}
 `
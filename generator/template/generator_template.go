@@ -30,6 +30,11 @@ type Schema struct {
 	Path       string
 	Model      Model
 	SQLBuilder SQLBuilder
+	Repository Repository
+	Proto      ProtoConversion
+	// SnapshotFileName is a name of json file with schema metadata snapshot. If set, snapshot is saved in the
+	// schema directory and can be used to check statements against the schema without database connection.
+	SnapshotFileName string
 }
 
 // UsePath replaces path and returns new schema template
@@ -50,11 +55,126 @@ func (s Schema) UseSQLBuilder(sqlBuilder SQLBuilder) Schema {
 	return s
 }
 
+// UseRepository returns new schema with replaced template for repository files generation
+func (s Schema) UseRepository(repository Repository) Schema {
+	s.Repository = repository
+	return s
+}
+
+// UseProto returns new schema with replaced template for protobuf conversion files generation
+func (s Schema) UseProto(proto ProtoConversion) Schema {
+	s.Proto = proto
+	return s
+}
+
+// UseSnapshotFileName returns new schema template which also saves schema metadata snapshot into fileName
+func (s Schema) UseSnapshotFileName(fileName string) Schema {
+	s.SnapshotFileName = fileName
+	return s
+}
+
+// UseColumnRenames returns new schema template in which columns are generated with Go field names from renames,
+// in both model and sql builder types (for instance "usr_nm" -> "UserName"). Renames are keyed by column name, or
+// by "table.column" for renames of a single table (or view) column. Statements still use database column names,
+// and renamed model fields are tagged with column alias, so query results are mapped into renamed fields.
+// UseColumnRenames wraps current model and sql builder templates, so it has to be called after UseModel and
+// UseSQLBuilder.
+func (s Schema) UseColumnRenames(renames map[string]string) Schema {
+	renamed := func(table metadata.Table, column metadata.Column) (string, bool) {
+		if name, ok := renames[table.Name+"."+column.Name]; ok {
+			return name, true
+		}
+
+		name, ok := renames[column.Name]
+		return name, ok
+	}
+
+	renameModelFields := func(tableFunc func(table metadata.Table) TableModel) func(table metadata.Table) TableModel {
+		return func(table metadata.Table) TableModel {
+			tableModel := tableFunc(table)
+
+			if tableModel.Skip || tableModel.Field == nil {
+				return tableModel
+			}
+
+			fieldFunc := tableModel.Field
+
+			return tableModel.UseField(func(column metadata.Column) TableModelField {
+				field := fieldFunc(column)
+
+				if name, ok := renamed(table, column); ok {
+					field = field.UseName(name).UseTags(`alias:"` + column.Name + `"`)
+				}
+
+				return field
+			})
+		}
+	}
+
+	renameSQLBuilderColumns := func(tableFunc func(table metadata.Table) TableSQLBuilder) func(table metadata.Table) TableSQLBuilder {
+		return func(table metadata.Table) TableSQLBuilder {
+			tableSQLBuilder := tableFunc(table)
+
+			if tableSQLBuilder.Skip || tableSQLBuilder.Column == nil {
+				return tableSQLBuilder
+			}
+
+			columnFunc := tableSQLBuilder.Column
+
+			return tableSQLBuilder.UseColumn(func(column metadata.Column) TableSQLBuilderColumn {
+				sqlBuilderColumn := columnFunc(column)
+
+				if name, ok := renamed(table, column); ok {
+					sqlBuilderColumn.Name = name
+				}
+
+				return sqlBuilderColumn
+			})
+		}
+	}
+
+	s.Model.Table = renameModelFields(s.Model.Table)
+	s.Model.View = renameModelFields(s.Model.View)
+	s.SQLBuilder.Table = renameSQLBuilderColumns(s.SQLBuilder.Table)
+	s.SQLBuilder.View = renameSQLBuilderColumns(s.SQLBuilder.View)
+
+	return s
+}
+
+// UseModelFieldHook returns new schema template in which each table and view model field is passed through hook
+// before model files are rendered. Hook can add struct tags (for instance json or validate tags), rename the field,
+// change its type or skip the column with TableModelField.UseSkip. UseModelFieldHook wraps current model template,
+// so it has to be called after UseModel.
+func (s Schema) UseModelFieldHook(hook func(table metadata.Table, column metadata.Column, field TableModelField) TableModelField) Schema {
+	hookModelFields := func(tableFunc func(table metadata.Table) TableModel) func(table metadata.Table) TableModel {
+		return func(table metadata.Table) TableModel {
+			tableModel := tableFunc(table)
+
+			if tableModel.Skip || tableModel.Field == nil {
+				return tableModel
+			}
+
+			fieldFunc := tableModel.Field
+
+			return tableModel.UseField(func(column metadata.Column) TableModelField {
+				return hook(table, column, fieldFunc(column))
+			})
+		}
+	}
+
+	s.Model.Table = hookModelFields(s.Model.Table)
+	s.Model.View = hookModelFields(s.Model.View)
+
+	return s
+}
+
 // DefaultSchema returns default schema template implementation
 func DefaultSchema(schemaMetaData metadata.Schema) Schema {
 	return Schema{
 		Path:       schemaMetaData.Name,
 		Model:      DefaultModel(),
 		SQLBuilder: DefaultSQLBuilder(),
+		Repository: DefaultRepository(),
+		Proto:      DefaultProtoConversion(),
 	}
 }
@@ -66,6 +66,11 @@ type TableModel struct {
 	FileName string
 	TypeName string
 	Field    func(columnMetaData metadata.Column) TableModelField
+	// Scanner, if set, generates ScanColumns and ScanRow model methods, used by QueryFast statement method to scan
+	// query result without reflection.
+	Scanner bool
+	// FileTemplate, if set, is text/template used to generate model file instead of DefaultTableModelFileTemplate
+	FileTemplate string
 }
 
 // ViewModel is template for view model files generation
@@ -101,10 +106,41 @@ func (t TableModel) UseField(structFieldFunc func(columnMetaData metadata.Column
 	return t
 }
 
+// UseScanner returns new TableModel with scanner methods generation turned on or off
+func (t TableModel) UseScanner(scanner bool) TableModel {
+	t.Scanner = scanner
+	return t
+}
+
+// UseFileTemplate returns new TableModel with model file generated from fileTemplate. File template is executed with
+// table metadata.Table as data, see DefaultTableModelFileTemplate for available template functions.
+func (t TableModel) UseFileTemplate(fileTemplate string) TableModel {
+	t.FileTemplate = fileTemplate
+	return t
+}
+
+// modelColumns returns table metadata without the columns whose model field is skipped
+func modelColumns(modelType TableModel, tableMetaData metadata.Table) metadata.Table {
+	var columns []metadata.Column
+
+	for _, columnMetaData := range tableMetaData.Columns {
+		if !modelType.Field(columnMetaData).Skip {
+			columns = append(columns, columnMetaData)
+		}
+	}
+
+	tableMetaData.Columns = columns
+
+	return tableMetaData
+}
+
 func getTableModelImports(modelType TableModel, tableMetaData metadata.Table) []string {
 	importPaths := map[string]bool{}
 	for _, columnMetaData := range tableMetaData.Columns {
 		field := modelType.Field(columnMetaData)
+		if field.Skip {
+			continue
+		}
 		importPath := field.Type.ImportPath
 
 		if importPath != "" {
@@ -112,6 +148,10 @@ func getTableModelImports(modelType TableModel, tableMetaData metadata.Table) []
 		}
 	}
 
+	if modelType.Scanner {
+		importPaths["database/sql"] = true
+	}
+
 	var ret []string
 	for importPath := range importPaths {
 		ret = append(ret, importPath)
@@ -126,6 +166,8 @@ type EnumModel struct {
 	FileName  string
 	TypeName  string
 	ValueName func(value string) string
+	// FileTemplate, if set, is text/template used to generate enum model file instead of DefaultEnumModelFileTemplate
+	FileTemplate string
 }
 
 // UseFileName returns new EnumModel with new file name set
@@ -140,6 +182,13 @@ func (em EnumModel) UseTypeName(typeName string) EnumModel {
 	return em
 }
 
+// UseFileTemplate returns new EnumModel with enum model file generated from fileTemplate. File template is executed
+// with enum metadata.Enum as data, see DefaultEnumModelFileTemplate for available template functions.
+func (em EnumModel) UseFileTemplate(fileTemplate string) EnumModel {
+	em.FileTemplate = fileTemplate
+	return em
+}
+
 // DefaultEnumModel returns default implementation for EnumModel
 func DefaultEnumModel(enumMetaData metadata.Enum) EnumModel {
 	typeName := utils.ToGoIdentifier(enumMetaData.Name)
@@ -158,6 +207,8 @@ type TableModelField struct {
 	Name string
 	Type Type
 	Tags []string
+	// Skip, if set, column is left out of the model type
+	Skip bool
 }
 
 // DefaultTableModelField returns default TableModelField implementation
@@ -193,6 +244,21 @@ func (f TableModelField) UseTags(tags ...string) TableModelField {
 	return f
 }
 
+// UseJSONType returns new TableModelField implementation with field type t, stored into json column. Field value is
+// marshaled to json text when model is inserted or updated, and unmarshaled from json column when query result is
+// mapped into the model. For nullable columns t should be a pointer type, for instance NewType(&MyStruct{}).
+func (f TableModelField) UseJSONType(t Type) TableModelField {
+	f.Type = t
+	f.Tags = append(f.Tags, `sql:"json"`)
+	return f
+}
+
+// UseSkip returns new TableModelField implementation, which is left out of the model type if skip is true.
+func (f TableModelField) UseSkip(skip bool) TableModelField {
+	f.Skip = skip
+	return f
+}
+
 // TagsString returns tags string representation
 func (f TableModelField) TagsString() string {
 	if len(f.Tags) == 0 {
@@ -310,6 +376,7 @@ func toGoType(column metadata.Column) interface{} {
 		"tsvector", "bit", "bit varying", "varbit",
 		"money", "json", "jsonb",
 		"xml", "point", "interval", "line", "array",
+		"citext",                                     // PostgreSQL citext extension
 		"char", "tinytext", "mediumtext", "longtext": // MySQL
 		return ""
 	case "real", "float4":
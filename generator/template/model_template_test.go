@@ -2,7 +2,11 @@ package template
 
 import (
 	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path"
 	"testing"
 )
 
@@ -42,4 +46,186 @@ func Test_TableModelField(t *testing.T) {
 		},
 		Tags: nil,
 	})
+
+	type filmMetadata struct {
+		Rating int
+	}
+
+	require.Equal(t, DefaultTableModelField(metadata.Column{
+		Name:       "metadata",
+		IsNullable: true,
+		DataType: metadata.DataType{
+			Name: "jsonb",
+			Kind: "base",
+		},
+	}).UseJSONType(NewType(&filmMetadata{})), TableModelField{
+		Name: "Metadata",
+		Type: Type{
+			ImportPath: "github.com/go-jet/jet/v2/generator/template",
+			Name:       "*template.filmMetadata",
+		},
+		Tags: []string{`sql:"json"`},
+	})
+}
+
+func TestProcessSchema_ModelScanner(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_model_scanner")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "actor",
+				Columns: []metadata.Column{
+					{Name: "actor_id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+					{Name: "last_update", IsNullable: true, DataType: metadata.DataType{Name: "timestamp", Kind: metadata.BaseType}},
+					{Name: "password_hash", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+				},
+			},
+			{
+				Name:    "log",
+				Columns: []metadata.Column{{Name: "level", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}}},
+			},
+		},
+	}
+
+	generatorTemplate := Default(postgres.Dialect).
+		UseSchema(func(schemaMetaData metadata.Schema) Schema {
+			return DefaultSchema(schemaMetaData).
+				UseModel(DefaultModel().UseTable(func(table metadata.Table) TableModel {
+					return DefaultTableModel(table).UseScanner(table.Name == "actor").
+						UseField(func(column metadata.Column) TableModelField {
+							return DefaultTableModelField(column).UseSkip(column.Name == "password_hash")
+						})
+				}))
+		})
+
+	ProcessSchema(dirPath, schema, generatorTemplate)
+
+	actor := readGeneratedFile(t, path.Join(dirPath, "store", "model", "actor.go"))
+	require.Contains(t, actor, `"database/sql"`)
+	require.Contains(t, actor, `func (m *Actor) ScanColumns() []string {
+	return []string{
+		"actor.actor_id",
+		"actor.last_update",
+		"actor.password_hash",
+	}
+}`)
+	require.Contains(t, actor, `func (m *Actor) ScanRow(rows *sql.Rows) error {
+	return rows.Scan(
+		&m.ActorID,
+		&m.LastUpdate,
+		new(interface{}), // password_hash
+	)
+}`)
+	require.NotContains(t, actor, "PasswordHash")
+
+	log := readGeneratedFile(t, path.Join(dirPath, "store", "model", "log.go"))
+	require.NotContains(t, log, "ScanRow")
+}
+
+func TestProcessSchema_ColumnRenames(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_model")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	column := func(name string) metadata.Column {
+		return metadata.Column{Name: name, DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}}
+	}
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{Name: "users", Columns: []metadata.Column{column("usr_nm"), column("crt_dt")}},
+			{Name: "orders", Columns: []metadata.Column{column("usr_nm"), column("crt_dt")}},
+		},
+	}
+
+	generatorTemplate := Default(postgres.Dialect).
+		UseSchema(func(schemaMetaData metadata.Schema) Schema {
+			return DefaultSchema(schemaMetaData).
+				UseColumnRenames(map[string]string{
+					"usr_nm":        "UserName",
+					"orders.crt_dt": "OrderedAt",
+				})
+		})
+
+	ProcessSchema(dirPath, schema, generatorTemplate)
+
+	users := readGeneratedFile(t, path.Join(dirPath, "store", "model", "users.go"))
+	require.Contains(t, users, "UserName string `alias:\"usr_nm\"`")
+	require.Contains(t, users, "CrtDt    string\n")
+
+	orders := readGeneratedFile(t, path.Join(dirPath, "store", "model", "orders.go"))
+	require.Contains(t, orders, "OrderedAt string `alias:\"crt_dt\"`")
+
+	usersTable := readGeneratedFile(t, path.Join(dirPath, "store", "table", "users.go"))
+	require.Contains(t, usersTable, "UserName postgres.ColumnString")
+	require.Contains(t, usersTable, `UserNameColumn = postgres.StringColumn("usr_nm")`)
+
+	ordersTable := readGeneratedFile(t, path.Join(dirPath, "store", "table", "orders.go"))
+	require.Contains(t, ordersTable, `OrderedAtColumn = postgres.StringColumn("crt_dt")`)
+}
+
+func TestProcessSchema_ModelFieldHookAndFileTemplates(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_model")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{Name: "users", Columns: []metadata.Column{
+				{Name: "id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				{Name: "email", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+				{Name: "password_hash", DataType: metadata.DataType{Name: "bytea", Kind: metadata.BaseType}},
+			}},
+		},
+		EnumsMetaData: []metadata.Enum{
+			{Name: "mood", Values: []string{"happy", "sad"}},
+		},
+	}
+
+	generatorTemplate := Default(postgres.Dialect).
+		UseSchema(func(schemaMetaData metadata.Schema) Schema {
+			return DefaultSchema(schemaMetaData).
+				UseModel(DefaultModel().
+					UseEnum(func(enum metadata.Enum) EnumModel {
+						return DefaultEnumModel(enum).
+							UseFileTemplate("package {{package}}\n\n// {{enumTemplate.TypeName}} values: {{range .Values}}{{valueName .}} {{end}}\n")
+					}),
+				).
+				UseSQLBuilder(DefaultSQLBuilder().
+					UseTable(func(table metadata.Table) TableSQLBuilder {
+						return DefaultTableSQLBuilder(table).
+							UseFileTemplate(DefaultTableSQLBuilderFileTemplate(postgres.Dialect) + "\n// custom footer\n")
+					}),
+				).
+				UseModelFieldHook(func(table metadata.Table, column metadata.Column, field TableModelField) TableModelField {
+					if column.Name == "email" {
+						field = field.UseTags(`validate:"email"`)
+					}
+
+					return field.
+						UseTags(`json:"` + column.Name + `"`).
+						UseSkip(column.Name == "password_hash")
+				})
+		})
+
+	ProcessSchema(dirPath, schema, generatorTemplate)
+
+	users := readGeneratedFile(t, path.Join(dirPath, "store", "model", "users.go"))
+	require.Contains(t, users, "ID    int32  `sql:\"primary_key\" json:\"id\"`")
+	require.Contains(t, users, "Email string `validate:\"email\" json:\"email\"`")
+	require.NotContains(t, users, "PasswordHash")
+
+	mood := readGeneratedFile(t, path.Join(dirPath, "store", "model", "mood.go"))
+	require.Contains(t, mood, "Code generated by go-jet DO NOT EDIT.")
+	require.Contains(t, mood, "// Mood values: Mood_Happy Mood_Sad")
+
+	usersTable := readGeneratedFile(t, path.Join(dirPath, "store", "table", "users.go"))
+	require.Contains(t, usersTable, `PasswordHashColumn = postgres.StringColumn("password_hash")`)
+	require.Contains(t, usersTable, "// custom footer")
 }
@@ -2,12 +2,14 @@ package template
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/internal/jet"
 	"github.com/go-jet/jet/v2/internal/utils"
 	"github.com/go-jet/jet/v2/internal/utils/throw"
 	"path"
+	"sort"
 	"strings"
 	"text/template"
 )
@@ -28,6 +30,23 @@ func ProcessSchema(dirPath string, schemaMetaData metadata.Schema, generatorTemp
 
 	processModel(schemaPath, schemaMetaData, schemaTemplate)
 	processSQLBuilder(schemaPath, generatorTemplate.Dialect, schemaMetaData, schemaTemplate)
+	processRepository(schemaPath, generatorTemplate.Dialect, schemaMetaData, schemaTemplate)
+	processProto(schemaPath, schemaMetaData, schemaTemplate)
+	processSnapshot(schemaPath, schemaMetaData, schemaTemplate)
+}
+
+func processSnapshot(dirPath string, schemaMetaData metadata.Schema, schemaTemplate Schema) {
+	if schemaTemplate.SnapshotFileName == "" {
+		return
+	}
+
+	fmt.Println("Saving schema snapshot...")
+
+	err := utils.EnsureDirPath(dirPath)
+	throw.OnError(err)
+
+	err = metadata.SaveSchema(path.Join(dirPath, schemaTemplate.SnapshotFileName), schemaMetaData)
+	throw.OnError(err)
 }
 
 func processModel(dirPath string, schemaMetaData metadata.Schema, schemaTemplate Schema) {
@@ -83,7 +102,7 @@ func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []
 		throw.OnError(err)
 
 		text, err := generateTemplate(
-			autoGenWarningTemplate+enumSQLBuilderTemplate,
+			fileTemplate(enumTemplate.FileTemplate, enumSQLBuilderTemplate),
 			enumMetaData,
 			template.FuncMap{
 				"package": func() string {
@@ -95,6 +114,12 @@ func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []
 				"enumTemplate": func() EnumSQLBuilder {
 					return enumTemplate
 				},
+				"enumTypeName": func() string {
+					return utils.ToGoIdentifier(enumMetaData.Name)
+				},
+				"typedEnums": func() bool {
+					return sqlBuilder.TypedEnums
+				},
 				"enumValueName": func(enumValue string) string {
 					return enumTemplate.ValueName(enumValue)
 				},
@@ -118,6 +143,9 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 
 	fmt.Printf("Generating %s sql builder files\n", fileTypes)
 
+	tablePackages := map[string]string{}
+	enumTypes := map[string][]string{}
+
 	for _, tableMetaData := range tablesMetaData {
 
 		var tableSQLBuilderTemplate TableSQLBuilder
@@ -132,13 +160,17 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 			continue
 		}
 
+		if sqlBuilderTemplate.TypedEnums {
+			tableSQLBuilderTemplate.Column = typedEnumColumn(tableSQLBuilderTemplate.Column)
+		}
+
 		tableSQLBuilderPath := path.Join(dirPath, tableSQLBuilderTemplate.Path)
 
 		err := utils.EnsureDirPath(tableSQLBuilderPath)
 		throw.OnError(err)
 
 		text, err := generateTemplate(
-			autoGenWarningTemplate+getTableSQLBuilderTemplate(dialect),
+			fileTemplate(tableSQLBuilderTemplate.FileTemplate, DefaultTableSQLBuilderFileTemplate(dialect)),
 			tableMetaData,
 			template.FuncMap{
 				"package": func() string {
@@ -148,6 +180,9 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 					return dialect
 				},
 				"schemaName": func() string {
+					if sqlBuilderTemplate.SchemaName != "" {
+						return sqlBuilderTemplate.SchemaName
+					}
 					return schemaMetaData.Name
 				},
 				"tableTemplate": func() TableSQLBuilder {
@@ -160,15 +195,252 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 				"columnField": func(columnMetaData metadata.Column) TableSQLBuilderColumn {
 					return tableSQLBuilderTemplate.Column(columnMetaData)
 				},
+				"foreignKeys": func() []tableSQLBuilderForeignKey {
+					return getTableSQLBuilderForeignKeys(tableMetaData, tableSQLBuilderTemplate, schemaMetaData, sqlBuilderTemplate)
+				},
 			})
 		throw.OnError(err)
 
 		err = utils.SaveGoFile(tableSQLBuilderPath, tableSQLBuilderTemplate.FileName, text)
 		throw.OnError(err)
+
+		tablePackages[tableSQLBuilderPath] = tableSQLBuilderTemplate.PackageName()
+
+		for _, column := range tableMetaData.Columns {
+			enumType := tableSQLBuilderTemplate.Column(column).EnumType
+
+			if enumType != "" && !utils.StringSliceContains(enumTypes[tableSQLBuilderPath], enumType) {
+				enumTypes[tableSQLBuilderPath] = append(enumTypes[tableSQLBuilderPath], enumType)
+			}
+		}
+	}
+
+	processEnumColumns(dialect, tablePackages, enumTypes)
+
+	if fileTypes == "table" {
+		processInstalledExtensions(dirPath, schemaMetaData.Extensions)
+	}
+}
+
+// typedEnumColumn returns column template function which sets EnumType of enum columns
+func typedEnumColumn(columnFunc func(columnMetaData metadata.Column) TableSQLBuilderColumn) func(columnMetaData metadata.Column) TableSQLBuilderColumn {
+	return func(columnMetaData metadata.Column) TableSQLBuilderColumn {
+		column := columnFunc(columnMetaData)
+
+		if columnMetaData.DataType.Kind == metadata.EnumType && column.EnumType == "" {
+			column.EnumType = utils.ToGoIdentifier(columnMetaData.DataType.Name)
+		}
+
+		return column
+	}
+}
+
+// processEnumColumns generates typed enum column types, used by the table sql builder types, in each of the
+// table sql builder directories
+func processEnumColumns(dialect jet.Dialect, tablePackages map[string]string, enumTypes map[string][]string) {
+	for dirPath, types := range enumTypes {
+		packageName := tablePackages[dirPath]
+		sort.Strings(types)
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+enumColumnsTemplate,
+			types,
+			template.FuncMap{
+				"package": func() string {
+					return packageName
+				},
+				"dialect": func() jet.Dialect {
+					return dialect
+				},
+			})
+		throw.OnError(err)
+
+		err = utils.SaveGoFile(dirPath, "enum_columns", text)
+		throw.OnError(err)
+	}
+}
+
+// processInstalledExtensions generates installed extensions file once per schema, in the default table sql builder
+// directory, regardless of the table sql builder paths
+func processInstalledExtensions(dirPath string, extensions []string) {
+	if len(extensions) == 0 {
+		return
 	}
+
+	tableSQLBuilder := DefaultTableSQLBuilder(metadata.Table{})
+	extensionsPath := path.Join(dirPath, tableSQLBuilder.Path)
+
+	err := utils.EnsureDirPath(extensionsPath)
+	throw.OnError(err)
+
+	text, err := generateTemplate(
+		autoGenWarningTemplate+installedExtensionsTemplate,
+		extensions,
+		template.FuncMap{
+			"package": func() string {
+				return tableSQLBuilder.PackageName()
+			},
+		})
+	throw.OnError(err)
+
+	err = utils.SaveGoFile(extensionsPath, "installed_extensions", text)
+	throw.OnError(err)
 }
 
-func getTableSQLBuilderTemplate(dialect jet.Dialect) string {
+func processRepository(dirPath string, dialect jet.Dialect, schemaMetaData metadata.Schema, schemaTemplate Schema) {
+	repositoryTemplate := schemaTemplate.Repository
+
+	if repositoryTemplate.Skip || len(schemaMetaData.TablesMetaData) == 0 {
+		return
+	}
+
+	if repositoryTemplate.ImportPath == "" {
+		throw.OnError(errors.New("jet: repository import path is not set"))
+	}
+
+	fmt.Println("Generating table repository files...")
+
+	repositoryPath := path.Join(dirPath, repositoryTemplate.Path)
+
+	err := utils.EnsureDirPath(repositoryPath)
+	throw.OnError(err)
+
+	modelImportPath := path.Join(repositoryTemplate.ImportPath, schemaTemplate.Model.Path)
+
+	for _, tableMetaData := range schemaMetaData.TablesMetaData {
+		tableRepository := repositoryTemplate.Table(tableMetaData)
+		tableModel := schemaTemplate.Model.Table(tableMetaData)
+		tableSQLBuilder := schemaTemplate.SQLBuilder.Table(tableMetaData)
+
+		if tableRepository.Skip || tableModel.Skip || tableSQLBuilder.Skip {
+			continue
+		}
+
+		tableImportPath := path.Join(repositoryTemplate.ImportPath, schemaTemplate.SQLBuilder.Path, tableSQLBuilder.Path)
+		tableInstance := tableSQLBuilder.PackageName() + "." + tableSQLBuilder.InstanceName
+		primaryKeys, extraImports := getRepositoryPrimaryKeys(tableMetaData, tableModel, path.Base(modelImportPath), tableSQLBuilder)
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+tableRepositoryTemplate,
+			tableMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return repositoryTemplate.PackageName()
+				},
+				"dialect": func() jet.Dialect {
+					return dialect
+				},
+				"repositoryTemplate": func() TableRepository {
+					return tableRepository
+				},
+				"modelType": func() string {
+					return path.Base(modelImportPath) + "." + tableModel.TypeName
+				},
+				"tableInstance": func() string {
+					return tableInstance
+				},
+				"extraImports": func() []string {
+					return extraImports
+				},
+				"modelImportPath": func() string {
+					return modelImportPath
+				},
+				"tableImportPath": func() string {
+					return tableImportPath
+				},
+				"primaryKeys": func() []repositoryPrimaryKey {
+					return primaryKeys
+				},
+				"pkParams": func() string {
+					return repositoryPKParams(primaryKeys)
+				},
+				"pkCondition": func(fromModel bool) string {
+					return repositoryPKCondition(tableInstance, primaryKeys, fromModel)
+				},
+				"autoIncrement": func() bool {
+					return isRepositoryAutoIncrement(primaryKeys)
+				},
+			})
+		throw.OnError(err)
+
+		err = utils.SaveGoFile(repositoryPath, tableRepository.FileName, text)
+		throw.OnError(err)
+	}
+}
+
+func processProto(dirPath string, schemaMetaData metadata.Schema, schemaTemplate Schema) {
+	protoTemplate := schemaTemplate.Proto
+
+	if protoTemplate.Skip || len(schemaMetaData.TablesMetaData) == 0 {
+		return
+	}
+
+	if protoTemplate.ImportPath == "" || protoTemplate.ProtoImportPath == "" {
+		throw.OnError(errors.New("jet: proto conversion import paths are not set"))
+	}
+
+	fmt.Println("Generating proto conversion files...")
+
+	protoPath := path.Join(dirPath, protoTemplate.Path)
+
+	err := utils.EnsureDirPath(protoPath)
+	throw.OnError(err)
+
+	modelImportPath := path.Join(protoTemplate.ImportPath, schemaTemplate.Model.Path)
+
+	for _, tableMetaData := range schemaMetaData.TablesMetaData {
+		tableProto := protoTemplate.Table(tableMetaData)
+		tableModel := schemaTemplate.Model.Table(tableMetaData)
+
+		if tableProto.Skip || tableModel.Skip {
+			continue
+		}
+
+		fields, extraImports, needsErr := getProtoConversionFields(tableMetaData, tableModel, path.Base(modelImportPath))
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+tableProtoConversionTemplate,
+			tableMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return protoTemplate.PackageName()
+				},
+				"protoTemplate": func() TableProtoConversion {
+					return tableProto
+				},
+				"modelTypeName": func() string {
+					return tableModel.TypeName
+				},
+				"modelType": func() string {
+					return path.Base(modelImportPath) + "." + tableModel.TypeName
+				},
+				"extraImports": func() []string {
+					return extraImports
+				},
+				"modelImportPath": func() string {
+					return modelImportPath
+				},
+				"protoImportPath": func() string {
+					return protoTemplate.ProtoImportPath
+				},
+				"fields": func() []protoConversionField {
+					return fields
+				},
+				"needsErr": func() bool {
+					return needsErr
+				},
+			})
+		throw.OnError(err)
+
+		err = utils.SaveGoFile(protoPath, tableProto.FileName, text)
+		throw.OnError(err)
+	}
+}
+
+// DefaultTableSQLBuilderFileTemplate returns text/template used to generate table and view sql builder files of the
+// dialect. Template functions available to custom table sql builder templates are package, dialect, schemaName,
+// tableTemplate, structImplName, columnField and foreignKeys.
+func DefaultTableSQLBuilderFileTemplate(dialect jet.Dialect) string {
 	if dialect.Name() == "PostgreSQL" || dialect.Name() == "SQLite" {
 		return tableSQLBuilderTemplateWithEXCLUDED
 	}
@@ -176,6 +448,34 @@ func getTableSQLBuilderTemplate(dialect jet.Dialect) string {
 	return tableSQLBuilderTemplate
 }
 
+// DefaultEnumSQLBuilderFileTemplate returns text/template used to generate enum sql builder files. Template functions
+// available to custom enum sql builder templates are package, dialect, enumTemplate, enumTypeName and enumValueName.
+func DefaultEnumSQLBuilderFileTemplate() string {
+	return enumSQLBuilderTemplate
+}
+
+// DefaultTableModelFileTemplate returns text/template used to generate table and view model files. Template functions
+// available to custom model templates are package, modelImports, tableTemplate and structField. Columns of skipped
+// model fields are removed from the table metadata the template is executed with.
+func DefaultTableModelFileTemplate() string {
+	return tableModelFileTemplate
+}
+
+// DefaultEnumModelFileTemplate returns text/template used to generate enum model files. Template functions available
+// to custom enum model templates are package, enumTemplate and valueName.
+func DefaultEnumModelFileTemplate() string {
+	return enumModelTemplate
+}
+
+// fileTemplate returns custom file template, if set, or default file template otherwise
+func fileTemplate(customTemplate, defaultTemplate string) string {
+	if customTemplate != "" {
+		return autoGenWarningTemplate + customTemplate
+	}
+
+	return autoGenWarningTemplate + defaultTemplate
+}
+
 func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadata.Table, modelTemplate Model) {
 	if len(tablesMetaData) == 0 {
 		return
@@ -196,8 +496,8 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 		}
 
 		text, err := generateTemplate(
-			autoGenWarningTemplate+tableModelFileTemplate,
-			tableMetaData,
+			fileTemplate(tableTemplate.FileTemplate, tableModelFileTemplate),
+			modelColumns(tableTemplate, tableMetaData),
 			template.FuncMap{
 				"package": func() string {
 					return modelTemplate.PackageName()
@@ -211,6 +511,9 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 				"structField": func(columnMetaData metadata.Column) TableModelField {
 					return tableTemplate.Field(columnMetaData)
 				},
+				"scanColumns": func() []metadata.Column { // all table columns, including skipped model fields
+					return tableMetaData.Columns
+				},
 			})
 		throw.OnError(err)
 
@@ -233,7 +536,7 @@ func processEnumModels(modelDir string, enumsMetaData []metadata.Enum, modelTemp
 		}
 
 		text, err := generateTemplate(
-			autoGenWarningTemplate+enumModelTemplate,
+			fileTemplate(enumTemplate.FileTemplate, enumModelTemplate),
 			enumMetaData,
 			template.FuncMap{
 				"package": func() string {
@@ -0,0 +1,228 @@
+package template
+
+import (
+	"fmt"
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/internal/utils"
+	"path"
+	"strings"
+)
+
+const (
+	timestamppbImportPath = "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspbImportPath  = "google.golang.org/protobuf/types/known/wrapperspb"
+	uuidImportPath        = "github.com/google/uuid"
+)
+
+// ProtoConversion is template for protobuf conversion files generation. Conversion files contain functions converting
+// generated table models to protobuf generated structs with matching names, and back. Nullable columns are converted
+// to well known wrapper types, and time columns to google.protobuf.Timestamp. Proto conversion generation is skipped
+// by default, and it requires ImportPath of the schema destination directory and ProtoImportPath of protobuf
+// generated package.
+type ProtoConversion struct {
+	Skip            bool
+	Path            string
+	ImportPath      string
+	ProtoImportPath string
+	Table           func(table metadata.Table) TableProtoConversion
+}
+
+// PackageName returns package name of proto conversion functions
+func (p ProtoConversion) PackageName() string {
+	return path.Base(p.Path)
+}
+
+// UsePath returns new ProtoConversion template with replaced file path
+func (p ProtoConversion) UsePath(path string) ProtoConversion {
+	p.Path = path
+	return p
+}
+
+// UseImportPaths returns new ProtoConversion template with replaced import path of the schema destination directory
+// (for instance "github.com/user/project/.gen/jetdb/dvds"), and import path of protobuf generated package
+// (for instance "github.com/user/project/gen/proto/dvds/v1").
+func (p ProtoConversion) UseImportPaths(importPath, protoImportPath string) ProtoConversion {
+	p.Skip = false
+	p.ImportPath = importPath
+	p.ProtoImportPath = protoImportPath
+	return p
+}
+
+// UseTable returns new ProtoConversion template with replaced template for table conversion files generation
+func (p ProtoConversion) UseTable(tableFunc func(table metadata.Table) TableProtoConversion) ProtoConversion {
+	p.Table = tableFunc
+	return p
+}
+
+// DefaultProtoConversion returns default ProtoConversion template implementation
+func DefaultProtoConversion() ProtoConversion {
+	return ProtoConversion{
+		Skip:  true,
+		Path:  "/protoconv",
+		Table: DefaultTableProtoConversion,
+	}
+}
+
+// TableProtoConversion is template for table proto conversion files generation
+type TableProtoConversion struct {
+	Skip        bool
+	FileName    string
+	MessageName string
+}
+
+// DefaultTableProtoConversion returns default TableProtoConversion template implementation
+func DefaultTableProtoConversion(tableMetaData metadata.Table) TableProtoConversion {
+	return TableProtoConversion{
+		FileName:    utils.ToGoFileName(tableMetaData.Name),
+		MessageName: utils.ToGoIdentifier(tableMetaData.Name),
+	}
+}
+
+// UseFileName returns new TableProtoConversion with new file name set
+func (t TableProtoConversion) UseFileName(fileName string) TableProtoConversion {
+	t.FileName = fileName
+	return t
+}
+
+// UseMessageName returns new TableProtoConversion with new protobuf message name set
+func (t TableProtoConversion) UseMessageName(messageName string) TableProtoConversion {
+	t.MessageName = messageName
+	return t
+}
+
+// protoConversionField contains conversion statements of a single model field as used by proto conversion template.
+// Unsupported is set to the field description if field type can not be converted.
+type protoConversionField struct {
+	ToProto     string
+	FromProto   string
+	Unsupported string
+}
+
+// protoScalar describes go type of protobuf scalar field, and the well known wrapper constructor used for nullable values
+type protoScalar struct {
+	Type    string
+	Wrapper string
+}
+
+var protoScalars = map[string]protoScalar{
+	"string":  {"string", "String"},
+	"bool":    {"bool", "Bool"},
+	"int8":    {"int32", "Int32"},
+	"int16":   {"int32", "Int32"},
+	"int32":   {"int32", "Int32"},
+	"int64":   {"int64", "Int64"},
+	"uint8":   {"uint32", "UInt32"},
+	"uint16":  {"uint32", "UInt32"},
+	"uint32":  {"uint32", "UInt32"},
+	"uint64":  {"uint64", "UInt64"},
+	"float32": {"float32", "Float"},
+	"float64": {"float64", "Double"},
+	"[]byte":  {"[]byte", "Bytes"},
+}
+
+// getProtoConversionFields returns conversion statements for each of the table model fields, with the list of
+// additional imports conversions need.
+func getProtoConversionFields(tableMetaData metadata.Table, modelTemplate TableModel,
+	modelPackage string) (fields []protoConversionField, imports []string, needsErr bool) {
+
+	addImport := func(importPath string) {
+		if !utils.StringSliceContains(imports, importPath) {
+			imports = append(imports, importPath)
+		}
+	}
+
+	for _, column := range tableMetaData.Columns {
+		modelField := modelTemplate.Field(column)
+
+		if modelField.Skip {
+			continue
+		}
+
+		typeName := strings.TrimPrefix(modelField.Type.Name, "*")
+		nullable := strings.HasPrefix(modelField.Type.Name, "*")
+
+		m := "m." + modelField.Name
+		p := "p." + protoFieldName(column.Name)
+
+		var toProto, fromProto func(value string) string
+		var wrapper string
+		parse := false
+
+		switch {
+		case column.DataType.Kind == metadata.EnumType:
+			toProto = func(value string) string { return "string(" + value + ")" }
+			fromProto = func(value string) string { return modelPackage + "." + typeName + "(" + value + ")" }
+			wrapper = "String"
+		case typeName == "time.Time":
+			toProto = func(value string) string { return "timestamppb.New(" + value + ")" }
+			fromProto = func(value string) string { return value + ".AsTime()" }
+			addImport(timestamppbImportPath)
+		case typeName == "uuid.UUID":
+			toProto = func(value string) string { return value + ".String()" }
+			wrapper = "String"
+			parse = true
+			addImport(uuidImportPath)
+		default:
+			scalar, ok := protoScalars[typeName]
+
+			if !ok {
+				fields = append(fields, protoConversionField{Unsupported: modelField.Name + " " + modelField.Type.Name})
+				continue
+			}
+
+			toProto = func(value string) string { return castProtoValue(scalar.Type, typeName, value) }
+			fromProto = func(value string) string { return castProtoValue(typeName, scalar.Type, value) }
+			wrapper = scalar.Wrapper
+		}
+
+		var field protoConversionField
+
+		switch {
+		case !nullable && parse:
+			needsErr = true
+			field.ToProto = fmt.Sprintf("%s = %s", p, toProto(m))
+			field.FromProto = fmt.Sprintf("if %s, err = uuid.Parse(%s); err != nil {\n\t\treturn nil, err\n\t}", m, p)
+		case !nullable:
+			field.ToProto = fmt.Sprintf("%s = %s", p, toProto(m))
+			field.FromProto = fmt.Sprintf("%s = %s", m, fromProto(p))
+		case wrapper == "": // timestamp, nil is a valid proto value
+			field.ToProto = fmt.Sprintf("if %s != nil {\n\t\t%s = %s\n\t}", m, p, toProto("*"+m))
+			field.FromProto = fmt.Sprintf("if %s != nil {\n\t\tvalue := %s\n\t\t%s = &value\n\t}", p, fromProto(p), m)
+		case parse:
+			addImport(wrapperspbImportPath)
+			field.ToProto = fmt.Sprintf("if %s != nil {\n\t\t%s = wrapperspb.%s(%s)\n\t}", m, p, wrapper, toProto(m))
+			field.FromProto = fmt.Sprintf("if %s != nil {\n\t\tvalue, err := uuid.Parse(%s.GetValue())\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\t\t%s = &value\n\t}", p, p, m)
+		default:
+			addImport(wrapperspbImportPath)
+			field.ToProto = fmt.Sprintf("if %s != nil {\n\t\t%s = wrapperspb.%s(%s)\n\t}", m, p, wrapper, toProto("*"+m))
+			field.FromProto = fmt.Sprintf("if %s != nil {\n\t\tvalue := %s\n\t\t%s = &value\n\t}", p, fromProto(p+".GetValue()"), m)
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, imports, needsErr
+}
+
+func castProtoValue(toType, fromType, value string) string {
+	if toType == fromType {
+		return value
+	}
+
+	return toType + "(" + value + ")"
+}
+
+// protoFieldName returns go field name protoc-gen-go generates for the proto field with the same name as the column
+func protoFieldName(columnName string) string {
+	var ret strings.Builder
+
+	for _, part := range strings.Split(columnName, "_") {
+		if part == "" {
+			continue
+		}
+
+		ret.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return ret.String()
+}
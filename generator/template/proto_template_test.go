@@ -0,0 +1,76 @@
+package template
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessSchema_Proto(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_proto")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	column := func(name, dataType string, kind metadata.DataTypeKind, nullable bool) metadata.Column {
+		return metadata.Column{Name: name, IsNullable: nullable, DataType: metadata.DataType{Name: dataType, Kind: kind}}
+	}
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "actor",
+				Columns: []metadata.Column{
+					column("actor_id", "integer", metadata.BaseType, false),
+					column("rating", "smallint", metadata.BaseType, true),
+					column("first_name", "text", metadata.BaseType, false),
+					column("nickname", "text", metadata.BaseType, true),
+					column("external_id", "uuid", metadata.BaseType, false),
+					column("parent_id", "uuid", metadata.BaseType, true),
+					column("mood", "mood", metadata.EnumType, false),
+					column("created_at", "timestamp", metadata.BaseType, false),
+					column("deleted_at", "timestamp", metadata.BaseType, true),
+				},
+			},
+		},
+	}
+
+	generatorTemplate := Default(postgres.Dialect).
+		UseSchema(func(schemaMetaData metadata.Schema) Schema {
+			return DefaultSchema(schemaMetaData).
+				UseProto(DefaultProtoConversion().UseImportPaths("github.com/user/project/.gen/jetdb/store",
+					"github.com/user/project/gen/store/v1"))
+		})
+
+	ProcessSchema(dirPath, schema, generatorTemplate)
+
+	actor := readGeneratedFile(t, path.Join(dirPath, "store", "protoconv", "actor.go"))
+	require.Contains(t, actor, `pb "github.com/user/project/gen/store/v1"`)
+	require.Contains(t, actor, `"github.com/user/project/.gen/jetdb/store/model"`)
+	require.Contains(t, actor, `"google.golang.org/protobuf/types/known/timestamppb"`)
+	require.Contains(t, actor, `"google.golang.org/protobuf/types/known/wrapperspb"`)
+
+	require.Contains(t, actor, `func ActorToProto(m *model.Actor) *pb.Actor {`)
+	require.Contains(t, actor, `p.ActorId = m.ActorID`)
+	require.Contains(t, actor, `p.Rating = wrapperspb.Int32(int32(*m.Rating))`)
+	require.Contains(t, actor, `p.Nickname = wrapperspb.String(*m.Nickname)`)
+	require.Contains(t, actor, `p.ExternalId = m.ExternalID.String()`)
+	require.Contains(t, actor, `p.ParentId = wrapperspb.String(m.ParentID.String())`)
+	require.Contains(t, actor, `p.Mood = string(m.Mood)`)
+	require.Contains(t, actor, `p.CreatedAt = timestamppb.New(m.CreatedAt)`)
+	require.Contains(t, actor, `p.DeletedAt = timestamppb.New(*m.DeletedAt)`)
+
+	require.Contains(t, actor, `func ActorFromProto(p *pb.Actor) (*model.Actor, error) {`)
+	require.Contains(t, actor, `m.ActorID = p.ActorId`)
+	require.Contains(t, actor, `value := int16(p.Rating.GetValue())`)
+	require.Contains(t, actor, `if m.ExternalID, err = uuid.Parse(p.ExternalId); err != nil {`)
+	require.Contains(t, actor, `value, err := uuid.Parse(p.ParentId.GetValue())`)
+	require.Contains(t, actor, `m.Mood = model.Mood(p.Mood)`)
+	require.Contains(t, actor, `m.CreatedAt = p.CreatedAt.AsTime()`)
+	require.Contains(t, actor, `value := p.DeletedAt.AsTime()`)
+}
@@ -0,0 +1,190 @@
+package template
+
+import (
+	"fmt"
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/internal/utils"
+	"path"
+	"strings"
+)
+
+// Repository is template for repository files generation. Repository files contain basic CRUD functions
+// (GetByPK, List, Insert, Update and Delete) for each table, built on top of generated model and sql builder types.
+// Repository generation is skipped by default, and it requires ImportPath of the schema destination directory.
+type Repository struct {
+	Skip       bool
+	Path       string
+	ImportPath string
+	Table      func(table metadata.Table) TableRepository
+}
+
+// PackageName returns package name of repository types
+func (r Repository) PackageName() string {
+	return path.Base(r.Path)
+}
+
+// UsePath returns new Repository template with replaced file path
+func (r Repository) UsePath(path string) Repository {
+	r.Path = path
+	return r
+}
+
+// UseImportPath returns new Repository template with replaced import path of the schema destination directory,
+// (for instance "github.com/user/project/.gen/jetdb/dvds"). Model and table packages are imported relative to it.
+func (r Repository) UseImportPath(importPath string) Repository {
+	r.Skip = false
+	r.ImportPath = importPath
+	return r
+}
+
+// UseTable returns new Repository template with replaced template for table repository files generation
+func (r Repository) UseTable(tableFunc func(table metadata.Table) TableRepository) Repository {
+	r.Table = tableFunc
+	return r
+}
+
+// DefaultRepository returns default Repository template implementation
+func DefaultRepository() Repository {
+	return Repository{
+		Skip:  true,
+		Path:  "/repository",
+		Table: DefaultTableRepository,
+	}
+}
+
+// TableRepository is template for table repository files generation
+type TableRepository struct {
+	Skip     bool
+	FileName string
+	TypeName string
+}
+
+// DefaultTableRepository returns default TableRepository template implementation
+func DefaultTableRepository(tableMetaData metadata.Table) TableRepository {
+	return TableRepository{
+		FileName: utils.ToGoFileName(tableMetaData.Name),
+		TypeName: utils.ToGoIdentifier(tableMetaData.Name) + "Repository",
+	}
+}
+
+// UseFileName returns new TableRepository with new file name set
+func (t TableRepository) UseFileName(fileName string) TableRepository {
+	t.FileName = fileName
+	return t
+}
+
+// UseTypeName returns new TableRepository with new type name set
+func (t TableRepository) UseTypeName(typeName string) TableRepository {
+	t.TypeName = typeName
+	return t
+}
+
+// repositoryPrimaryKey describes primary key column as used by repository file template
+type repositoryPrimaryKey struct {
+	ColumnName    string
+	FieldName     string
+	ParamName     string
+	ParamType     string
+	LiteralFormat string
+	AutoIncrement bool
+}
+
+// getRepositoryPrimaryKeys returns primary key columns of the table with the list of additional imports needed for
+// primary key parameter types. Nil is returned if table does not have a primary key, or if some of the primary key
+// columns can not be compared with a literal in all the dialects.
+func getRepositoryPrimaryKeys(tableMetaData metadata.Table, modelTemplate TableModel, modelPackage string,
+	sqlBuilderTemplate TableSQLBuilder) ([]repositoryPrimaryKey, []string) {
+	var primaryKeys []repositoryPrimaryKey
+	var imports []string
+
+	for _, column := range tableMetaData.Columns {
+		if !column.IsPrimaryKey {
+			continue
+		}
+
+		field := modelTemplate.Field(column)
+		paramName := "pk" + field.Name
+		paramType := field.Type.Name
+
+		if field.Skip || strings.HasPrefix(paramType, "*") {
+			return nil, nil
+		}
+
+		if column.DataType.Kind == metadata.EnumType {
+			paramType = modelPackage + "." + paramType
+		}
+
+		var literalFormat string
+
+		switch SQLBuilderColumnType(column) {
+		case "Bool":
+			literalFormat = "Bool(%s)"
+		case "Integer":
+			literalFormat = "Int(int64(%s))"
+		case "Float":
+			literalFormat = "Float(float64(%s))"
+		case "String":
+			switch paramType {
+			case "string":
+				literalFormat = "String(%s)"
+			case "uuid.UUID":
+				literalFormat = "UUID(%s)"
+			default:
+				literalFormat = "String(string(%s))"
+			}
+		default:
+			return nil, nil
+		}
+
+		if field.Type.ImportPath != "" && !utils.StringSliceContains(imports, field.Type.ImportPath) {
+			imports = append(imports, field.Type.ImportPath)
+		}
+
+		primaryKeys = append(primaryKeys, repositoryPrimaryKey{
+			ColumnName:    sqlBuilderTemplate.Column(column).Name,
+			FieldName:     field.Name,
+			ParamName:     paramName,
+			ParamType:     paramType,
+			LiteralFormat: literalFormat,
+			AutoIncrement: column.IsAutoIncrement,
+		})
+	}
+
+	return primaryKeys, imports
+}
+
+// repositoryPKParams returns function parameters list for primary key columns
+func repositoryPKParams(primaryKeys []repositoryPrimaryKey) string {
+	var params []string
+
+	for _, primaryKey := range primaryKeys {
+		params = append(params, primaryKey.ParamName+" "+primaryKey.ParamType)
+	}
+
+	return strings.Join(params, ", ")
+}
+
+// repositoryPKCondition returns condition matching primary key columns with function parameters, or with
+// model fields if fromModel is true
+func repositoryPKCondition(tableInstance string, primaryKeys []repositoryPrimaryKey, fromModel bool) string {
+	var conditions []string
+
+	for _, primaryKey := range primaryKeys {
+		value := primaryKey.ParamName
+
+		if fromModel {
+			value = "m." + primaryKey.FieldName
+		}
+
+		conditions = append(conditions,
+			fmt.Sprintf("%s.%s.EQ(%s)", tableInstance, primaryKey.ColumnName, fmt.Sprintf(primaryKey.LiteralFormat, value)))
+	}
+
+	return strings.Join(conditions, ".AND(") + strings.Repeat(")", len(conditions)-1)
+}
+
+// isRepositoryAutoIncrement returns true if table has a single primary key column, generated by the database on insert
+// (serial, identity, AUTO_INCREMENT or SQLite rowid alias)
+func isRepositoryAutoIncrement(primaryKeys []repositoryPrimaryKey) bool {
+	return len(primaryKeys) == 1 && primaryKeys[0].AutoIncrement
+}
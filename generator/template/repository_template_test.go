@@ -0,0 +1,108 @@
+package template
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessSchema_Repository(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_repository")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	integerColumn := func(name string, primaryKey bool) metadata.Column {
+		return metadata.Column{Name: name, IsPrimaryKey: primaryKey, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}}
+	}
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "actor",
+				Columns: []metadata.Column{
+					{Name: "actor_id", IsPrimaryKey: true, IsAutoIncrement: true, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+					{Name: "first_name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+				},
+			},
+			{
+				Name: "film_actor",
+				Columns: []metadata.Column{
+					integerColumn("actor_id", true),
+					integerColumn("film_id", true),
+					integerColumn("rating", false),
+				},
+			},
+			{
+				Name:    "country",
+				Columns: []metadata.Column{integerColumn("country_code", true)},
+			},
+			{
+				Name:    "log",
+				Columns: []metadata.Column{integerColumn("level", false)},
+			},
+		},
+	}
+
+	generatorTemplate := Default(postgres.Dialect).
+		UseSchema(func(schemaMetaData metadata.Schema) Schema {
+			return DefaultSchema(schemaMetaData).
+				UseRepository(DefaultRepository().UseImportPath("github.com/user/project/.gen/jetdb/store"))
+		})
+
+	ProcessSchema(dirPath, schema, generatorTemplate)
+
+	actor := readGeneratedFile(t, path.Join(dirPath, "store", "repository", "actor.go"))
+	require.Contains(t, actor, `"github.com/user/project/.gen/jetdb/store/model"`)
+	require.Contains(t, actor, `"github.com/user/project/.gen/jetdb/store/table"`)
+	require.Contains(t, actor, `func (r ActorRepository) GetByPK(ctx context.Context, pkActorID int32) (model.Actor, error) {`)
+	require.Contains(t, actor, `WHERE(table.Actor.ActorID.EQ(Int(int64(pkActorID)))).`)
+	require.Contains(t, actor, `return table.Actor.INSERT(table.Actor.MutableColumns).`)
+	require.Contains(t, actor, `func (r ActorRepository) Update(ctx context.Context, m model.Actor) (sql.Result, error) {`)
+	require.Contains(t, actor, `WHERE(table.Actor.ActorID.EQ(Int(int64(m.ActorID)))).`)
+	require.Contains(t, actor, `func (r ActorRepository) Delete(ctx context.Context, pkActorID int32) (sql.Result, error) {`)
+
+	country := readGeneratedFile(t, path.Join(dirPath, "store", "repository", "country.go"))
+	require.Contains(t, country, `return table.Country.INSERT(table.Country.AllColumns).`)
+
+	filmActor := readGeneratedFile(t, path.Join(dirPath, "store", "repository", "film_actor.go"))
+	require.Contains(t, filmActor, `GetByPK(ctx context.Context, pkActorID int32, pkFilmID int32) (model.FilmActor, error) {`)
+	require.Contains(t, filmActor, `WHERE(table.FilmActor.ActorID.EQ(Int(int64(pkActorID))).AND(table.FilmActor.FilmID.EQ(Int(int64(pkFilmID))))).`)
+	require.Contains(t, filmActor, `return table.FilmActor.INSERT(table.FilmActor.AllColumns).`)
+
+	log := readGeneratedFile(t, path.Join(dirPath, "store", "repository", "log.go"))
+	require.Contains(t, log, `func (r LogRepository) List(ctx context.Context, condition BoolExpression, orderBy ...OrderByClause) ([]model.Log, error) {`)
+	require.Contains(t, log, `func (r LogRepository) Insert(ctx context.Context, m model.Log) (sql.Result, error) {`)
+	require.NotContains(t, log, "GetByPK")
+	require.NotContains(t, log, "Update")
+	require.NotContains(t, log, "Delete")
+}
+
+func TestProcessSchema_RepositorySkippedByDefault(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_repository")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{Name: "log", Columns: []metadata.Column{{Name: "level", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}}}},
+		},
+	}
+
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect))
+
+	_, err = os.Stat(path.Join(dirPath, "store", "repository"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func readGeneratedFile(t *testing.T, filePath string) string {
+	data, err := ioutil.ReadFile(filePath)
+	require.NoError(t, err)
+	return string(data)
+}
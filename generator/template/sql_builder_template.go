@@ -16,6 +16,12 @@ type SQLBuilder struct {
 	Table func(table metadata.Table) TableSQLBuilder
 	View  func(view metadata.Table) TableSQLBuilder
 	Enum  func(enum metadata.Enum) EnumSQLBuilder
+	// SchemaName, if set, is used as schema name of generated tables and views instead of database schema name
+	SchemaName string
+	// TypedEnums, if set, enum columns are generated as <Enum>Column types, and enum values as <Enum>Value types, so
+	// enum column can be compared only with values of the same enum. Off by default, because it changes the types of
+	// generated enum columns and values.
+	TypedEnums bool
 }
 
 // DefaultSQLBuilder returns default SQLBuilder implementation
@@ -46,6 +52,20 @@ func (sb SQLBuilder) UseView(viewFunc func(table metadata.Table) ViewSQLBuilder)
 	return sb
 }
 
+// UseSchemaName returns new SQLBuilder in which generated tables and views use schemaName instead of database schema
+// name. Schema name can contain placeholders resolved at statement execution (for instance "{{tenant}}", see
+// WithSchemaParams), so the same generated code can be used with each tenant schema of schema-per-tenant database.
+func (sb SQLBuilder) UseSchemaName(schemaName string) SQLBuilder {
+	sb.SchemaName = schemaName
+	return sb
+}
+
+// UseTypedEnums returns new SQLBuilder with typed enum columns and values generation turned on or off
+func (sb SQLBuilder) UseTypedEnums(typedEnums bool) SQLBuilder {
+	sb.TypedEnums = typedEnums
+	return sb
+}
+
 // UseEnum returns new SQLBuilder with new EnumSQLBuilder template function set
 func (sb SQLBuilder) UseEnum(enumFunc func(enum metadata.Enum) EnumSQLBuilder) SQLBuilder {
 	sb.Enum = enumFunc
@@ -60,6 +80,12 @@ type TableSQLBuilder struct {
 	InstanceName string
 	TypeName     string
 	Column       func(columnMetaData metadata.Column) TableSQLBuilderColumn
+	// MetaData if set, table sql builder type will also have ColumnDefaults and CheckConstraints methods,
+	// exposing column default expressions and table check constraints to the tooling.
+	MetaData bool
+	// FileTemplate, if set, is text/template used to generate table sql builder file instead of
+	// DefaultTableSQLBuilderFileTemplate
+	FileTemplate string
 }
 
 // ViewSQLBuilder is template for generating view SQLBuilder files
@@ -118,10 +144,27 @@ func (tb TableSQLBuilder) UseColumn(columnsFunc func(column metadata.Column) Tab
 	return tb
 }
 
+// UseMetaData returns new TableSQLBuilder with column defaults and check constraints generation turned on or off
+func (tb TableSQLBuilder) UseMetaData(metaData bool) TableSQLBuilder {
+	tb.MetaData = metaData
+	return tb
+}
+
+// UseFileTemplate returns new TableSQLBuilder with table sql builder file generated from fileTemplate. File template
+// is executed with table metadata.Table as data, see DefaultTableSQLBuilderFileTemplate for available template functions.
+func (tb TableSQLBuilder) UseFileTemplate(fileTemplate string) TableSQLBuilder {
+	tb.FileTemplate = fileTemplate
+	return tb
+}
+
 // TableSQLBuilderColumn is template for table sql builder column
 type TableSQLBuilderColumn struct {
 	Name string
 	Type string
+	// EnumType, if set, is the name of enum type column is generated for. Enum column is then of <EnumType>Column
+	// type, generated in the same package, and it can be compared only with values of the same enum.
+	// It is set for enum columns if SQLBuilder.TypedEnums is on.
+	EnumType string
 }
 
 // DefaultTableSQLBuilderColumn returns default implementation of TableSQLBuilderColumn
@@ -134,41 +177,70 @@ func DefaultTableSQLBuilderColumn(columnMetaData metadata.Column) TableSQLBuilde
 
 // getSqlBuilderColumnType returns type of jet sql builder column
 func getSqlBuilderColumnType(columnMetaData metadata.Column) string {
+	columnType, supported := sqlBuilderColumnType(columnMetaData)
+
+	if !supported {
+		fmt.Println("- [SQL Builder] Unsupported sql column '" + columnMetaData.Name + " " + columnMetaData.DataType.Name + "', using StringColumn instead.")
+	}
+
+	return columnType
+}
+
+// SQLBuilderColumnType returns type of jet sql builder column (Bool, Integer, Float, String, Date, Time, Timez,
+// Timestamp, Timestampz, Interval, JSONB, Range, Vector or Ltree) generated for a column metadata. Unsupported sql types are mapped to String.
+func SQLBuilderColumnType(columnMetaData metadata.Column) string {
+	columnType, _ := sqlBuilderColumnType(columnMetaData)
+	return columnType
+}
+
+func sqlBuilderColumnType(columnMetaData metadata.Column) (string, bool) {
+	if columnMetaData.DataType.Kind == metadata.UserDefinedType {
+		switch columnMetaData.DataType.Name {
+		case "vector": // pgvector
+			return "Vector", true
+		case "ltree":
+			return "Ltree", true
+		}
+	}
+
 	if columnMetaData.DataType.Kind != metadata.BaseType {
-		return "String"
+		return "String", true
 	}
 
 	switch strings.ToLower(columnMetaData.DataType.Name) {
 	case "boolean":
-		return "Bool"
+		return "Bool", true
 	case "smallint", "integer", "bigint",
 		"tinyint", "mediumint", "int", "year": //MySQL
-		return "Integer"
+		return "Integer", true
 	case "date":
-		return "Date"
+		return "Date", true
 	case "timestamp without time zone",
 		"timestamp", "datetime": //MySQL:
-		return "Timestamp"
+		return "Timestamp", true
 	case "timestamp with time zone":
-		return "Timestampz"
+		return "Timestampz", true
 	case "time without time zone",
 		"time": //MySQL
-		return "Time"
+		return "Time", true
 	case "time with time zone":
-		return "Timez"
+		return "Timez", true
 	case "interval":
-		return "Interval"
+		return "Interval", true
+	case "jsonb":
+		return "JSONB", true
+	case "int4range", "int8range", "numrange", "tsrange", "tstzrange", "daterange":
+		return "Range", true
 	case "user-defined", "enum", "text", "character", "character varying", "bytea", "uuid",
-		"tsvector", "bit", "bit varying", "money", "json", "jsonb", "xml", "point", "line", "ARRAY",
+		"tsvector", "bit", "bit varying", "money", "json", "xml", "point", "line", "ARRAY",
 		"char", "varchar", "nvarchar", "binary", "varbinary",
 		"tinyblob", "blob", "mediumblob", "longblob", "tinytext", "mediumtext", "longtext": // MySQL
-		return "String"
+		return "String", true
 	case "real", "numeric", "decimal", "double precision", "float",
 		"double": // MySQL
-		return "Float"
+		return "Float", true
 	default:
-		fmt.Println("- [SQL Builder] Unsupported sql column '" + columnMetaData.Name + " " + columnMetaData.DataType.Name + "', using StringColumn instead.")
-		return "String"
+		return "String", false
 	}
 }
 
@@ -179,6 +251,9 @@ type EnumSQLBuilder struct {
 	FileName     string
 	InstanceName string
 	ValueName    func(enumValue string) string
+	// FileTemplate, if set, is text/template used to generate enum sql builder file instead of
+	// DefaultEnumSQLBuilderFileTemplate
+	FileTemplate string
 }
 
 // DefaultEnumSQLBuilder returns default implementation of EnumSQLBuilder
@@ -216,6 +291,13 @@ func (e EnumSQLBuilder) UseInstanceName(name string) EnumSQLBuilder {
 	return e
 }
 
+// UseFileTemplate returns new EnumSQLBuilder with enum sql builder file generated from fileTemplate. File template is
+// executed with enum metadata.Enum as data, see DefaultEnumSQLBuilderFileTemplate for available template functions.
+func (e EnumSQLBuilder) UseFileTemplate(fileTemplate string) EnumSQLBuilder {
+	e.FileTemplate = fileTemplate
+	return e
+}
+
 func defaultEnumValueName(enumName, enumValue string) string {
 	enumValueName := utils.ToGoIdentifier(enumValue)
 	if !unicode.IsLetter([]rune(enumValueName)[0]) {
@@ -224,3 +306,124 @@ func defaultEnumValueName(enumName, enumValue string) string {
 
 	return enumValueName
 }
+
+// tableSQLBuilderForeignKey is foreign key join helper of generated table sql builder type
+type tableSQLBuilderForeignKey struct {
+	Name         string // foreign key constraint name
+	MethodSuffix string // join helper methods name suffix, for instance Customer in INNER_JOIN_Customer
+	RefTypeName  string // sql builder type name of the referenced table
+	Condition    string // join condition go expression
+
+	columns []string
+}
+
+// getTableSQLBuilderForeignKeys returns join helpers of table foreign keys. Foreign keys referencing tables, which are
+// not generated into the same package, or whose columns can not be compared with referenced columns, are skipped.
+func getTableSQLBuilderForeignKeys(tableMetaData metadata.Table, tableSQLBuilder TableSQLBuilder,
+	schemaMetaData metadata.Schema, sqlBuilder SQLBuilder) []tableSQLBuilderForeignKey {
+
+	var ret []tableSQLBuilderForeignKey
+	referenceCount := map[string]int{}
+
+	for _, foreignKey := range tableMetaData.ForeignKeys {
+		refTableMetaData, ok := findTable(schemaMetaData.TablesMetaData, foreignKey.ReferencedTable)
+
+		if !ok || len(foreignKey.Columns) == 0 || len(foreignKey.Columns) != len(foreignKey.ReferencedColumns) {
+			continue
+		}
+
+		refSQLBuilder := sqlBuilder.Table(refTableMetaData)
+
+		if refSQLBuilder.Skip || refSQLBuilder.Path != tableSQLBuilder.Path {
+			continue
+		}
+
+		condition, ok := foreignKeyCondition(foreignKey, tableMetaData, tableSQLBuilder, refTableMetaData, refSQLBuilder)
+
+		if !ok {
+			continue
+		}
+
+		referenceCount[refSQLBuilder.InstanceName]++
+
+		ret = append(ret, tableSQLBuilderForeignKey{
+			Name:         foreignKey.Name,
+			MethodSuffix: refSQLBuilder.InstanceName,
+			RefTypeName:  refSQLBuilder.TypeName,
+			Condition:    condition,
+			columns:      foreignKey.Columns,
+		})
+	}
+
+	// tables referenced with more than one foreign key are joined by foreign key columns, for instance
+	// INNER_JOIN_Users_ByCreatedBy
+	methodSuffixes := map[string]bool{}
+	var unique []tableSQLBuilderForeignKey
+
+	for _, foreignKey := range ret {
+		if referenceCount[foreignKey.MethodSuffix] > 1 {
+			foreignKey.MethodSuffix += "_By" + utils.ToGoIdentifier(strings.Join(foreignKey.columns, "_"))
+		}
+
+		if methodSuffixes[foreignKey.MethodSuffix] {
+			continue
+		}
+
+		methodSuffixes[foreignKey.MethodSuffix] = true
+		unique = append(unique, foreignKey)
+	}
+
+	return unique
+}
+
+func foreignKeyCondition(foreignKey metadata.ForeignKey, tableMetaData metadata.Table, tableSQLBuilder TableSQLBuilder,
+	refTableMetaData metadata.Table, refSQLBuilder TableSQLBuilder) (string, bool) {
+
+	var condition string
+
+	for i, columnName := range foreignKey.Columns {
+		column, ok := findColumn(tableMetaData, columnName)
+		refColumn, refOk := findColumn(refTableMetaData, foreignKey.ReferencedColumns[i])
+
+		if !ok || !refOk {
+			return "", false
+		}
+
+		field := tableSQLBuilder.Column(column)
+		refField := refSQLBuilder.Column(refColumn)
+
+		if field.Type != refField.Type || field.EnumType != refField.EnumType {
+			return "", false
+		}
+
+		columnCondition := fmt.Sprintf("a.%s.EQ(t.%s)", field.Name, refField.Name)
+
+		if i == 0 {
+			condition = columnCondition
+		} else {
+			condition += ".AND(" + columnCondition + ")"
+		}
+	}
+
+	return condition, true
+}
+
+func findTable(tables []metadata.Table, name string) (metadata.Table, bool) {
+	for _, table := range tables {
+		if table.Name == name {
+			return table, true
+		}
+	}
+
+	return metadata.Table{}, false
+}
+
+func findColumn(table metadata.Table, name string) (metadata.Column, bool) {
+	for _, column := range table.Columns {
+		if column.Name == name {
+			return column, true
+		}
+	}
+
+	return metadata.Column{}, false
+}
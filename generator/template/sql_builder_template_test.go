@@ -1,7 +1,14 @@
 package template
 
 import (
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
 	"testing"
 )
 
@@ -9,3 +16,353 @@ func TestToGoEnumValueIdentifier(t *testing.T) {
 	require.Equal(t, defaultEnumValueName("enum_name", "enum_value"), "EnumValue")
 	require.Equal(t, defaultEnumValueName("NumEnum", "100"), "NumEnum100")
 }
+
+func TestProcessSchema_TablePrimaryKeyHelpers(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_sql_builder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	integerColumn := func(name string, primaryKey bool) metadata.Column {
+		return metadata.Column{Name: name, IsPrimaryKey: primaryKey, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}}
+	}
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{Name: "film_actor", Columns: []metadata.Column{integerColumn("actor_id", true), integerColumn("film_id", true)}},
+			{Name: "film_category", Columns: []metadata.Column{
+				{Name: "film_id", IsPrimaryKey: true, PrimaryKeyOrdinal: 2, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				{Name: "category_id", IsPrimaryKey: true, PrimaryKeyOrdinal: 1, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+			}},
+			{Name: "log", Columns: []metadata.Column{integerColumn("level", false)}},
+		},
+	}
+
+	ProcessSchema(dirPath, schema, Default(mysql.Dialect))
+
+	filmActor := readGeneratedFile(t, path.Join(dirPath, "store", "table", "film_actor.go"))
+	require.Contains(t, filmActor, `func (a FilmActorTable) PrimaryKey() mysql.ColumnList {
+	return mysql.ColumnList{a.ActorID, a.FilmID}
+}`)
+	require.Contains(t, filmActor, `func (a FilmActorTable) ByPrimaryKey(values ...mysql.Expression) mysql.BoolExpression {
+	return a.PrimaryKey().EQ(values...)
+}`)
+
+	filmCategory := readGeneratedFile(t, path.Join(dirPath, "store", "table", "film_category.go"))
+	require.Contains(t, filmCategory, `return mysql.ColumnList{a.CategoryID, a.FilmID}`)
+
+	log := readGeneratedFile(t, path.Join(dirPath, "store", "table", "log.go"))
+	require.NotContains(t, log, "PrimaryKey")
+}
+
+func TestProcessSchema_ForeignKeyJoinHelpers(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_sql_builder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	column := func(name, dataType string, primaryKey bool) metadata.Column {
+		return metadata.Column{Name: name, IsPrimaryKey: primaryKey, DataType: metadata.DataType{Name: dataType, Kind: metadata.BaseType}}
+	}
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{Name: "customer", Columns: []metadata.Column{column("id", "integer", true), column("code", "text", false)}},
+			{
+				Name: "orders",
+				Columns: []metadata.Column{
+					column("id", "integer", true),
+					column("customer_id", "integer", false),
+					column("customer_code", "integer", false),
+					column("created_by", "integer", false),
+					column("updated_by", "integer", false),
+				},
+				ForeignKeys: []metadata.ForeignKey{
+					{Name: "orders_customer_fk", Columns: []string{"customer_id"}, ReferencedTable: "customer", ReferencedColumns: []string{"id"}},
+					{Name: "orders_created_by_fk", Columns: []string{"created_by"}, ReferencedTable: "users", ReferencedColumns: []string{"id"}},
+					{Name: "orders_updated_by_fk", Columns: []string{"updated_by"}, ReferencedTable: "users", ReferencedColumns: []string{"id"}},
+					{Name: "orders_missing_fk", Columns: []string{"customer_id"}, ReferencedTable: "missing", ReferencedColumns: []string{"id"}},
+					{Name: "orders_code_fk", Columns: []string{"customer_code"}, ReferencedTable: "customer", ReferencedColumns: []string{"code"}},
+				},
+			},
+			{Name: "users", Columns: []metadata.Column{column("id", "integer", true)}},
+		},
+	}
+
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect))
+
+	orders := readGeneratedFile(t, path.Join(dirPath, "store", "table", "orders.go"))
+	require.Contains(t, orders, `
+// INNER_JOIN_Customer joins t (CustomerTable table or its alias) on orders_customer_fk foreign key columns
+func (a ordersTable) INNER_JOIN_Customer(t *CustomerTable) postgres.ReadableTable {
+	return a.INNER_JOIN(t, a.FK_Customer(t))
+}
+
+// LEFT_JOIN_Customer left joins t (CustomerTable table or its alias) on orders_customer_fk foreign key columns
+func (a ordersTable) LEFT_JOIN_Customer(t *CustomerTable) postgres.ReadableTable {
+	return a.LEFT_JOIN(t, a.FK_Customer(t))
+}
+
+// FK_Customer returns condition matching orders_customer_fk foreign key columns with referenced columns of t
+func (a ordersTable) FK_Customer(t *CustomerTable) postgres.BoolExpression {
+	return a.CustomerID.EQ(t.ID)
+}`)
+	require.Contains(t, orders, `func (a ordersTable) FK_Users_ByCreatedBy(t *UsersTable) postgres.BoolExpression {
+	return a.CreatedBy.EQ(t.ID)
+}`)
+	require.Contains(t, orders, "func (a ordersTable) INNER_JOIN_Users_ByUpdatedBy(t *UsersTable)")
+	require.NotContains(t, orders, "Missing")
+	require.NotContains(t, orders, "orders_code_fk") // integer column can not be compared with text column
+
+	ProcessSchema(dirPath, schema, Default(mysql.Dialect))
+
+	orders = readGeneratedFile(t, path.Join(dirPath, "store", "table", "orders.go"))
+	require.Contains(t, orders, `func (a OrdersTable) INNER_JOIN_Customer(t CustomerTable) mysql.ReadableTable {
+	return a.INNER_JOIN(t, a.FK_Customer(t))
+}`)
+}
+
+func TestProcessSchema_TableMetaData(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_sql_builder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "film",
+				Columns: []metadata.Column{
+					{Name: "film_id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType},
+						DefaultExpression: "nextval('film_film_id_seq'::regclass)"},
+					{Name: "length", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				},
+				CheckConstraints: []metadata.CheckConstraint{
+					{Name: "film_length_check", Expression: "CHECK ((length > 0))"},
+				},
+			},
+		},
+	}
+
+	generatorTemplate := Default(postgres.Dialect).
+		UseSchema(func(schemaMetaData metadata.Schema) Schema {
+			return DefaultSchema(schemaMetaData).
+				UseSQLBuilder(DefaultSQLBuilder().
+					UseTable(func(table metadata.Table) TableSQLBuilder {
+						return DefaultTableSQLBuilder(table).UseMetaData(true)
+					}),
+				)
+		})
+
+	ProcessSchema(dirPath, schema, generatorTemplate)
+
+	film := readGeneratedFile(t, path.Join(dirPath, "store", "table", "film.go"))
+	require.Contains(t, film, `func (a filmTable) ColumnDefaults() map[string]string {
+	return map[string]string{
+		"film_id": "nextval('film_film_id_seq'::regclass)",
+	}
+}`)
+	require.Contains(t, film, `func (a filmTable) CheckConstraints() map[string]string {
+	return map[string]string{
+		"film_length_check": "CHECK ((length > 0))",
+	}
+}`)
+
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect))
+
+	film = readGeneratedFile(t, path.Join(dirPath, "store", "table", "film.go"))
+	require.NotContains(t, film, "ColumnDefaults")
+	require.NotContains(t, film, "CheckConstraints")
+}
+
+func TestProcessSchema_TimescaleTables(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_sql_builder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	columns := []metadata.Column{
+		{Name: "time", DataType: metadata.DataType{Name: "timestamp with time zone", Kind: metadata.BaseType}},
+	}
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{Name: "metrics", Columns: columns, Hypertable: true},
+			{Name: "devices", Columns: columns},
+		},
+		ViewsMetaData: []metadata.Table{
+			{Name: "metrics_hourly", Columns: columns, ContinuousAggregate: true},
+			{Name: "metrics_daily", Columns: columns, MaterializedView: true},
+		},
+	}
+
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect))
+
+	require.Contains(t, readGeneratedFile(t, path.Join(dirPath, "store", "table", "metrics.go")),
+		"// MetricsTable is TimescaleDB hypertable\ntype MetricsTable struct {")
+	require.Contains(t, readGeneratedFile(t, path.Join(dirPath, "store", "table", "devices.go")),
+		"}\n\ntype DevicesTable struct {")
+	require.Contains(t, readGeneratedFile(t, path.Join(dirPath, "store", "view", "metrics_hourly.go")),
+		"// MetricsHourlyTable is TimescaleDB continuous aggregate view\ntype MetricsHourlyTable struct {")
+	require.Contains(t, readGeneratedFile(t, path.Join(dirPath, "store", "view", "metrics_daily.go")),
+		"// MetricsDailyTable is materialized view\ntype MetricsDailyTable struct {")
+}
+
+func TestProcessSchema_ExtensionColumns(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_sql_builder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "items",
+				Columns: []metadata.Column{
+					{Name: "embedding", DataType: metadata.DataType{Name: "vector", Kind: metadata.UserDefinedType}},
+					{Name: "path", DataType: metadata.DataType{Name: "ltree", Kind: metadata.UserDefinedType}},
+					{Name: "during", DataType: metadata.DataType{Name: "tstzrange", Kind: metadata.BaseType}},
+				},
+			},
+		},
+	}
+
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect))
+
+	items := readGeneratedFile(t, path.Join(dirPath, "store", "table", "items.go"))
+	require.Contains(t, items, "Embedding postgres.ColumnVector")
+	require.Contains(t, items, `EmbeddingColumn = postgres.VectorColumn("embedding")`)
+	require.Contains(t, items, "Path      postgres.ColumnLtree")
+	require.Contains(t, items, `PathColumn      = postgres.LtreeColumn("path")`)
+	require.Contains(t, items, "During    postgres.ColumnRange")
+	require.Contains(t, readGeneratedFile(t, path.Join(dirPath, "store", "model", "items.go")), "Embedding string")
+}
+
+func TestProcessSchema_InstalledExtensions(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_sql_builder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "items",
+				Columns: []metadata.Column{
+					{Name: "name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+				},
+			},
+		},
+		Extensions: []string{"fuzzystrmatch", "pg_trgm"},
+	}
+
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect))
+
+	require.Contains(t, readGeneratedFile(t, path.Join(dirPath, "store", "table", "installed_extensions.go")), `
+var InstalledExtensions = map[string]bool{
+	"fuzzystrmatch": true,
+	"pg_trgm":       true,
+}`)
+
+	schema.TablesMetaData = append(schema.TablesMetaData, metadata.Table{Name: "orders", Columns: schema.TablesMetaData[0].Columns})
+
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect).
+		UseSchema(func(schemaMetaData metadata.Schema) Schema {
+			return DefaultSchema(schemaMetaData).
+				UseSQLBuilder(DefaultSQLBuilder().
+					UseTable(func(table metadata.Table) TableSQLBuilder {
+						return DefaultTableSQLBuilder(table).UsePath("/table/" + table.Name)
+					}))
+		}))
+
+	require.FileExists(t, path.Join(dirPath, "store", "table", "installed_extensions.go"))
+	_, err = os.Stat(path.Join(dirPath, "store", "table", "items", "installed_extensions.go"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(path.Join(dirPath, "store", "table", "orders", "installed_extensions.go"))
+	require.True(t, os.IsNotExist(err))
+
+	schema.Extensions = nil
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect))
+
+	_, err = os.Stat(path.Join(dirPath, "store", "table", "installed_extensions.go"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestProcessSchema_EnumColumns(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_sql_builder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	ratingColumn := metadata.Column{Name: "rating", DataType: metadata.DataType{Name: "mpaa_rating", Kind: metadata.EnumType}}
+
+	schema := metadata.Schema{
+		Name: "store",
+		TablesMetaData: []metadata.Table{
+			{Name: "film", Columns: []metadata.Column{ratingColumn}},
+			{Name: "rating_history", Columns: []metadata.Column{ratingColumn}},
+		},
+		ViewsMetaData: []metadata.Table{
+			{Name: "film_list", Columns: []metadata.Column{ratingColumn}},
+		},
+		EnumsMetaData: []metadata.Enum{
+			{Name: "mpaa_rating", Values: []string{"G", "PG-13"}},
+		},
+	}
+
+	// typed enums are off by default
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect))
+
+	film := readGeneratedFile(t, path.Join(dirPath, "store", "table", "film.go"))
+	require.Contains(t, film, "Rating postgres.ColumnString\n")
+	_, err = os.Stat(path.Join(dirPath, "store", "table", "enum_columns.go"))
+	require.True(t, os.IsNotExist(err))
+	require.Contains(t, readGeneratedFile(t, path.Join(dirPath, "store", "enum", "mpaa_rating.go")),
+		"Pg13 postgres.StringExpression\n")
+
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect).
+		UseSchema(func(schemaMetaData metadata.Schema) Schema {
+			return DefaultSchema(schemaMetaData).UseSQLBuilder(DefaultSQLBuilder().UseTypedEnums(true))
+		}),
+	)
+
+	film = readGeneratedFile(t, path.Join(dirPath, "store", "table", "film.go"))
+	require.Contains(t, film, "Rating MpaaRatingColumn\n")
+	require.Contains(t, film, `= MpaaRatingColumn{postgres.StringColumn("rating")}`)
+
+	for _, dir := range []string{"table", "view"} {
+		enumColumns := readGeneratedFile(t, path.Join(dirPath, "store", dir, "enum_columns.go"))
+		require.Equal(t, 1, strings.Count(enumColumns, "type MpaaRatingColumn struct {"))
+		require.Contains(t, enumColumns, "func (c MpaaRatingColumn) EQ(rhs MpaaRatingExpression) postgres.BoolExpression {")
+	}
+
+	enum := readGeneratedFile(t, path.Join(dirPath, "store", "enum", "mpaa_rating.go"))
+	require.Contains(t, enum, "func (MpaaRatingValue) IsMpaaRatingEnum() {}")
+	require.Contains(t, enum, `Pg13: MpaaRatingValue{postgres.NewEnumValue("PG-13")},`)
+
+	model := readGeneratedFile(t, path.Join(dirPath, "store", "model", "mpaa_rating.go"))
+	require.Contains(t, model, "func (e MpaaRating) AllValues() []MpaaRating {\n\treturn []MpaaRating{\n\t\tMpaaRating_G,\n\t\tMpaaRating_Pg13,\n\t}\n}")
+	require.Contains(t, model, "func (e MpaaRating) Value() (driver.Value, error) {")
+	require.Contains(t, model, "func (e *MpaaRating) UnmarshalJSON(data []byte) error {")
+}
+
+func TestProcessSchema_SchemaNamePlaceholder(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet_sql_builder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	schema := metadata.Schema{
+		Name: "tenant_template",
+		TablesMetaData: []metadata.Table{
+			{Name: "users", Columns: []metadata.Column{{Name: "id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}}}},
+		},
+	}
+
+	ProcessSchema(dirPath, schema, Default(postgres.Dialect).
+		UseSchema(func(schema metadata.Schema) Schema {
+			return DefaultSchema(schema).UseSQLBuilder(DefaultSQLBuilder().UseSchemaName("{{tenant}}"))
+		}),
+	)
+
+	users := readGeneratedFile(t, path.Join(dirPath, "tenant_template", "table", "users.go"))
+	require.Contains(t, users, `var Users = newUsersTable("{{tenant}}", "users", "")`)
+}
@@ -13,4 +13,5 @@ require (
 	github.com/pkg/profile v1.5.0 //tests
 	github.com/shopspring/decimal v1.2.0 // tests
 	github.com/stretchr/testify v1.6.1 // tests
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,122 @@
+package jet
+
+// AggregateFunc is a builder of aggregate function calls with full aggregate expression clause support, for
+// instance calls of user defined aggregates or aggregates provided by database extensions.
+type AggregateFunc struct {
+	ExpressionInterfaceImpl
+
+	name      string
+	arguments []Expression
+	distinct  bool
+	orderBy   []OrderByClause
+	filter    BoolExpression
+	window    Window
+}
+
+// AGG creates new call of aggregate function name with arguments, for instance:
+//
+//	AGG("my_custom_agg", table.Col1, table.Col2).DISTINCT().ORDER_BY(table.Col1.DESC()).FILTER(table.Col3.IS_NOT_NULL())
+//
+// Aggregate result is of generic Expression type, so it should be wrapped with one of the type wrappers (StringExp,
+// IntExp, ...) to be used in typed expressions.
+func AGG(name string, arguments ...Expression) *AggregateFunc {
+	if name == "" {
+		panic("jet: aggregate function name is empty")
+	}
+
+	ret := &AggregateFunc{
+		name:      name,
+		arguments: parameters(arguments),
+	}
+
+	ret.ExpressionInterfaceImpl.Parent = ret
+
+	return ret
+}
+
+// DISTINCT aggregates only distinct argument values
+func (a *AggregateFunc) DISTINCT() *AggregateFunc {
+	a.distinct = true
+	return a
+}
+
+// ORDER_BY specifies order in which argument values are aggregated
+func (a *AggregateFunc) ORDER_BY(orderBy ...OrderByClause) *AggregateFunc {
+	a.orderBy = orderBy
+	return a
+}
+
+// FILTER aggregates only input rows for which condition evaluates to true. For database versions without FILTER
+// clause support, filter is emulated by passing NULL as the first argument for the rows not satisfying condition,
+// which gives the same result only for aggregates ignoring NULL values (count, sum, avg, ...).
+func (a *AggregateFunc) FILTER(condition BoolExpression) *AggregateFunc {
+	a.filter = condition
+	return a
+}
+
+// OVER turns aggregate into window function, computed over window
+func (a *AggregateFunc) OVER(window ...Window) Expression {
+	if len(window) > 0 {
+		a.window = window[0]
+	} else {
+		a.window = newWindowImpl(nil)
+	}
+
+	return a
+}
+
+func (a *AggregateFunc) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.aggregateWritten()
+	out.WriteString(a.name + "(")
+
+	if a.distinct {
+		out.WriteString("DISTINCT")
+	}
+
+	arguments := a.arguments
+	emulateFilter := a.filter != nil && out.Dialect != nil && !out.Dialect.Supports(FeatureFilterClause)
+
+	if emulateFilter {
+		arguments = a.filterArguments(out)
+	}
+
+	serializeExpressionList(statement, arguments, ", ", out, FallTrough(options)...)
+
+	if len(a.orderBy) > 0 {
+		orderBy := &ClauseOrderBy{List: a.orderBy, SkipNewLine: true}
+		orderBy.Serialize(statement, out, FallTrough(options)...)
+	}
+
+	out.WriteString(")")
+
+	if a.filter != nil && !emulateFilter {
+		out.WriteString("FILTER (WHERE")
+		a.filter.serialize(statement, out, NoWrap.WithFallTrough(options)...)
+		out.WriteString(")")
+	}
+
+	if a.window != nil {
+		out.RequireFeature(FeatureWindowFunctions)
+		out.WriteString("OVER")
+		a.window.serialize(statement, out, FallTrough(options)...)
+	}
+}
+
+// filterArguments returns aggregate arguments with the first argument replaced with CASE expression, which is NULL
+// for the rows not satisfying filter condition
+func (a *AggregateFunc) filterArguments(out *SQLBuilder) []Expression {
+	if len(a.arguments) == 0 {
+		out.RequireFeature(FeatureFilterClause)
+	}
+
+	first := a.arguments[0]
+
+	if wrapped, ok := first.(*skipParenthesisWrap); ok && wrapped.Expression == STAR {
+		first = FixedLiteral(1)
+	}
+
+	arguments := append([]Expression{}, a.arguments...)
+	arguments[0] = skipWrap(CASE().WHEN(a.filter).THEN(first))
+
+	return arguments
+}
@@ -0,0 +1,64 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// TxBeginner is db connection able to begin transaction, for instance *sql.DB or *sql.Conn
+type TxBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// CanBeginTx returns true if db, or db connection wrapped with jet db wrappers, can begin transaction
+func CanBeginTx(db interface{}) bool {
+	for current := db; current != nil; current = innerDB(current) {
+		if _, ok := current.(TxBeginner); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BeginTx begins transaction over db connection wrapped with jet db wrappers (WithConfig, ProxyMode, DeadlineLimit,
+// WrapTx or StmtCache). Transaction is returned both as it is, and wrapped with the same wrappers as db (except
+// StmtCache, which caches statements prepared on db), so that statements executed over wrappedTx keep db settings.
+// Use CanBeginTx to check if db can begin transaction.
+func BeginTx(ctx context.Context, db interface{}, opts *sql.TxOptions) (tx *sql.Tx, wrappedTx qrm.DB, err error) {
+	switch wrapper := db.(type) {
+	case *proxyModeDB:
+		tx, wrappedTx, err = BeginTx(ctx, wrapper.DB, opts)
+		if err == nil {
+			wrappedTx = &proxyModeDB{DB: wrappedTx}
+		}
+		return
+	case *deadlineLimitDB:
+		tx, wrappedTx, err = BeginTx(ctx, wrapper.DB, opts)
+		if err == nil {
+			wrappedTx = &deadlineLimitDB{DB: wrappedTx, threshold: wrapper.threshold, safetyLimit: wrapper.safetyLimit}
+		}
+		return
+	case *configDB:
+		tx, wrappedTx, err = BeginTx(ctx, wrapper.DB, opts)
+		if err == nil {
+			wrappedTx = &configDB{DB: wrappedTx, config: wrapper.config}
+		}
+		return
+	case *txDB:
+		tx, wrappedTx, err = BeginTx(ctx, wrapper.DB, opts)
+		if err == nil {
+			wrappedTx = &txDB{DB: wrappedTx, options: wrapper.options}
+		}
+		return
+	case *StmtCache:
+		return BeginTx(ctx, wrapper.db, opts)
+	case TxBeginner:
+		tx, err = wrapper.BeginTx(ctx, opts)
+		return tx, tx, err
+	}
+
+	panic("jet: db can not begin transaction")
+}
@@ -0,0 +1,111 @@
+package jet
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType   = reflect.TypeOf(time.Time{})
+	valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+)
+
+// bindValue converts go slices, arrays, maps and structs into query arguments drivers can bind. Slices and arrays are
+// bound as postgres array text representation if dialect supports array arguments, and as JSON text otherwise.
+// Maps and structs are bound as JSON text. Byte slices, time.Time and driver.Valuer values are left unchanged.
+func bindValue(dialect Dialect, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	valueType := reflect.TypeOf(value)
+
+	if valueType.Implements(valuerType) || valueType == timeType {
+		return value
+	}
+
+	switch valueType.Kind() {
+	case reflect.Slice, reflect.Array:
+		if valueType.Elem().Kind() == reflect.Uint8 { // []byte
+			return value
+		}
+
+		if dialect != nil && dialect.ArrayArguments() {
+			if array, ok := arrayText(reflect.ValueOf(value)); ok {
+				return array
+			}
+		}
+
+		return jsonText(value)
+	case reflect.Map, reflect.Struct:
+		if _, ok := value.(toStringInterface); ok && valueType.Kind() == reflect.Struct {
+			return value
+		}
+
+		return jsonText(value)
+	}
+
+	return value
+}
+
+func jsonText(value interface{}) string {
+	data, err := json.Marshal(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("jet: failed to marshal %T query argument to json, %s", value, err))
+	}
+
+	return string(data)
+}
+
+// arrayText returns postgres array text representation of the slice of strings, integers, floats or booleans
+func arrayText(array reflect.Value) (string, bool) {
+	var text strings.Builder
+
+	text.WriteByte('{')
+
+	for i := 0; i < array.Len(); i++ {
+		if i > 0 {
+			text.WriteByte(',')
+		}
+
+		element := array.Index(i)
+
+		if element.Kind() == reflect.Ptr || element.Kind() == reflect.Interface {
+			if element.IsNil() {
+				text.WriteString("NULL")
+				continue
+			}
+
+			element = element.Elem()
+		}
+
+		switch element.Kind() {
+		case reflect.String:
+			text.WriteByte('"')
+			text.WriteString(arrayElementEscaper.Replace(element.String()))
+			text.WriteByte('"')
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			text.WriteString(strconv.FormatInt(element.Int(), 10))
+		case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			text.WriteString(strconv.FormatUint(element.Uint(), 10))
+		case reflect.Float32, reflect.Float64:
+			text.WriteString(strconv.FormatFloat(element.Float(), 'g', -1, 64))
+		case reflect.Bool:
+			text.WriteString(strconv.FormatBool(element.Bool()))
+		default:
+			return "", false
+		}
+	}
+
+	text.WriteByte('}')
+
+	return text.String(), true
+}
+
+var arrayElementEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
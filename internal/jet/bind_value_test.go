@@ -0,0 +1,45 @@
+package jet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+var arrayDialect = NewDialect(DialectParams{ArrayArguments: true})
+
+func TestBindValue(t *testing.T) {
+	var none *string
+	text := "text"
+	now := time.Now()
+	id := uuid.New()
+
+	require.Equal(t, `{1,2,3}`, bindValue(arrayDialect, []int64{1, 2, 3}))
+	require.Equal(t, `{}`, bindValue(arrayDialect, []int32{}))
+	require.Equal(t, `{"a","b \"c\"","d\\e"}`, bindValue(arrayDialect, []string{"a", `b "c"`, `d\e`}))
+	require.Equal(t, `{"text",NULL}`, bindValue(arrayDialect, []*string{&text, none}))
+	require.Equal(t, `{1.5,true}`, bindValue(arrayDialect, []interface{}{1.5, true}))
+	require.Equal(t, `[{"A":1}]`, bindValue(arrayDialect, []struct{ A int }{{A: 1}}))
+
+	require.Equal(t, `[1,2,3]`, bindValue(defaultDialect, []int64{1, 2, 3}))
+	require.Equal(t, `{"key":"value"}`, bindValue(defaultDialect, map[string]interface{}{"key": "value"}))
+	require.Equal(t, `{"Name":"film","Tags":["new"]}`, bindValue(defaultDialect, struct {
+		Name string
+		Tags []string
+	}{Name: "film", Tags: []string{"new"}}))
+
+	require.Equal(t, []byte("bytes"), bindValue(arrayDialect, []byte("bytes")))
+	require.Equal(t, now, bindValue(arrayDialect, now))
+	require.Equal(t, id, bindValue(arrayDialect, id))
+	require.Equal(t, int64(1), bindValue(arrayDialect, int64(1)))
+	require.Nil(t, bindValue(arrayDialect, nil))
+}
+
+func TestBindValueInvalidJSON(t *testing.T) {
+	require.PanicsWithValue(t, "jet: failed to marshal map[string]interface {} query argument to json, "+
+		"json: unsupported type: chan int", func() {
+		bindValue(defaultDialect, map[string]interface{}{"ch": make(chan int)})
+	})
+}
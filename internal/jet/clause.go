@@ -98,6 +98,10 @@ func (c *ClauseWhere) Serialize(statementType StatementType, out *SQLBuilder, op
 	}
 	out.WriteString("WHERE")
 
+	if out.references != nil {
+		out.references.addConjunctFilters(c.Condition)
+	}
+
 	out.IncreaseIdent(6)
 	c.Condition.serialize(statementType, out, NoWrap.WithFallTrough(options)...)
 	out.DecreaseIdent(6)
@@ -106,11 +110,20 @@ func (c *ClauseWhere) Serialize(statementType StatementType, out *SQLBuilder, op
 // ClauseGroupBy struct
 type ClauseGroupBy struct {
 	List []GroupByClause
+	// NonAggregatedProjections, if set, are projections statement is grouped by, excluding projections containing
+	// aggregate functions. Grouping by List clauses follows.
+	NonAggregatedProjections []Projection
 }
 
 // Serialize serializes clause into SQLBuilder
 func (c *ClauseGroupBy) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
-	if len(c.List) == 0 {
+	list := c.List
+
+	if len(c.NonAggregatedProjections) > 0 {
+		list = append(nonAggregatedProjections(out, c.NonAggregatedProjections), c.List...)
+	}
+
+	if len(list) == 0 {
 		return
 	}
 
@@ -119,7 +132,7 @@ func (c *ClauseGroupBy) Serialize(statementType StatementType, out *SQLBuilder,
 
 	out.IncreaseIdent()
 
-	for i, c := range c.List {
+	for i, c := range list {
 		if i > 0 {
 			out.WriteString(", ")
 		}
@@ -157,6 +170,8 @@ func (c *ClauseHaving) Serialize(statementType StatementType, out *SQLBuilder, o
 type ClauseOrderBy struct {
 	List        []OrderByClause
 	SkipNewLine bool
+	// DistinctOn is a list of SELECT DISTINCT ON expressions, which have to match the leftmost ORDER BY expressions
+	DistinctOn []ColumnExpression
 }
 
 // Serialize serializes clause into SQLBuilder
@@ -165,6 +180,10 @@ func (o *ClauseOrderBy) Serialize(statementType StatementType, out *SQLBuilder,
 		return
 	}
 
+	if len(o.DistinctOn) > 0 {
+		o.validateDistinctOn(out)
+	}
+
 	if !o.SkipNewLine {
 		out.NewLine()
 	}
@@ -183,6 +202,27 @@ func (o *ClauseOrderBy) Serialize(statementType StatementType, out *SQLBuilder,
 	out.DecreaseIdent()
 }
 
+// validateDistinctOn checks that the leftmost ORDER BY expressions, in any order, are DISTINCT ON expressions
+func (o *ClauseOrderBy) validateDistinctOn(out *SQLBuilder) {
+	distinctOn := map[string]bool{}
+
+	for _, column := range o.DistinctOn {
+		distinctOn[orderByExpressionString(column, out.Dialect)] = true
+	}
+
+	for i, clause := range o.List {
+		if i >= len(o.DistinctOn) {
+			break
+		}
+
+		expression := orderByExpression(clause)
+
+		if expression == nil || !distinctOn[orderByExpressionString(expression, out.Dialect)] {
+			panic("jet: SELECT DISTINCT ON expressions must match initial ORDER BY expressions")
+		}
+	}
+}
+
 // ClauseLimit struct
 type ClauseLimit struct {
 	Count int64
@@ -190,10 +230,10 @@ type ClauseLimit struct {
 
 // Serialize serializes clause into SQLBuilder
 func (l *ClauseLimit) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
-	if l.Count >= 0 {
+	if count := l.count(statementType, out); count >= 0 {
 		out.NewLine()
 		out.WriteString("LIMIT")
-		out.insertParametrizedArgument(l.Count)
+		out.insertParametrizedArgument(count)
 	}
 }
 
@@ -204,10 +244,16 @@ type ClauseOffset struct {
 
 // Serialize serializes clause into SQLBuilder
 func (o *ClauseOffset) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
-	if o.Count >= 0 {
+	count := o.Count
+
+	if out.pageWindow != nil && out.statementDepth <= 1 && statementType == SelectStatementType {
+		count = out.pageWindow.offsetCount(count)
+	}
+
+	if count >= 0 {
 		out.NewLine()
 		out.WriteString("OFFSET")
-		out.insertParametrizedArgument(o.Count)
+		out.insertParametrizedArgument(count)
 	}
 }
 
@@ -223,7 +269,6 @@ func (f *ClauseFor) Serialize(statementType StatementType, out *SQLBuilder, opti
 	}
 
 	out.NewLine()
-	out.WriteString("FOR")
 	f.Lock.serialize(statementType, out, FallTrough(options)...)
 }
 
@@ -288,6 +333,19 @@ type ClauseUpdate struct {
 	Table SerializerTable
 }
 
+// Projections returns list of all the columns of the table to update
+func (u *ClauseUpdate) Projections() ProjectionList {
+	var ret ProjectionList
+
+	for _, column := range u.Table.columns() {
+		if projection, ok := column.(Projection); ok {
+			ret = append(ret, projection)
+		}
+	}
+
+	return ret
+}
+
 // Serialize serializes clause into SQLBuilder
 func (u *ClauseUpdate) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
 	out.NewLine()
@@ -329,7 +387,7 @@ func (s *SetClause) Serialize(statementType StatementType, out *SQLBuilder, opti
 			panic("jet: nil column in columns list for SET clause")
 		}
 
-		out.WriteIdentifier(column.Name())
+		out.writeColumnName(column)
 
 		out.WriteString(" = ")
 
@@ -606,3 +664,82 @@ func (r *ClauseReturning) Serialize(statementType StatementType, out *SQLBuilder
 func (r ClauseReturning) Projections() ProjectionList {
 	return r.ProjectionList
 }
+
+// ClauseOutput type, used by dialects (SQL Server) returning inserted, updated or deleted rows with OUTPUT clause.
+// Columns are read from Prefix pseudo table (INSERTED or DELETED), and are aliased the same way as SELECT projections.
+type ClauseOutput struct {
+	Prefix         string
+	ProjectionList []Projection
+}
+
+// Serialize for ClauseOutput
+func (o *ClauseOutput) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if len(o.ProjectionList) == 0 {
+		return
+	}
+
+	if !out.canReadAny(o.ProjectionList) {
+		out.projectionsDenied = true
+	}
+
+	out.NewLine()
+	out.WriteString("OUTPUT")
+	out.IncreaseIdent(7)
+
+	first := true
+
+	for _, projection := range flattenProjections(o.ProjectionList) {
+		if projection == nil {
+			panic("jet: Projection is nil")
+		}
+
+		if !out.canRead(projection) {
+			continue
+		}
+
+		if !first {
+			out.WriteString(",")
+			out.NewLine()
+		}
+		first = false
+
+		column, ok := projection.(ColumnExpression)
+
+		if !ok {
+			projection.serializeForProjection(statementType, out)
+			continue
+		}
+
+		out.WriteString(o.Prefix)
+		out.WriteByte('.')
+		out.WriteIdentifier(column.Name())
+		out.WriteString("AS")
+		out.WriteAlias(column.defaultAlias())
+	}
+
+	out.DecreaseIdent(7)
+}
+
+// Projections for ClauseOutput
+func (o ClauseOutput) Projections() ProjectionList {
+	return o.ProjectionList
+}
+
+func flattenProjections(projections []Projection) []Projection {
+	var ret []Projection
+
+	for _, projection := range projections {
+		switch p := projection.(type) {
+		case ProjectionList:
+			ret = append(ret, flattenProjections(p)...)
+		case ColumnList:
+			for _, column := range p {
+				ret = append(ret, column)
+			}
+		default:
+			ret = append(ret, projection)
+		}
+	}
+
+	return ret
+}
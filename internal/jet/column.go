@@ -101,12 +101,27 @@ func (c ColumnExpressionImpl) serializeForProjection(statement StatementType, ou
 }
 
 func (c ColumnExpressionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.groupingColumnWritten(c.Parent)
 
 	if c.subQuery != nil {
 		out.WriteIdentifier(c.subQuery.Alias())
 		out.WriteByte('.')
 		out.WriteIdentifier(c.defaultAlias())
 	} else {
+		if out.references != nil || out.columnAccess != nil {
+			column, ok := c.Parent.(Column)
+
+			if !ok {
+				column = &c
+			}
+
+			if out.references != nil {
+				out.references.addColumn(column)
+			}
+
+			out.checkReadAccess(column)
+		}
+
 		if c.tableName != "" && !contains(options, ShortName) {
 			out.WriteIdentifier(c.tableName)
 			out.WriteByte('.')
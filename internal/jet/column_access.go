@@ -0,0 +1,115 @@
+package jet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ColumnAccessPolicy returns true if column can be accessed in the context, for instance depending on the roles
+// of the caller stored in the context.
+type ColumnAccessPolicy func(ctx context.Context, column Column) bool
+
+// SetColumnAccessPolicy sets column access policy applied on statement execution. Columns caller can not access are
+// excluded from SELECT projections, if projected as a bare (optionally aliased) column. Statements referencing such
+// columns anywhere else (expressions, conditions, sub-queries), or writing into them (INSERT, UPDATE), are rejected
+// with an error, as well as statements with all the projections excluded.
+// Policy is not applied to Sql and DebugSql methods, because they are not aware of the execution context.
+func SetColumnAccessPolicy(policy ColumnAccessPolicy) {
+	updateGlobalConfig(func(config *Config) {
+		config.ColumnAccessPolicy = policy
+	})
+}
+
+func (s *SQLBuilder) canRead(projection Projection) bool {
+	if s.columnAccess == nil {
+		return true
+	}
+
+	switch p := projection.(type) {
+	case ColumnList:
+		for _, column := range p {
+			if s.canRead(column) {
+				return true
+			}
+		}
+		return false
+	case ProjectionList:
+		for _, subProjection := range p {
+			if s.canRead(subProjection) {
+				return true
+			}
+		}
+		return false
+	case Column:
+		return s.columnAccess(p)
+	case *alias:
+		if column, ok := p.expression.(Column); ok {
+			return s.columnAccess(column)
+		}
+	}
+
+	return true
+}
+
+// canReadAny returns true if at least one of the projections can be read
+func (s *SQLBuilder) canReadAny(projections []Projection) bool {
+	for _, projection := range projections {
+		if projection == nil || s.canRead(projection) {
+			return true
+		}
+	}
+
+	return len(projections) == 0
+}
+
+// checkReadAccess records column referenced by statement, if caller can not access it
+func (s *SQLBuilder) checkReadAccess(column Column) {
+	if s.columnAccess == nil || s.columnAccess(column) {
+		return
+	}
+
+	columnName := column.TableName() + "." + column.Name()
+
+	for _, denied := range s.readDeniedColumns {
+		if denied == columnName {
+			return
+		}
+	}
+
+	s.readDeniedColumns = append(s.readDeniedColumns, columnName)
+}
+
+func (s *SQLBuilder) checkWriteAccess(column Column) {
+	if s.columnAccess == nil {
+		return
+	}
+
+	if columnList, ok := column.(ColumnList); ok {
+		for _, c := range columnList {
+			s.checkWriteAccess(c)
+		}
+		return
+	}
+
+	if !s.columnAccess(column) {
+		s.deniedColumns = append(s.deniedColumns, column.TableName()+"."+column.Name())
+	}
+}
+
+func (s *SQLBuilder) accessError() error {
+	if len(s.deniedColumns) > 0 {
+		return fmt.Errorf("jet: write access denied to column(s): %s", strings.Join(s.deniedColumns, ", "))
+	}
+
+	if len(s.readDeniedColumns) > 0 {
+		return fmt.Errorf("jet: read access denied to column(s): %s", strings.Join(s.readDeniedColumns, ", "))
+	}
+
+	if s.projectionsDenied {
+		return errors.New("jet: read access denied to all the projected columns")
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testStatement struct {
+	SerializerStatement
+}
+
+func newTestStatement(statementType StatementType, clauses ...Clause) Statement {
+	statement := &testStatement{}
+	statement.SerializerStatement = NewStatementImpl(defaultDialect, statementType, statement, clauses...)
+	return statement
+}
+
+type roleKey struct{}
+
+func TestColumnAccessPolicy(t *testing.T) {
+	SetColumnAccessPolicy(func(ctx context.Context, column Column) bool {
+		return column.Name() != "col_float" || ctx.Value(roleKey{}) == "admin"
+	})
+	defer SetColumnAccessPolicy(nil)
+
+	db := &recordingDB{}
+	adminCtx := context.WithValue(context.Background(), roleKey{}, "admin")
+
+	selectStmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1Col1, ColumnList{table1ColFloat}, table1ColFloat, table1ColBool}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+	)
+
+	_, err := selectStmt.ExecContext(context.Background(), db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, `
+SELECT table1.col1 AS "table1.col1",
+     table1.col_bool AS "table1.col_bool"
+FROM db.table1;
+`, db.query)
+
+	_, err = selectStmt.ExecContext(adminCtx, db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, `
+SELECT table1.col1 AS "table1.col1",
+     table1.col_float AS "table1.col_float",
+     table1.col_float AS "table1.col_float",
+     table1.col_bool AS "table1.col_bool"
+FROM db.table1;
+`, db.query)
+
+	updateStmt := newTestStatement(UpdateStatementType,
+		&ClauseUpdate{Table: table1},
+		&SetClause{Columns: []Column{table1ColBool, table1ColFloat}, Values: []Serializer{Bool(true), Float(1.1)}},
+	)
+
+	db.query = ""
+	_, err = updateStmt.ExecContext(context.Background(), db)
+	require.EqualError(t, err, "jet: write access denied to column(s): table1.col_float")
+	require.Empty(t, db.query)
+
+	_, err = updateStmt.ExecContext(adminCtx, db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.NotEmpty(t, db.query)
+
+	// policy is not applied outside of execution context
+	query, _ := selectStmt.Sql()
+	require.Contains(t, query, "table1.col_float")
+}
+
+func TestColumnAccessPolicyExpressions(t *testing.T) {
+	SetColumnAccessPolicy(func(ctx context.Context, column Column) bool {
+		return column.Name() != "col_float"
+	})
+	defer SetColumnAccessPolicy(nil)
+
+	db := &recordingDB{}
+
+	testCases := []Projection{
+		table1ColFloat.ADD(Float(0)).AS("table1.col_float"),
+		NewFunc("UPPER", []Expression{table1ColFloat}, nil),
+		CASE().WHEN(table1ColBool).THEN(table1ColFloat).ELSE(Float(0)),
+	}
+
+	for _, projection := range testCases {
+		db.query = ""
+		stmt := newTestStatement(SelectStatementType,
+			&ClauseSelect{ProjectionList: []Projection{table1Col1, projection}},
+			&ClauseFrom{Tables: []Serializer{table1}},
+		)
+
+		_, err := stmt.ExecContext(context.Background(), db)
+		require.EqualError(t, err, "jet: read access denied to column(s): table1.col_float")
+		require.Empty(t, db.query)
+	}
+
+	whereStmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1Col1}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+		&ClauseWhere{Condition: table1ColFloat.GT(Float(1000))},
+	)
+
+	_, err := whereStmt.ExecContext(context.Background(), db)
+	require.EqualError(t, err, "jet: read access denied to column(s): table1.col_float")
+
+	aliasedStmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1Col1, table1ColFloat.AS("salary")}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+	)
+
+	_, err = aliasedStmt.ExecContext(context.Background(), db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, `
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1;
+`, db.query)
+
+	db.query = ""
+	allDeniedStmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1ColFloat, ColumnList{table1ColFloat}}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+	)
+
+	_, err = allDeniedStmt.ExecContext(context.Background(), db)
+	require.EqualError(t, err, "jet: read access denied to all the projected columns")
+	require.Empty(t, db.query)
+}
@@ -11,9 +11,20 @@ type columnAssigmentImpl struct {
 	expression Expression
 }
 
+// NewColumnAssigment is utility function to create column assigment for dialect specific column types, from outside
+// of jet package
+func NewColumnAssigment(column ColumnExpression, expression Expression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     column,
+		expression: expression,
+	}
+}
+
 func (a columnAssigmentImpl) isColumnAssigment() {}
 
 func (a columnAssigmentImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.columnWritten(a.column)
+
 	a.column.serialize(statement, out, ShortName.WithFallTrough(options)...)
 	out.WriteString("=")
 	a.expression.serialize(statement, out, FallTrough(options)...)
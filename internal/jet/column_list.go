@@ -1,5 +1,14 @@
 package jet
 
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
 // ColumnList is a helper type to support list of columns as single projection
 type ColumnList []ColumnExpression
 
@@ -33,6 +42,103 @@ func (cl ColumnList) Except(excludedColumns ...Column) ColumnList {
 	return ret
 }
 
+// ByFieldNames will create new column list containing only columns matching field names. Field names are matched
+// with column names ignoring case and underscores, so field name 'firstName' matches 'first_name' column.
+func (cl ColumnList) ByFieldNames(fieldNames ...string) ColumnList {
+	fields := map[string]bool{}
+
+	for _, fieldName := range fieldNames {
+		fields[toFieldIdentifier(fieldName)] = true
+	}
+
+	var ret ColumnList
+
+	for _, column := range cl {
+		if fields[toFieldIdentifier(column.Name())] {
+			ret = append(ret, column)
+		}
+	}
+
+	return ret
+}
+
+// Diff returns new column list containing only columns with different values of oldModel and newModel fields.
+// Models have to be of the same struct type, with a field for each column, matched the same way as in MODEL.
+// Returned column list and newModel can be used for a minimal UPDATE statement, for instance:
+//
+//	changedColumns := Actor.MutableColumns.Diff(oldActor, newActor)
+//	stmt := Actor.UPDATE(changedColumns).MODEL(newActor).WHERE(Actor.ActorID.EQ(Int(newActor.ActorID)))
+func (cl ColumnList) Diff(oldModel, newModel interface{}) ColumnList {
+	oldValue := reflect.Indirect(reflect.ValueOf(oldModel))
+	newValue := reflect.Indirect(reflect.ValueOf(newModel))
+
+	utils.ValueMustBe(oldValue, reflect.Struct, "jet: old model has to be a struct")
+	utils.MustBeTrue(oldValue.Type() == newValue.Type(), "jet: old and new model have to be of the same type")
+
+	var ret ColumnList
+
+	for _, column := range cl {
+		structFieldName := utils.ToGoIdentifier(column.Name())
+		oldField := oldValue.FieldByName(structFieldName)
+
+		if !oldField.IsValid() {
+			panic("missing struct field for column : " + column.Name())
+		}
+
+		if !equalFieldValues(oldField, newValue.FieldByName(structFieldName)) {
+			ret = append(ret, column)
+		}
+	}
+
+	return ret
+}
+
+func equalFieldValues(oldField, newField reflect.Value) bool {
+	if oldField.Kind() == reflect.Ptr {
+		if oldField.IsNil() || newField.IsNil() {
+			return oldField.IsNil() == newField.IsNil()
+		}
+
+		return equalFieldValues(oldField.Elem(), newField.Elem())
+	}
+
+	if oldTime, ok := oldField.Interface().(time.Time); ok {
+		return oldTime.Equal(newField.Interface().(time.Time))
+	}
+
+	return reflect.DeepEqual(oldField.Interface(), newField.Interface())
+}
+
+// EQ creates condition comparing each column in column list with the value at the same position, where comparisons
+// are joined with AND. Useful for matching composite keys. Number of values has to match number of columns.
+func (cl ColumnList) EQ(values ...Expression) BoolExpression {
+	if len(cl) == 0 {
+		panic("jet: column list is empty")
+	}
+
+	if len(values) != len(cl) {
+		panic(fmt.Sprintf("jet: column list of %d column(s) compared with %d value(s)", len(cl), len(values)))
+	}
+
+	var condition BoolExpression
+
+	for i, column := range cl {
+		columnCondition := newBinaryBoolOperatorExpression(column, values[i], "=")
+
+		if condition == nil {
+			condition = columnCondition
+		} else {
+			condition = condition.AND(columnCondition)
+		}
+	}
+
+	return condition
+}
+
+func toFieldIdentifier(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
+
 func (cl ColumnList) fromImpl(subQuery SelectTable) Projection {
 	newProjectionList := ProjectionList{}
 
@@ -1,6 +1,11 @@
 package jet
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
 
 func TestColumn(t *testing.T) {
 	column := NewColumnImpl("col", "", nil)
@@ -12,3 +17,76 @@ func TestColumn(t *testing.T) {
 	assertProjectionSerialize(t, &column, `table1.col AS "table1.col"`)
 	assertProjectionSerialize(t, column.AS("alias1"), `table1.col AS "alias1"`)
 }
+
+func TestColumnListByFieldNames(t *testing.T) {
+	columnList := ColumnList{table1Col1, table1ColInt, table1ColTimestampz}
+
+	require.Equal(t, ColumnList{table1ColInt, table1ColTimestampz}, columnList.ByFieldNames("colInt", "ColTimestampz", "col_unknown"))
+	require.Nil(t, columnList.ByFieldNames())
+}
+
+type diffBase struct {
+	Col1          int32
+	ColTimestampz time.Time
+}
+
+type diffModel struct {
+	diffBase
+
+	ColInt   *int64
+	ColFloat []float64
+	ColBool  bool
+
+	private int
+}
+
+func TestColumnListDiff(t *testing.T) {
+	columnList := ColumnList{table1Col1, table1ColTimestampz, table1ColInt, table1ColFloat, table1ColBool}
+	colInt := int64(10)
+	now := time.Now()
+
+	oldModel := diffModel{
+		diffBase: diffBase{Col1: 1, ColTimestampz: now},
+		ColInt:   &colInt,
+		ColFloat: []float64{1.1},
+		private:  1,
+	}
+
+	newModel := oldModel
+	newModel.ColTimestampz = now.UTC()
+	newModel.private = 2
+	newColInt := int64(10)
+	newModel.ColInt = &newColInt
+
+	require.Nil(t, columnList.Diff(oldModel, &newModel))
+
+	newModel.Col1 = 2
+	newModel.ColInt = nil
+	newModel.ColFloat = []float64{1.1, 2.2}
+
+	require.Equal(t, ColumnList{table1Col1, table1ColInt, table1ColFloat}, columnList.Diff(&oldModel, newModel))
+
+	require.PanicsWithValue(t, "jet: old model has to be a struct", func() {
+		columnList.Diff(1, 2)
+	})
+	require.PanicsWithValue(t, "jet: old and new model have to be of the same type", func() {
+		columnList.Diff(diffModel{}, diffBase{})
+	})
+	require.PanicsWithValue(t, "missing struct field for column : col3", func() {
+		ColumnList{table1Col3}.Diff(diffModel{}, diffModel{})
+	})
+}
+
+func TestColumnListEQ(t *testing.T) {
+	columnList := ColumnList{table1Col1, table1ColInt}
+
+	assertClauseSerialize(t, columnList.EQ(Int(1), Int(2)), "((table1.col1 = $1) AND (table1.col_int = $2))", int64(1), int64(2))
+	assertClauseSerialize(t, ColumnList{table1Col1}.EQ(Int(1)), "(table1.col1 = $1)", int64(1))
+
+	require.PanicsWithValue(t, "jet: column list of 2 column(s) compared with 1 value(s)", func() {
+		columnList.EQ(Int(1))
+	})
+	require.PanicsWithValue(t, "jet: column list is empty", func() {
+		ColumnList{}.EQ()
+	})
+}
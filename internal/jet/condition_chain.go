@@ -0,0 +1,95 @@
+package jet
+
+import (
+	"reflect"
+
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
+// ConditionChain accumulates optional conditions, for instance request driven filters, and combines them with AND or
+// OR operator. Nil conditions are skipped, so conditions can be added without checking if filter is set:
+//
+//	filter := AllOf()
+//	filter.AddIfSet(req.Name, func() BoolExpression { return table.Name.EQ(String(*req.Name)) })
+//	filter.AddIf(req.OnlyActive, func() BoolExpression { return table.Active.IS_TRUE() })
+//
+//	stmt := SELECT(table.AllColumns).FROM(table).WHERE(filter.Build())
+type ConditionChain struct {
+	operator   string
+	conditions []BoolExpression
+}
+
+// AllOf creates new condition chain, which combines conditions with AND operator
+func AllOf(conditions ...BoolExpression) *ConditionChain {
+	return (&ConditionChain{operator: "AND"}).Add(conditions...)
+}
+
+// AnyOf creates new condition chain, which combines conditions with OR operator
+func AnyOf(conditions ...BoolExpression) *ConditionChain {
+	return (&ConditionChain{operator: "OR"}).Add(conditions...)
+}
+
+// Add adds conditions to the chain. Nil conditions are skipped.
+func (c *ConditionChain) Add(conditions ...BoolExpression) *ConditionChain {
+	for _, condition := range conditions {
+		if utils.IsNil(condition) {
+			continue
+		}
+
+		c.conditions = append(c.conditions, condition)
+	}
+
+	return c
+}
+
+// AddIf adds condition created with conditionFunc to the chain, if ok is true
+func (c *ConditionChain) AddIf(ok bool, conditionFunc func() BoolExpression) *ConditionChain {
+	if !ok {
+		return c
+	}
+
+	return c.Add(conditionFunc())
+}
+
+// AddIfSet adds condition created with conditionFunc to the chain, if value is not nil and not a zero value of its
+// type (for instance nil pointer, empty string or empty slice).
+func (c *ConditionChain) AddIfSet(value interface{}, conditionFunc func() BoolExpression) *ConditionChain {
+	if isZeroValue(value) {
+		return c
+	}
+
+	return c.Add(conditionFunc())
+}
+
+// Len returns number of conditions in the chain
+func (c *ConditionChain) Len() int {
+	return len(c.conditions)
+}
+
+// Build returns conditions combined with chain operator, or nil if the chain is empty. Nil condition is ignored by
+// WHERE and HAVING clauses of SELECT statement, while UPDATE and DELETE statements will panic.
+func (c *ConditionChain) Build() BoolExpression {
+	switch len(c.conditions) {
+	case 0:
+		return nil
+	case 1:
+		return c.conditions[0]
+	}
+
+	return newBoolExpressionListOperator(c.operator, c.conditions...)
+}
+
+func isZeroValue(value interface{}) bool {
+	if utils.IsNil(value) {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+
+	return reflect.DeepEqual(value, reflect.Zero(v.Type()).Interface())
+}
@@ -0,0 +1,29 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionChain(t *testing.T) {
+	var name *string
+	var ids []int64
+	status := "active"
+
+	filter := AllOf(table1ColBool, nil).
+		AddIfSet(name, func() BoolExpression { return table2ColStr.EQ(String(*name)) }).
+		AddIfSet(ids, func() BoolExpression { return table1ColInt.IN(Int(ids[0])) }).
+		AddIfSet(status, func() BoolExpression { return table2ColStr.EQ(String(status)) }).
+		AddIf(false, func() BoolExpression { return table1ColFloat.GT(Float(1)) })
+
+	require.Equal(t, 2, filter.Len())
+	assertClauseSerialize(t, filter.Build(), `(
+    table1.col_bool
+        AND (table2.col_str = $1)
+)`, "active")
+
+	assertClauseSerialize(t, AnyOf().Add(table1ColBool).Build(), "table1.col_bool")
+	require.Nil(t, AnyOf(nil).AddIfSet(0, nil).Build())
+	require.Nil(t, AllOf().Build())
+}
@@ -0,0 +1,111 @@
+package jet
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// Config contains statement execution settings. Config is a value type, so once passed to WithConfig or
+// SetGlobalConfig it can not be changed by the caller anymore.
+type Config struct {
+	// Logger is called with each statement before execution
+	Logger LoggerFunc
+	// QueryLogger is called with each statement after execution
+	QueryLogger QueryLoggerFunc
+	// Tracer, if set, starts a span around each statement execution (see SetTracer)
+	Tracer Tracer
+	// ColumnAccessPolicy, if set, is applied on statement execution (see SetColumnAccessPolicy)
+	ColumnAccessPolicy ColumnAccessPolicy
+	// InjectionAudit turns sql injection audit on (see SetInjectionAudit)
+	InjectionAudit bool
+	// ResultLimit, if set, limits the number of rows and the size of query result mapped into destination.
+	// Query returns qrm.ErrResultLimitExceeded if the limit is exceeded. Rows method is not limited, and it should
+	// be used to stream result sets of unbounded size.
+	ResultLimit qrm.ResultLimit
+	// TolerantMode, if set, enables tolerant mode of query result mapping with the logger, instead of the tolerant
+	// mode set with qrm.SetTolerantMode.
+	TolerantMode qrm.MismatchLogger
+	// PanicOnSerializeError, if set, statement execution panics when statement can not be serialized into sql query,
+	// instead of returning SerializeError. Useful for fail-fast development setups.
+	PanicOnSerializeError bool
+	// StatementLocation turns statement location capture on (see SetStatementLocation). Statement location is
+	// captured when statement is constructed, so only global configuration setting is used.
+	StatementLocation bool
+}
+
+var globalConfig atomic.Value
+var globalConfigMutex sync.Mutex
+
+func init() {
+	globalConfig.Store(Config{})
+}
+
+// GlobalConfig returns configuration used to execute statements over db connections not wrapped with WithConfig
+func GlobalConfig() Config {
+	return globalConfig.Load().(Config)
+}
+
+// SetGlobalConfig replaces configuration used to execute statements over db connections not wrapped with WithConfig.
+// It is safe to call SetGlobalConfig concurrently with statement execution.
+func SetGlobalConfig(config Config) {
+	updateGlobalConfig(func(current *Config) {
+		*current = config
+	})
+}
+
+func updateGlobalConfig(update func(config *Config)) {
+	globalConfigMutex.Lock()
+	defer globalConfigMutex.Unlock()
+
+	config := GlobalConfig()
+	update(&config)
+	globalConfig.Store(config)
+}
+
+type configDB struct {
+	qrm.DB
+
+	config Config
+}
+
+// WithConfig wraps db connection or transaction, so that statements executed over it use config instead of global
+// configuration. Useful when different parts of an application need different logging or access policies.
+func WithConfig(db qrm.DB, config Config) qrm.DB {
+	return &configDB{
+		DB:     db,
+		config: config,
+	}
+}
+
+// executionConfig returns configuration of the outermost WithConfig wrapper of db, or global configuration
+func executionConfig(db interface{}) Config {
+	for current := db; current != nil; current = innerDB(current) {
+		if configDB, ok := current.(*configDB); ok {
+			return configDB.config
+		}
+	}
+
+	return GlobalConfig()
+}
+
+// innerDB returns db connection wrapped by jet db wrapper, or nil if db is not jet wrapper
+func innerDB(db interface{}) interface{} {
+	switch wrapper := db.(type) {
+	case *proxyModeDB:
+		return wrapper.DB
+	case *deadlineLimitDB:
+		return wrapper.DB
+	case *configDB:
+		return wrapper.DB
+	case *txDB:
+		return wrapper.DB
+	case *StmtCache:
+		return wrapper.db
+	case *pageWindowDB:
+		return wrapper.Queryable
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithConfig(t *testing.T) {
+	var globalLogged, executorLogged []string
+
+	SetLoggerFunc(func(ctx context.Context, statement PrintableStatement) {
+		globalLogged = append(globalLogged, statement.DebugSql())
+	})
+	defer SetLoggerFunc(nil)
+
+	db := &recordingDB{}
+	stmt := RawStatement(defaultDialect, "SELECT 1")
+
+	_, err := stmt.Exec(db)
+	require.Equal(t, sql.ErrConnDone, err)
+
+	configDB := WithConfig(db, Config{
+		Logger: func(ctx context.Context, statement PrintableStatement) {
+			executorLogged = append(executorLogged, statement.DebugSql())
+		},
+		ColumnAccessPolicy: func(ctx context.Context, column Column) bool {
+			return column.Name() != "col_float"
+		},
+	})
+
+	_, err = stmt.Exec(ProxyMode(configDB))
+	require.Equal(t, sql.ErrConnDone, err)
+
+	require.Equal(t, []string{"SELECT 1;\n"}, globalLogged)
+	require.Equal(t, []string{"SELECT 1;\n"}, executorLogged)
+
+	selectStmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1ColInt, table1ColFloat}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+	)
+
+	_, err = selectStmt.Exec(DeadlineLimit(configDB, time.Second, 10))
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1;
+`, db.query)
+
+	// not wrapped db uses global config, without column access policy
+	_, err = selectStmt.Exec(db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Contains(t, db.query, "table1.col_float")
+}
+
+func TestSetGlobalConfigConcurrently(t *testing.T) {
+	defer SetGlobalConfig(Config{})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			SetInjectionAudit(true)
+			SetQueryLogger(func(ctx context.Context, info QueryInfo) {})
+		}()
+
+		go func() {
+			defer wg.Done()
+			_, _ = RawStatement(defaultDialect, "SELECT 1").Exec(&recordingDB{})
+		}()
+	}
+
+	wg.Wait()
+
+	require.True(t, GlobalConfig().InjectionAudit)
+	require.NotNil(t, GlobalConfig().QueryLogger)
+}
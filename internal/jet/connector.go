@@ -0,0 +1,348 @@
+package jet
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"strings"
+)
+
+// NewConnector wraps database driver connector, so that queries executed over its connections are reported to
+// the global query logger and tracer, including queries not built with jet (raw database/sql calls, migrations,
+// other libraries). Statements executed with jet are already reported by jet, and they are not reported twice.
+//
+//	db := sql.OpenDB(postgres.NewConnector(pgConnector))
+//
+// For queries not built with jet, QueryInfo.Statement and SpanInfo.Statement contain the sql query as sent to the
+// driver, and RowsProcessed is always 0 for queries returning rows.
+func NewConnector(connector driver.Connector) driver.Connector {
+	return &instrumentedConnector{connector: connector}
+}
+
+// NewDriverConnector is the same as NewConnector, for drivers opened with data source name.
+func NewDriverConnector(drv driver.Driver, dataSourceName string) (driver.Connector, error) {
+	if driverCtx, ok := drv.(driver.DriverContext); ok {
+		connector, err := driverCtx.OpenConnector(dataSourceName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return NewConnector(connector), nil
+	}
+
+	return NewConnector(&dsnConnector{driver: drv, dsn: dataSourceName}), nil
+}
+
+type jetExecutionKey struct{}
+
+// withJetExecution marks context of statements executed by jet, so instrumented connections can skip them
+func withJetExecution(ctx context.Context) context.Context {
+	return context.WithValue(ctx, jetExecutionKey{}, true)
+}
+
+func isJetExecution(ctx context.Context) bool {
+	executed, _ := ctx.Value(jetExecutionKey{}).(bool)
+	return executed
+}
+
+type dsnConnector struct {
+	driver driver.Driver
+	dsn    string
+}
+
+func (c *dsnConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+func (c *dsnConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+type instrumentedConnector struct {
+	connector driver.Connector
+}
+
+func (c *instrumentedConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.connector.Connect(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &instrumentedConn{Conn: conn}, nil
+}
+
+func (c *instrumentedConnector) Driver() driver.Driver {
+	return c.connector.Driver()
+}
+
+// driverStatement is a printable statement of a query executed over instrumented connection
+type driverStatement struct {
+	query string
+	args  []interface{}
+}
+
+func (d driverStatement) Sql() (query string, args []interface{}) {
+	return d.query, d.args
+}
+
+// DebugSql returns query as sent to the driver, because placeholder syntax depends on the driver
+func (d driverStatement) DebugSql() (query string) {
+	return d.query
+}
+
+func driverStatementType(query string) StatementType {
+	fields := strings.Fields(query)
+
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return StatementType(strings.ToUpper(fields[0]))
+}
+
+func namedValuesToArgs(namedValues []driver.NamedValue) []interface{} {
+	args := make([]interface{}, len(namedValues))
+
+	for i, namedValue := range namedValues {
+		args[i] = namedValue.Value
+	}
+
+	return args
+}
+
+// instrument reports execution of a query not executed by jet to the global query logger and tracer
+func instrument(ctx context.Context, operation, query string, namedValues []driver.NamedValue,
+	execute func(ctx context.Context) (rowsProcessed int64, err error)) error {
+
+	config := GlobalConfig()
+
+	if isJetExecution(ctx) || (config.QueryLogger == nil && config.Tracer == nil &&
+		ctx.Value(queryLoggerKey{}) == nil) {
+		_, err := execute(ctx)
+		return err
+	}
+
+	statement := driverStatement{query: query, args: namedValuesToArgs(namedValues)}
+
+	spanCtx, span := startSpan(ctx, config, SpanInfo{
+		Statement:     statement,
+		StatementType: driverStatementType(query),
+		Operation:     operation,
+		ArgsCount:     len(namedValues),
+	})
+
+	var rowsProcessed int64
+	var err error
+
+	duration := duration(func() {
+		rowsProcessed, err = execute(spanCtx)
+	})
+
+	if err == driver.ErrSkip { // database/sql will retry the query using prepared statement
+		endSpan(span, QueryInfo{Statement: statement, Query: query, Args: statement.args})
+		return err
+	}
+
+	queryInfo := QueryInfo{
+		Statement:     statement,
+		Query:         query,
+		Args:          statement.args,
+		RowsProcessed: rowsProcessed,
+		Duration:      duration,
+		Err:           err,
+	}
+
+	endSpan(span, queryInfo)
+	callQueryLoggerFunc(ctx, config, queryInfo)
+
+	return err
+}
+
+func rowsAffected(result driver.Result) int64 {
+	if result == nil {
+		return 0
+	}
+
+	rows, _ := result.RowsAffected()
+
+	return rows
+}
+
+type instrumentedConn struct {
+	driver.Conn
+}
+
+func (c *instrumentedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (result driver.Result, err error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	err = instrument(ctx, "Exec", query, args, func(ctx context.Context) (int64, error) {
+		result, err = execer.ExecContext(ctx, query, args)
+		return rowsAffected(result), err
+	})
+
+	return result, err
+}
+
+func (c *instrumentedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (rows driver.Rows, err error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	err = instrument(ctx, "Query", query, args, func(ctx context.Context) (int64, error) {
+		rows, err = queryer.QueryContext(ctx, query, args)
+		return 0, err
+	})
+
+	return rows, err
+}
+
+func (c *instrumentedConn) PrepareContext(ctx context.Context, query string) (stmt driver.Stmt, err error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.Conn.Prepare(query)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	instrumented := &instrumentedStmt{Stmt: stmt, conn: c.Conn, query: query}
+
+	// database/sql uses ColumnConverter of the statement instead of the default converter, so wrapper implements
+	// it only if wrapped statement does
+	if _, ok := stmt.(driver.ColumnConverter); ok {
+		return &instrumentedConverterStmt{instrumentedStmt: instrumented}, nil
+	}
+
+	return instrumented, nil
+}
+
+func (c *instrumentedConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *instrumentedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+
+	return c.Conn.Begin()
+}
+
+func (c *instrumentedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+
+	return nil
+}
+
+func (c *instrumentedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+
+	return nil
+}
+
+func (c *instrumentedConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+
+	return true
+}
+
+func (c *instrumentedConn) CheckNamedValue(namedValue *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(namedValue)
+	}
+
+	return driver.ErrSkip
+}
+
+type instrumentedStmt struct {
+	driver.Stmt
+
+	conn  driver.Conn
+	query string
+}
+
+func (s *instrumentedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (result driver.Result, err error) {
+	err = instrument(ctx, "Exec", s.query, args, func(ctx context.Context) (int64, error) {
+		if execer, ok := s.Stmt.(driver.StmtExecContext); ok {
+			result, err = execer.ExecContext(ctx, args)
+		} else {
+			var values []driver.Value
+			if values, err = namedValuesToValues(args); err == nil {
+				result, err = s.Stmt.Exec(values)
+			}
+		}
+
+		return rowsAffected(result), err
+	})
+
+	return result, err
+}
+
+func (s *instrumentedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (rows driver.Rows, err error) {
+	err = instrument(ctx, "Query", s.query, args, func(ctx context.Context) (int64, error) {
+		if queryer, ok := s.Stmt.(driver.StmtQueryContext); ok {
+			rows, err = queryer.QueryContext(ctx, args)
+		} else {
+			var values []driver.Value
+			if values, err = namedValuesToValues(args); err == nil {
+				rows, err = s.Stmt.Query(values)
+			}
+		}
+
+		return 0, err
+	})
+
+	return rows, err
+}
+
+// CheckNamedValue checks argument with the wrapped statement checker, or with the connection checker if statement
+// does not have one, the same way database/sql does for unwrapped statements
+func (s *instrumentedStmt) CheckNamedValue(namedValue *driver.NamedValue) error {
+	if checker, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(namedValue)
+	}
+
+	if checker, ok := s.conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(namedValue)
+	}
+
+	return driver.ErrSkip
+}
+
+type instrumentedConverterStmt struct {
+	*instrumentedStmt
+}
+
+func (s *instrumentedConverterStmt) ColumnConverter(idx int) driver.ValueConverter {
+	return s.Stmt.(driver.ColumnConverter).ColumnConverter(idx)
+}
+
+func namedValuesToValues(namedValues []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(namedValues))
+
+	for i, namedValue := range namedValues {
+		if namedValue.Name != "" {
+			return nil, errors.New("jet: driver does not support the use of named parameters")
+		}
+
+		values[i] = namedValue.Value
+	}
+
+	return values, nil
+}
@@ -0,0 +1,141 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConnector(t *testing.T) {
+	connector, err := NewDriverConnector(&countingDriver{}, "")
+	require.NoError(t, err)
+
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	var queries []QueryInfo
+	tracer := &testTracer{}
+
+	SetQueryLogger(func(ctx context.Context, info QueryInfo) {
+		queries = append(queries, info)
+	})
+	SetTracer(tracer)
+	defer SetGlobalConfig(Config{})
+
+	_, err = db.Exec("delete FROM table1 WHERE col1 = $1", 11)
+	require.NoError(t, err)
+
+	rows, err := db.Query("SELECT col1 FROM table1")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+
+	require.Len(t, queries, 2)
+	require.Equal(t, "delete FROM table1 WHERE col1 = $1", queries[0].Query)
+	require.Equal(t, []interface{}{int64(11)}, queries[0].Args)
+	require.Equal(t, int64(1), queries[0].RowsProcessed)
+	require.Equal(t, "delete FROM table1 WHERE col1 = $1", queries[0].Statement.DebugSql())
+	require.Equal(t, "SELECT col1 FROM table1", queries[1].Query)
+
+	require.Len(t, tracer.started, 2)
+	require.Len(t, tracer.ended, 2)
+	require.Equal(t, DeleteStatementType, tracer.started[0].StatementType)
+	require.Equal(t, "Exec", tracer.started[0].Operation)
+	require.Equal(t, 1, tracer.started[0].ArgsCount)
+	require.Equal(t, SelectStatementType, tracer.started[1].StatementType)
+	require.Equal(t, "Query", tracer.started[1].Operation)
+
+	t.Run("jet statement reported once", func(t *testing.T) {
+		queries, tracer.started = nil, nil
+
+		stmt := newTestStatement(SelectStatementType,
+			&ClauseSelect{ProjectionList: []Projection{table1Col1}},
+			&ClauseFrom{Tables: []Serializer{table1}},
+		)
+
+		var dest []struct{}
+		require.NoError(t, stmt.Query(db, &dest))
+
+		require.Len(t, queries, 1)
+		_, isDriverStatement := queries[0].Statement.(driverStatement)
+		require.False(t, isDriverStatement)
+		require.Len(t, tracer.started, 1)
+	})
+}
+
+type customArg struct{ value string }
+
+// checkingDriver accepts customArg arguments through connection NamedValueChecker, and its statements do not
+// implement NamedValueChecker or ColumnConverter
+type checkingDriver struct {
+	countingDriver
+
+	args []interface{}
+}
+
+func (d *checkingDriver) Open(name string) (driver.Conn, error) {
+	return &checkingConn{countingConn: countingConn{driver: &d.countingDriver}, driver: d}, nil
+}
+
+type checkingConn struct {
+	countingConn
+
+	driver *checkingDriver
+}
+
+func (c *checkingConn) Prepare(query string) (driver.Stmt, error) {
+	return &checkingStmt{driver: c.driver}, nil
+}
+
+func (c *checkingConn) CheckNamedValue(namedValue *driver.NamedValue) error {
+	if arg, ok := namedValue.Value.(customArg); ok {
+		namedValue.Value = arg.value
+		return nil
+	}
+
+	return driver.ErrSkip
+}
+
+type checkingStmt struct {
+	driver *checkingDriver
+}
+
+func (s *checkingStmt) Close() error  { return nil }
+func (s *checkingStmt) NumInput() int { return -1 }
+func (s *checkingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	for _, arg := range args {
+		s.driver.args = append(s.driver.args, arg)
+	}
+	return driver.RowsAffected(1), nil
+}
+func (s *checkingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &emptyRows{}, nil
+}
+
+func TestNewConnectorPreparedStatementArgs(t *testing.T) {
+	checking := &checkingDriver{}
+	connector, err := NewDriverConnector(checking, "")
+	require.NoError(t, err)
+
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	stmt, err := db.Prepare("DELETE FROM table1 WHERE col1 = $1")
+	require.NoError(t, err)
+	defer stmt.Close()
+
+	_, err = stmt.Exec(customArg{value: "custom"})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"custom"}, checking.args)
+
+	prepared, err := connector.(*instrumentedConnector).Connect(context.Background())
+	require.NoError(t, err)
+
+	driverStmt, err := prepared.Prepare("SELECT 1")
+	require.NoError(t, err)
+
+	_, isConverter := driverStmt.(driver.ColumnConverter)
+	require.False(t, isConverter)
+}
@@ -15,6 +15,8 @@ type DateExpression interface {
 	GT_EQ(rhs DateExpression) BoolExpression
 	BETWEEN(min, max DateExpression) BoolExpression
 	NOT_BETWEEN(min, max DateExpression) BoolExpression
+	// IN_RANGE returns half-open interval condition from <= expression < to. Nil from or to is unbounded.
+	IN_RANGE(from, to DateExpression) BoolExpression
 
 	ADD(rhs Interval) TimestampExpression
 	SUB(rhs Interval) TimestampExpression
@@ -64,6 +66,10 @@ func (d *dateInterfaceImpl) NOT_BETWEEN(min, max DateExpression) BoolExpression
 	return NewBetweenOperatorExpression(d.parent, min, max, true)
 }
 
+func (d *dateInterfaceImpl) IN_RANGE(from, to DateExpression) BoolExpression {
+	return inRange(d.parent, from, to)
+}
+
 func (d *dateInterfaceImpl) ADD(rhs Interval) TimestampExpression {
 	return TimestampExp(Add(d.parent, rhs))
 }
@@ -0,0 +1,55 @@
+package jet
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+type deadlineLimitDB struct {
+	qrm.DB
+
+	threshold   time.Duration
+	safetyLimit int64
+}
+
+// DeadlineLimit wraps db connection or transaction, so that top level SELECT statements executed over it with a
+// context which deadline expires in less than threshold, return at most safetyLimit rows. If statement already has
+// a lower LIMIT it is left unchanged. Useful for latency-sensitive endpoints, where partial result is better than
+// a timeout error.
+func DeadlineLimit(db qrm.DB, threshold time.Duration, safetyLimit int64) qrm.DB {
+	return &deadlineLimitDB{
+		DB:          db,
+		threshold:   threshold,
+		safetyLimit: safetyLimit,
+	}
+}
+
+func (d *deadlineLimitDB) limit(ctx context.Context) int64 {
+	deadline, ok := ctx.Deadline()
+
+	if !ok || time.Until(deadline) >= d.threshold {
+		return 0
+	}
+
+	return d.safetyLimit
+}
+
+func (l *ClauseLimit) count(statementType StatementType, out *SQLBuilder) int64 {
+	if out.statementDepth > 1 || (statementType != SelectStatementType && statementType != SetStatementType) {
+		return l.Count
+	}
+
+	count := l.Count
+
+	if out.pageWindow != nil && statementType == SelectStatementType {
+		count = out.pageWindow.limitCount(count)
+	}
+
+	if out.safetyLimit <= 0 || (count >= 0 && count < out.safetyLimit) {
+		return count
+	}
+
+	return out.safetyLimit
+}
@@ -0,0 +1,104 @@
+package jet
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testExpressionStatement struct {
+	ExpressionStatement
+}
+
+func TestDeadlineLimit(t *testing.T) {
+	recording := &recordingDB{}
+	db := DeadlineLimit(recording, time.Second, 100)
+
+	selectStmt := func(limit int64) Statement {
+		subQuery := &testExpressionStatement{}
+		subQuery.ExpressionStatement = NewExpressionStatementImpl(defaultDialect, SelectStatementType, subQuery,
+			&ClauseSelect{ProjectionList: []Projection{table2Col3}},
+			&ClauseFrom{Tables: []Serializer{table2}},
+			&ClauseLimit{Count: 1000},
+		)
+
+		return newTestStatement(SelectStatementType,
+			&ClauseSelect{ProjectionList: []Projection{table1Col1}},
+			&ClauseFrom{Tables: []Serializer{table1}},
+			&ClauseWhere{Condition: table1Col1.IN(subQuery)},
+			&ClauseLimit{Count: limit},
+		)
+	}
+
+	shortCtx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	_, _ = selectStmt(-1).ExecContext(shortCtx, db)
+	require.Equal(t, []interface{}{int64(1000), int64(100)}, recording.args)
+
+	_, _ = selectStmt(10).ExecContext(shortCtx, db)
+	require.Equal(t, []interface{}{int64(1000), int64(10)}, recording.args)
+
+	longCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	_, _ = selectStmt(-1).ExecContext(longCtx, db)
+	require.Equal(t, []interface{}{int64(1000)}, recording.args)
+
+	_, _ = selectStmt(-1).ExecContext(context.Background(), db)
+	require.Equal(t, []interface{}{int64(1000)}, recording.args)
+
+	deleteStmt := newTestStatement(DeleteStatementType,
+		&ClauseDelete{Table: table1},
+		&ClauseLimit{Count: -1},
+	)
+
+	_, _ = deleteStmt.ExecContext(shortCtx, db)
+	require.Empty(t, recording.args)
+}
+
+func TestDeadlineLimitProxyMode(t *testing.T) {
+	recording := &recordingDB{}
+	db := ProxyMode(DeadlineLimit(recording, time.Second, 100))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	stmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1Col1}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+		&ClauseLimit{Count: -1},
+	)
+
+	_, _ = stmt.ExecContext(ctx, db)
+	require.Contains(t, recording.query, "LIMIT 100;")
+	require.Empty(t, recording.args)
+}
+
+func TestDeadlineLimitWith(t *testing.T) {
+	recording := &recordingDB{}
+	db := DeadlineLimit(recording, time.Second, 100)
+
+	cte := CTE("cte")
+	cte.Statement = newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table2Col3}},
+		&ClauseFrom{Tables: []Serializer{table2}},
+		&ClauseLimit{Count: -1},
+	).(SerializerStatement)
+
+	stmt := WITH(defaultDialect, false, &cte)(newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1Col1}},
+		&ClauseFrom{Tables: []Serializer{table1, cte}},
+		&ClauseLimit{Count: -1},
+	))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	_, _ = stmt.ExecContext(ctx, db)
+	require.Equal(t, []interface{}{int64(100)}, recording.args)
+	require.Equal(t, 1, strings.Count(recording.query, "LIMIT"))
+}
@@ -0,0 +1,236 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// DeleteByKeysOptions contains optional settings of DeleteByKeys
+type DeleteByKeysOptions struct {
+	// TxPerBatch, if set, each batch is deleted and committed in a separate transaction, so a failure does not
+	// roll back already deleted batches. Otherwise, all the batches are deleted in a single transaction.
+	TxPerBatch bool
+	// Progress, if set, is called after each committed transaction: after each batch if TxPerBatch is set, or once
+	// after all the batches otherwise, so rows of rolled back (and retried) transactions are never reported. If db is
+	// already a transaction, Progress is called after each deleted batch.
+	Progress func(progress DeleteProgress)
+	// MaxRetries is the number of times a transaction is retried if it fails with deadlock (or lock timeout) error.
+	// Transaction can be retried only if db is able to begin a transaction, not when db is already a transaction.
+	MaxRetries int
+	// RetryDelay is the time waited before the first retry. Each next retry waits RetryDelay longer.
+	RetryDelay time.Duration
+}
+
+// DeleteProgress contains progress information of DeleteByKeys
+type DeleteProgress struct {
+	KeysProcessed int
+	KeysTotal     int
+	RowsDeleted   int64
+}
+
+// DeleteByKeys deletes rows by keys in batches of batchSize keys. Each batch is deleted with the statement created by
+// newDelete. isRetryable reports if the error of a failed transaction is a dialect deadlock or lock timeout error.
+func DeleteByKeys(ctx context.Context, db qrm.DB, keys interface{}, batchSize int,
+	newDelete func(keys []Expression) Statement, isRetryable func(err error) bool,
+	options ...DeleteByKeysOptions) (int64, error) {
+
+	keyExpressions := keysToExpressions(keys)
+
+	if batchSize <= 0 {
+		panic("jet: DeleteByKeys batch size has to be greater than 0")
+	}
+
+	if len(keyExpressions) == 0 {
+		return 0, nil
+	}
+
+	deleter := keysDeleter{
+		db:          db,
+		keys:        keyExpressions,
+		batchSize:   batchSize,
+		newDelete:   newDelete,
+		isRetryable: isRetryable,
+	}
+
+	if len(options) > 0 {
+		deleter.options = options[0]
+	}
+
+	if deleter.options.TxPerBatch {
+		for start := 0; start < len(keyExpressions); start += batchSize {
+			if err := deleter.inTransaction(ctx, start, start+batchSize); err != nil {
+				return deleter.rowsDeleted, err
+			}
+		}
+
+		return deleter.rowsDeleted, nil
+	}
+
+	err := deleter.inTransaction(ctx, 0, len(keyExpressions))
+
+	return deleter.rowsDeleted, err
+}
+
+func keysToExpressions(keys interface{}) []Expression {
+	keysValue := reflect.ValueOf(keys)
+
+	if keysValue.Kind() != reflect.Slice && keysValue.Kind() != reflect.Array {
+		panic("jet: DeleteByKeys keys have to be a slice")
+	}
+
+	expressions := make([]Expression, keysValue.Len())
+
+	for i := range expressions {
+		key := keysValue.Index(i).Interface()
+
+		if expression, ok := key.(Expression); ok {
+			expressions[i] = expression
+		} else {
+			expressions[i] = Literal(key)
+		}
+	}
+
+	return expressions
+}
+
+type keysDeleter struct {
+	db          qrm.DB
+	keys        []Expression
+	batchSize   int
+	options     DeleteByKeysOptions
+	newDelete   func(keys []Expression) Statement
+	isRetryable func(err error) bool
+
+	rowsDeleted int64
+}
+
+// inTransaction deletes keys in range [start, end) in a new transaction, retrying the transaction on deadlock
+func (d *keysDeleter) inTransaction(ctx context.Context, start, end int) error {
+	if end > len(d.keys) {
+		end = len(d.keys)
+	}
+
+	if !CanBeginTx(d.db) {
+		return d.deleteBatches(ctx, d.db, start, end, true)
+	}
+
+	rowsDeleted := d.rowsDeleted
+
+	for attempt := 0; ; attempt++ {
+		err := d.deleteInTx(ctx, start, end)
+
+		if err == nil {
+			return nil
+		}
+
+		d.rowsDeleted = rowsDeleted // rows deleted in the failed transaction are rolled back
+
+		if attempt >= d.options.MaxRetries || !d.isRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d.options.RetryDelay * time.Duration(attempt+1)):
+		}
+	}
+}
+
+func (d *keysDeleter) deleteInTx(ctx context.Context, start, end int) error {
+	tx, wrappedTx, err := BeginTx(ctx, d.db, nil)
+
+	if err != nil {
+		return err
+	}
+
+	// progress is reported after commit, because deleted rows are rolled back if transaction fails
+	if err := d.deleteBatches(ctx, wrappedTx, start, end, false); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	d.reportProgress(end)
+
+	return nil
+}
+
+func (d *keysDeleter) deleteBatches(ctx context.Context, db qrm.Executable, start, end int, reportProgress bool) error {
+	for batchStart := start; batchStart < end; batchStart += d.batchSize {
+		batchEnd := batchStart + d.batchSize
+
+		if batchEnd > end {
+			batchEnd = end
+		}
+
+		res, err := d.newDelete(d.keys[batchStart:batchEnd]).ExecContext(ctx, db)
+
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+
+		if err != nil {
+			return err
+		}
+
+		d.rowsDeleted += rowsAffected
+
+		if reportProgress {
+			d.reportProgress(batchEnd)
+		}
+	}
+
+	return nil
+}
+
+func (d *keysDeleter) reportProgress(keysProcessed int) {
+	if d.options.Progress == nil {
+		return
+	}
+
+	d.options.Progress(DeleteProgress{
+		KeysProcessed: keysProcessed,
+		KeysTotal:     len(d.keys),
+		RowsDeleted:   d.rowsDeleted,
+	})
+}
+
+// IsSQLStateError reports if err (or any error it wraps) has one of the sqlStates, as returned by SQLState method of
+// the driver error (pgx, lib/pq), or if the error message contains one of the messages.
+func IsSQLStateError(err error, sqlStates []string, messages []string) bool {
+	if err == nil || err == sql.ErrTxDone {
+		return false
+	}
+
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		if stateErr, ok := e.(interface{ SQLState() string }); ok {
+			for _, sqlState := range sqlStates {
+				if stateErr.SQLState() == sqlState {
+					return true
+				}
+			}
+		}
+	}
+
+	errMessage := err.Error()
+
+	for _, message := range messages {
+		if strings.Contains(errMessage, message) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,149 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errTestDeadlock = errors.New("deadlock detected")
+
+// deadlockDriver fails failures executed statements with errTestDeadlock, after the first failAfter statements succeed
+type deadlockDriver struct {
+	failAfter  int
+	failures   int
+	executed   []string
+	rolledBack int
+}
+
+func (d *deadlockDriver) Open(name string) (driver.Conn, error) { return &deadlockConn{driver: d}, nil }
+
+type deadlockConn struct{ driver *deadlockDriver }
+
+func (c *deadlockConn) Prepare(query string) (driver.Stmt, error) {
+	return &deadlockStmt{driver: c.driver, query: query}, nil
+}
+func (c *deadlockConn) Close() error              { return nil }
+func (c *deadlockConn) Begin() (driver.Tx, error) { return &deadlockTx{driver: c.driver}, nil }
+
+type deadlockTx struct{ driver *deadlockDriver }
+
+func (t *deadlockTx) Commit() error   { return nil }
+func (t *deadlockTx) Rollback() error { t.driver.rolledBack++; return nil }
+
+type deadlockStmt struct {
+	driver *deadlockDriver
+	query  string
+}
+
+func (s *deadlockStmt) Close() error  { return nil }
+func (s *deadlockStmt) NumInput() int { return -1 }
+func (s *deadlockStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.driver.failAfter > 0 {
+		s.driver.failAfter--
+	} else if s.driver.failures > 0 {
+		s.driver.failures--
+		return nil, errTestDeadlock
+	}
+	s.driver.executed = append(s.driver.executed, s.query)
+	return driver.RowsAffected(len(args)), nil
+}
+func (s *deadlockStmt) Query(args []driver.Value) (driver.Rows, error) { return &emptyRows{}, nil }
+
+func TestDeleteByKeys(t *testing.T) {
+	newDelete := func(keys []Expression) Statement {
+		return newTestStatement(DeleteStatementType,
+			&ClauseStatementBegin{Name: "DELETE FROM", Tables: []SerializerTable{table1}},
+			&ClauseWhere{Condition: table1Col1.IN(keys...)},
+		)
+	}
+	isDeadlock := func(err error) bool { return err == errTestDeadlock }
+
+	t.Run("single transaction retried", func(t *testing.T) {
+		drv := &deadlockDriver{failures: 1}
+		db := sql.OpenDB(&dsnConnector{driver: drv})
+		defer db.Close()
+
+		rowsDeleted, err := DeleteByKeys(context.Background(), db, []int{1, 2, 3, 4, 5}, 2, newDelete, isDeadlock,
+			DeleteByKeysOptions{MaxRetries: 2})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(5), rowsDeleted)
+		require.Equal(t, 1, drv.rolledBack)
+		require.Equal(t, []string{
+			"\nDELETE FROM db.table1\nWHERE table1.col1 IN ($1, $2);\n",
+			"\nDELETE FROM db.table1\nWHERE table1.col1 IN ($1, $2);\n",
+			"\nDELETE FROM db.table1\nWHERE table1.col1 IN ($1);\n",
+		}, drv.executed)
+	})
+
+	t.Run("single transaction progress reported after commit", func(t *testing.T) {
+		drv := &deadlockDriver{failAfter: 1, failures: 1}
+		db := sql.OpenDB(&dsnConnector{driver: drv})
+		defer db.Close()
+
+		var progress []DeleteProgress
+
+		_, err := DeleteByKeys(context.Background(), db, []int{1, 2, 3, 4, 5}, 2, newDelete, isDeadlock,
+			DeleteByKeysOptions{
+				MaxRetries: 1,
+				Progress:   func(p DeleteProgress) { progress = append(progress, p) },
+			})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, drv.rolledBack)
+		require.Equal(t, []DeleteProgress{{KeysProcessed: 5, KeysTotal: 5, RowsDeleted: 5}}, progress)
+	})
+
+	t.Run("retries exceeded", func(t *testing.T) {
+		drv := &deadlockDriver{failures: 3}
+		db := sql.OpenDB(&dsnConnector{driver: drv})
+		defer db.Close()
+
+		var progress []DeleteProgress
+
+		rowsDeleted, err := DeleteByKeys(context.Background(), db, []int{1, 2, 3}, 1, newDelete, isDeadlock,
+			DeleteByKeysOptions{
+				TxPerBatch: true,
+				MaxRetries: 1,
+				Progress:   func(p DeleteProgress) { progress = append(progress, p) },
+			})
+
+		require.Equal(t, errTestDeadlock, err)
+		require.Equal(t, int64(0), rowsDeleted)
+		require.Empty(t, progress)
+		require.Equal(t, 2, drv.rolledBack)
+	})
+
+	t.Run("wrapped db retried in transaction", func(t *testing.T) {
+		drv := &deadlockDriver{failures: 1}
+		db := sql.OpenDB(&dsnConnector{driver: drv})
+		defer db.Close()
+
+		var logged int
+
+		wrappedDB := WithConfig(ProxyMode(DeadlineLimit(db, time.Second, 100)), Config{
+			Logger: func(ctx context.Context, statement PrintableStatement) { logged++ },
+		})
+
+		_, err := DeleteByKeys(context.Background(), wrappedDB, []int{1, 2, 3}, 2, newDelete, isDeadlock,
+			DeleteByKeysOptions{MaxRetries: 2})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{ // keys are interpolated in proxy mode
+			"\nDELETE FROM db.table1\nWHERE table1.col1 IN (1, 2);\n",
+			"\nDELETE FROM db.table1\nWHERE table1.col1 IN (3);\n",
+		}, drv.executed)
+		require.Equal(t, 1, drv.rolledBack)
+		require.Equal(t, 3, logged) // config is applied to the statements executed in transaction
+	})
+
+	require.PanicsWithValue(t, "jet: DeleteByKeys keys have to be a slice", func() {
+		_, _ = DeleteByKeys(context.Background(), &recordingDB{}, 1, 1, newDelete, isDeadlock)
+	})
+}
@@ -12,6 +12,14 @@ type Dialect interface {
 	IdentifierQuoteChar() byte
 	ArgumentPlaceholder() QueryPlaceholderFunc
 	IsReservedWord(name string) bool
+	// ArrayArguments returns true if go slices are bound as database arrays, instead of JSON arrays
+	ArrayArguments() bool
+	// Version returns targeted database version, or zero version if dialect targets the latest database version
+	Version() Version
+	// WithVersion returns new dialect targeting database version major.minor
+	WithVersion(major int, minor ...int) Dialect
+	// Supports returns true if feature is supported by targeted database version
+	Supports(feature Feature) bool
 }
 
 // SerializerFunc func
@@ -33,6 +41,14 @@ type DialectParams struct {
 	IdentifierQuoteChar        byte
 	ArgumentPlaceholder        QueryPlaceholderFunc
 	ReservedWords              []string
+	// FeatureVersions contains the oldest database version supporting the feature, for features not supported by
+	// all database versions
+	FeatureVersions map[Feature]Version
+	// UnsupportedFeatures contains features not supported by any database version
+	UnsupportedFeatures []Feature
+	// ArrayArguments is true if go slices query arguments are bound in postgres array text representation, instead
+	// of JSON text
+	ArrayArguments bool
 }
 
 // NewDialect creates new dialect with params
@@ -46,6 +62,9 @@ func NewDialect(params DialectParams) Dialect {
 		identifierQuoteChar:        params.IdentifierQuoteChar,
 		argumentPlaceholder:        params.ArgumentPlaceholder,
 		reservedWords:              arrayOfStringsToMapOfStrings(params.ReservedWords),
+		featureVersions:            params.FeatureVersions,
+		unsupportedFeatures:        arrayOfFeaturesToMap(params.UnsupportedFeatures),
+		arrayArguments:             params.ArrayArguments,
 	}
 }
 
@@ -58,6 +77,10 @@ type dialectImpl struct {
 	identifierQuoteChar        byte
 	argumentPlaceholder        QueryPlaceholderFunc
 	reservedWords              map[string]bool
+	featureVersions            map[Feature]Version
+	unsupportedFeatures        map[Feature]bool
+	arrayArguments             bool
+	version                    Version
 
 	supportsReturning bool
 }
@@ -96,6 +119,10 @@ func (d *dialectImpl) ArgumentPlaceholder() QueryPlaceholderFunc {
 	return d.argumentPlaceholder
 }
 
+func (d *dialectImpl) ArrayArguments() bool {
+	return d.arrayArguments
+}
+
 func (d *dialectImpl) IsReservedWord(name string) bool {
 	_, isReservedWord := d.reservedWords[strings.ToLower(name)]
 	return isReservedWord
@@ -109,3 +136,13 @@ func arrayOfStringsToMapOfStrings(arr []string) map[string]bool {
 
 	return ret
 }
+
+func arrayOfFeaturesToMap(features []Feature) map[Feature]bool {
+	ret := map[Feature]bool{}
+
+	for _, feature := range features {
+		ret[feature] = true
+	}
+
+	return ret
+}
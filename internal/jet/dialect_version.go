@@ -0,0 +1,100 @@
+package jet
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Version is a targeted database server version. Zero version targets the latest database version, in which every
+// dialect feature is supported.
+type Version struct {
+	Major int
+	Minor int
+}
+
+// IsZero returns true if version is not set
+func (v Version) IsZero() bool {
+	return v.Major == 0 && v.Minor == 0
+}
+
+// Less returns true if version v is older than version other
+func (v Version) Less(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+
+	return v.Minor < other.Minor
+}
+
+func (v Version) String() string {
+	return strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor)
+}
+
+// Feature is sql clause or syntax, which is not supported by all versions of a database
+type Feature string
+
+// Features with version dependent support
+const (
+	FeatureWindowFunctions        Feature = "window functions"
+	FeatureWindowFrameGroups      Feature = "GROUPS window frame"
+	FeatureWindowFrameExclusion   Feature = "window frame exclusion"
+	FeatureFilterClause           Feature = "aggregate FILTER clause"
+	FeatureCommonTableExpressions Feature = "WITH clause"
+	FeatureLateral                Feature = "LATERAL"
+	FeatureOnConflict             Feature = "ON CONFLICT clause"
+	FeatureNullsOrdering          Feature = "NULLS FIRST/LAST ordering"
+	FeatureLockingReadOptions     Feature = "FOR SHARE and OF row lock options"
+	FeatureNoWait                 Feature = "NOWAIT"
+	FeatureSkipLocked             Feature = "SKIP LOCKED"
+	FeatureReturning              Feature = "RETURNING clause"
+	FeatureBoolLiterals           Feature = "TRUE and FALSE literals"
+)
+
+// WithVersion returns new dialect targeting database version major.minor. Statements serialized with versioned
+// dialect use syntax supported by targeted version (for instance aggregate FILTER clause is emulated with CASE
+// expression), and panic if statement uses clause which is impossible on targeted version.
+func (d *dialectImpl) WithVersion(major int, minor ...int) Dialect {
+	newDialect := *d
+	newDialect.version = Version{Major: major}
+
+	if len(minor) > 0 {
+		newDialect.version.Minor = minor[0]
+	}
+
+	return &newDialect
+}
+
+func (d *dialectImpl) Version() Version {
+	return d.version
+}
+
+func (d *dialectImpl) Supports(feature Feature) bool {
+	if d.unsupportedFeatures[feature] {
+		return false
+	}
+
+	if d.version.IsZero() {
+		return true
+	}
+
+	minVersion, ok := d.featureVersions[feature]
+
+	if !ok {
+		return true
+	}
+
+	return !d.version.Less(minVersion)
+}
+
+// RequireFeature panics if feature is not supported by targeted version of the dialect
+func (s *SQLBuilder) RequireFeature(feature Feature) {
+	if s.Dialect == nil || s.Dialect.Supports(feature) {
+		return
+	}
+
+	if s.Dialect.Version().IsZero() {
+		panic(fmt.Sprintf("jet: %s not supported by %s", feature, s.Dialect.Name()))
+	}
+
+	panic(fmt.Sprintf("jet: %s not supported by %s %s", feature, s.Dialect.Name(), s.Dialect.Version()))
+}
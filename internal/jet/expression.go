@@ -1,6 +1,9 @@
 package jet
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // Expression is common interface for all expressions.
 // Can be Bool, Int, Float, String, Date, Time, Timez, Timestamp or Timestampz expressions.
@@ -113,6 +116,18 @@ func NewBinaryOperatorExpression(lhs, rhs Serializer, operator string, additiona
 }
 
 func (c *binaryOperatorExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if c.lhs == nil {
+		panic("jet: lhs of '" + strings.TrimSpace(c.operator) + "' operator is nil")
+	}
+
+	if c.rhs == nil {
+		panic("jet: rhs of '" + strings.TrimSpace(c.operator) + "' operator is nil")
+	}
+
+	if out.references != nil {
+		out.references.addPredicate(c.lhs, c.rhs, c.operator)
+	}
+
 	if serializeOverride := out.Dialect.OperatorSerializeOverride(c.operator); serializeOverride != nil {
 		serializeOverrideFunc := serializeOverride(c.lhs, c.rhs, c.additionalParam)
 		serializeOverrideFunc(statement, out, FallTrough(options)...)
@@ -201,6 +216,10 @@ func newPrefixOperatorExpression(expression Expression, operator string) Express
 }
 
 func (p *prefixExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if p.expression == nil {
+		panic("jet: operand of '" + strings.TrimSpace(p.operator) + "' operator is nil")
+	}
+
 	out.WriteString(p.operator)
 	p.expression.serialize(statement, out, FallTrough(options)...)
 }
@@ -225,10 +244,47 @@ func newPostfixOperatorExpression(expression Expression, operator string) *postf
 }
 
 func (p *postfixOpExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if p.expression == nil {
+		panic("jet: operand of '" + strings.TrimSpace(p.operator) + "' operator is nil")
+	}
+
+	if serializeOverride := out.Dialect.OperatorSerializeOverride(p.operator); serializeOverride != nil {
+		serializeOverride(p.expression)(statement, out, FallTrough(options)...)
+		return
+	}
+
 	p.expression.serialize(statement, out, FallTrough(options)...)
 	out.WriteString(p.operator)
 }
 
+// A subscript (array element) Expression
+type subscriptExpression struct {
+	ExpressionInterfaceImpl
+
+	expression Expression
+	index      Expression
+}
+
+// NewSubscriptExpression creates new array subscript expression, for instance (array)[index]
+func NewSubscriptExpression(expression, index Expression) Expression {
+	newSubscriptExpression := &subscriptExpression{
+		expression: expression,
+		index:      index,
+	}
+
+	newSubscriptExpression.ExpressionInterfaceImpl.Parent = newSubscriptExpression
+
+	return newSubscriptExpression
+}
+
+func (s *subscriptExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteString("(")
+	s.expression.serialize(statement, out, FallTrough(options)...)
+	out.WriteString(")[")
+	s.index.serialize(statement, out, FallTrough(options)...)
+	out.WriteString("]")
+}
+
 type betweenOperatorExpression struct {
 	ExpressionInterfaceImpl
 
@@ -253,6 +309,10 @@ func NewBetweenOperatorExpression(expression, min, max Expression, notBetween bo
 }
 
 func (p *betweenOperatorExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if p.expression == nil || p.min == nil || p.max == nil {
+		panic("jet: operand of 'BETWEEN' operator is nil")
+	}
+
 	p.expression.serialize(statement, out, FallTrough(options)...)
 	if p.notBetween {
 		out.WriteString("NOT")
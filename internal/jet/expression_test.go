@@ -36,3 +36,11 @@ func TestNOT_IN(t *testing.T) {
 		`(table2.col_int NOT IN ($1, $2, $3))`, int64(1), int64(2), int64(3))
 
 }
+
+func TestExpressionNilOperand(t *testing.T) {
+	assertClauseSerializeErr(t, table2ColInt.ADD(nil), "jet: rhs of '+' operator is nil")
+	assertClauseSerializeErr(t, Int(1).EQ(nil), "jet: rhs of '=' operator is nil")
+	assertClauseSerializeErr(t, NOT(nil), "jet: operand of 'NOT' operator is nil")
+	assertClauseSerializeErr(t, table2ColInt.BETWEEN(nil, Int(2)), "jet: operand of 'BETWEEN' operator is nil")
+	assertClauseSerializeErr(t, NewBinaryOperatorExpression(nil, Int(1), "||"), "jet: lhs of '||' operator is nil")
+}
@@ -0,0 +1,29 @@
+package jet
+
+type extractExpression struct {
+	ExpressionInterfaceImpl
+
+	field string
+	from  Expression
+}
+
+// EXTRACT retrieves field (for instance YEAR, DAY or EPOCH) from date, time, timestamp or interval expression
+func EXTRACT(field string, from Expression) Expression {
+	extract := &extractExpression{
+		field: field,
+		from:  from,
+	}
+	extract.ExpressionInterfaceImpl.Parent = extract
+
+	return extract
+}
+
+func (e *extractExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if e.from == nil {
+		panic("jet: EXTRACT source expression is nil")
+	}
+
+	out.WriteString("EXTRACT(" + e.field + " FROM")
+	e.from.serialize(statement, out, FallTrough(options)...)
+	out.WriteString(")")
+}
@@ -0,0 +1,363 @@
+package jet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ParseFilter parses filter expression into a bool expression over the list of allowed columns. Filter field names
+// are matched with column names ignoring case and underscores, and filter values are validated against column types,
+// so the filter can be safely accepted from API clients.
+//
+// Filter grammar:
+//
+//	filter     = or
+//	or         = and { "OR" and }
+//	and        = not { "AND" not }
+//	not        = "NOT" not | "(" or ")" | comparison
+//	comparison = field operator value
+//	operator   = "=" | "!=" | ">" | ">=" | "<" | "<=" | "~" (LIKE) | "!~" (NOT LIKE)
+//	value      = number | "string" | 'string' | true | false | null
+//
+// For example: `age>=18 AND (name~"jo%" OR nickname = null)`
+func ParseFilter(filter string, columns ColumnList) (BoolExpression, error) {
+	tokens, err := tokenizeFilter(filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parser := filterParser{tokens: tokens, columns: columns}
+
+	expression, err := parser.parseOr()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !parser.end() {
+		return nil, fmt.Errorf("jet: unexpected '%s' in filter at position %d", parser.peek().text, parser.peek().pos)
+	}
+
+	return expression, nil
+}
+
+type filterTokenKind int
+
+const (
+	filterIdentifier filterTokenKind = iota
+	filterNumber
+	filterString
+	filterOperator
+	filterParenthesis
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int
+}
+
+var filterOperators = []string{">=", "<=", "!=", "!~", "=", ">", "<", "~"}
+
+func tokenizeFilter(filter string) ([]filterToken, error) {
+	var tokens []filterToken
+
+	for pos := 0; pos < len(filter); {
+		char := rune(filter[pos])
+
+		switch {
+		case unicode.IsSpace(char):
+			pos++
+
+		case char == '(' || char == ')':
+			tokens = append(tokens, filterToken{kind: filterParenthesis, text: string(char), pos: pos})
+			pos++
+
+		case char == '"' || char == '\'':
+			value, end, err := readFilterString(filter, pos)
+
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, filterToken{kind: filterString, text: value, pos: pos})
+			pos = end
+
+		case unicode.IsDigit(char) || (char == '-' && pos+1 < len(filter) && unicode.IsDigit(rune(filter[pos+1]))):
+			end := pos + 1
+			for end < len(filter) && (unicode.IsDigit(rune(filter[end])) || filter[end] == '.') {
+				end++
+			}
+
+			tokens = append(tokens, filterToken{kind: filterNumber, text: filter[pos:end], pos: pos})
+			pos = end
+
+		case unicode.IsLetter(char) || char == '_':
+			end := pos + 1
+			for end < len(filter) && (unicode.IsLetter(rune(filter[end])) || unicode.IsDigit(rune(filter[end])) || filter[end] == '_') {
+				end++
+			}
+
+			tokens = append(tokens, filterToken{kind: filterIdentifier, text: filter[pos:end], pos: pos})
+			pos = end
+
+		default:
+			operator := ""
+
+			for _, op := range filterOperators {
+				if strings.HasPrefix(filter[pos:], op) {
+					operator = op
+					break
+				}
+			}
+
+			if operator == "" {
+				return nil, fmt.Errorf("jet: unexpected character '%c' in filter at position %d", char, pos)
+			}
+
+			tokens = append(tokens, filterToken{kind: filterOperator, text: operator, pos: pos})
+			pos += len(operator)
+		}
+	}
+
+	return tokens, nil
+}
+
+func readFilterString(filter string, start int) (value string, end int, err error) {
+	quote := filter[start]
+	var builder strings.Builder
+
+	for pos := start + 1; pos < len(filter); pos++ {
+		switch filter[pos] {
+		case '\\':
+			if pos+1 < len(filter) {
+				pos++
+				builder.WriteByte(filter[pos])
+			}
+		case quote:
+			return builder.String(), pos + 1, nil
+		default:
+			builder.WriteByte(filter[pos])
+		}
+	}
+
+	return "", 0, fmt.Errorf("jet: unterminated string in filter at position %d", start)
+}
+
+type filterParser struct {
+	tokens  []filterToken
+	pos     int
+	columns ColumnList
+}
+
+func (p *filterParser) end() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() (filterToken, error) {
+	if p.end() {
+		return filterToken{}, fmt.Errorf("jet: unexpected end of filter")
+	}
+
+	token := p.tokens[p.pos]
+	p.pos++
+
+	return token, nil
+}
+
+func (p *filterParser) isKeyword(keyword string) bool {
+	return !p.end() && p.peek().kind == filterIdentifier && strings.EqualFold(p.peek().text, keyword)
+}
+
+func (p *filterParser) parseOr() (BoolExpression, error) {
+	expression, err := p.parseAnd()
+
+	for err == nil && p.isKeyword("OR") {
+		p.pos++
+
+		var rhs BoolExpression
+		if rhs, err = p.parseAnd(); err == nil {
+			expression = expression.OR(rhs)
+		}
+	}
+
+	return expression, err
+}
+
+func (p *filterParser) parseAnd() (BoolExpression, error) {
+	expression, err := p.parseNot()
+
+	for err == nil && p.isKeyword("AND") {
+		p.pos++
+
+		var rhs BoolExpression
+		if rhs, err = p.parseNot(); err == nil {
+			expression = expression.AND(rhs)
+		}
+	}
+
+	return expression, err
+}
+
+func (p *filterParser) parseNot() (BoolExpression, error) {
+	if p.isKeyword("NOT") {
+		p.pos++
+
+		expression, err := p.parseNot()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return NOT(expression), nil
+	}
+
+	if !p.end() && p.peek().text == "(" && p.peek().kind == filterParenthesis {
+		p.pos++
+
+		expression, err := p.parseOr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		closing, err := p.next()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if closing.text != ")" || closing.kind != filterParenthesis {
+			return nil, fmt.Errorf("jet: expected ')' in filter at position %d", closing.pos)
+		}
+
+		return expression, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (BoolExpression, error) {
+	field, err := p.next()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if field.kind != filterIdentifier {
+		return nil, fmt.Errorf("jet: expected field name in filter at position %d", field.pos)
+	}
+
+	fieldColumns := p.columns.ByFieldNames(field.text)
+
+	if len(fieldColumns) == 0 {
+		return nil, fmt.Errorf("jet: unknown filter field '%s'", field.text)
+	}
+
+	column := fieldColumns[0]
+
+	operator, err := p.next()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if operator.kind != filterOperator {
+		return nil, fmt.Errorf("jet: expected operator in filter at position %d", operator.pos)
+	}
+
+	value, err := p.next()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if value.kind == filterIdentifier && strings.EqualFold(value.text, "null") {
+		switch operator.text {
+		case "=":
+			return column.IS_NULL(), nil
+		case "!=":
+			return column.IS_NOT_NULL(), nil
+		default:
+			return nil, fmt.Errorf("jet: operator '%s' can not be used with null in filter", operator.text)
+		}
+	}
+
+	literal, err := filterLiteral(column, field.text, value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch operator.text {
+	case "~", "!~":
+		if _, ok := column.(ColumnString); !ok {
+			return nil, fmt.Errorf("jet: operator '%s' can be used only with string filter fields", operator.text)
+		}
+
+		if operator.text == "~" {
+			return newBinaryBoolOperatorExpression(column, literal, "LIKE"), nil
+		}
+
+		return newBinaryBoolOperatorExpression(column, literal, "NOT LIKE"), nil
+	}
+
+	return newBinaryBoolOperatorExpression(column, literal, operator.text), nil
+}
+
+func filterLiteral(column ColumnExpression, field string, value filterToken) (Expression, error) {
+	invalidValue := fmt.Errorf("jet: invalid value '%s' for filter field '%s'", value.text, field)
+
+	switch column.(type) {
+	case ColumnBool:
+		if value.kind == filterIdentifier && (strings.EqualFold(value.text, "true") || strings.EqualFold(value.text, "false")) {
+			return Bool(strings.EqualFold(value.text, "true")), nil
+		}
+	case ColumnInteger:
+		if value.kind == filterNumber {
+			if intValue, err := strconv.ParseInt(value.text, 10, 64); err == nil {
+				return Int(intValue), nil
+			}
+		}
+	case ColumnFloat:
+		if value.kind == filterNumber {
+			if floatValue, err := strconv.ParseFloat(value.text, 64); err == nil {
+				return Float(floatValue), nil
+			}
+		}
+	case ColumnString:
+		if value.kind == filterString {
+			return String(value.text), nil
+		}
+	case ColumnDate:
+		if value.kind == filterString {
+			if date, err := time.Parse("2006-01-02", value.text); err == nil {
+				return DateT(date), nil
+			}
+		}
+	case ColumnTimestamp:
+		if value.kind == filterString {
+			if timestamp, err := time.Parse(time.RFC3339Nano, value.text); err == nil {
+				return TimestampT(timestamp), nil
+			}
+		}
+	case ColumnTimestampz:
+		if value.kind == filterString {
+			if timestamp, err := time.Parse(time.RFC3339Nano, value.text); err == nil {
+				return TimestampzT(timestamp), nil
+			}
+		}
+	default:
+		return nil, fmt.Errorf("jet: unsupported type of filter field '%s'", field)
+	}
+
+	return nil, invalidValue
+}
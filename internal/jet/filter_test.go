@@ -0,0 +1,41 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var filterColumns = ColumnList{table2ColInt, table2ColFloat, table2ColStr, table2ColBool, table2ColDate, table2ColTime}
+
+func assertFilter(t *testing.T, filter string, query string, args ...interface{}) {
+	expression, err := ParseFilter(filter, filterColumns)
+	require.NoError(t, err)
+	assertClauseSerialize(t, expression, query, args...)
+}
+
+func assertFilterErr(t *testing.T, filter string, errorStr string) {
+	_, err := ParseFilter(filter, filterColumns)
+	require.EqualError(t, err, errorStr)
+}
+
+func TestParseFilter(t *testing.T) {
+	assertFilter(t, "colInt>=18", "(table2.col_int >= $1)", int64(18))
+	assertFilter(t, `colInt >= 18 AND col_str ~ "jo%"`, "((table2.col_int >= $1) AND (table2.col_str LIKE $2))", int64(18), "jo%")
+	assertFilter(t, `colFloat < -1.5 or not (colBool = true and colStr != 'it\'s')`,
+		"((table2.col_float < $1) OR (NOT ((table2.col_bool = $2) AND (table2.col_str != $3))))", -1.5, true, "it's")
+	assertFilter(t, `colStr = null OR colDate != null`, "(table2.col_str IS NULL OR table2.col_date IS NOT NULL)")
+	assertFilter(t, `colStr !~ "%x"`, "(table2.col_str NOT LIKE $1)", "%x")
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	assertFilterErr(t, "age >= 18", "jet: unknown filter field 'age'")
+	assertFilterErr(t, "colInt >= '18'", "jet: invalid value '18' for filter field 'colInt'")
+	assertFilterErr(t, "colInt ~ 18", "jet: operator '~' can be used only with string filter fields")
+	assertFilterErr(t, "colInt > null", "jet: operator '>' can not be used with null in filter")
+	assertFilterErr(t, "colTime = '10:00'", "jet: unsupported type of filter field 'colTime'")
+	assertFilterErr(t, "colInt = 1; DROP TABLE users", "jet: unexpected character ';' in filter at position 10")
+	assertFilterErr(t, "(colInt = 1", "jet: unexpected end of filter")
+	assertFilterErr(t, "colInt = 1 colStr", "jet: unexpected 'colStr' in filter at position 11")
+	assertFilterErr(t, `colStr = "abc`, "jet: unterminated string in filter at position 9")
+}
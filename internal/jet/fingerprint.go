@@ -0,0 +1,37 @@
+package jet
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+)
+
+var (
+	placeholderListRegex = regexp.MustCompile(`(\?(?:::\w+)?)(?:\s*,\s*\?(?:::\w+)?)+`)
+	placeholderRowsRegex = regexp.MustCompile(`(\(\?(?:::\w+)?\))(?:\s*,\s*\(\?(?:::\w+)?\))+`)
+	whitespaceRegex      = regexp.MustCompile(`\s+`)
+)
+
+func (s *serializerStatementInterfaceImpl) Fingerprint() string {
+	sqlBuilder := &SQLBuilder{Dialect: s.dialect, fingerprint: true}
+
+	s.parent.serialize(s.statementType, sqlBuilder, NoWrap)
+
+	query, _ := sqlBuilder.finalize()
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(normalizeQuery(query)))
+
+	return fmt.Sprintf("%016x", hash.Sum64())
+}
+
+// normalizeQuery collapses whitespaces, lists of placeholders and multiple rows of placeholders, so statements with
+// a different number of IN list elements or inserted rows have the same shape.
+func normalizeQuery(query string) string {
+	query = whitespaceRegex.ReplaceAllString(strings.TrimSpace(query), " ")
+	query = placeholderListRegex.ReplaceAllString(query, "$1")
+	query = placeholderRowsRegex.ReplaceAllString(query, "$1")
+
+	return query
+}
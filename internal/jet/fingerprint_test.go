@@ -0,0 +1,34 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	require.Equal(t, "SELECT table1.col1 FROM db.table1 WHERE table1.col_int IN (?) LIMIT ?;",
+		normalizeQuery(`
+SELECT table1.col1
+FROM db.table1
+WHERE table1.col_int IN (?, ?,  ?)
+LIMIT ?;
+`))
+	require.Equal(t, "INSERT INTO db.table1 (col1, col_bool) VALUES (?);",
+		normalizeQuery(`INSERT INTO db.table1 (col1, col_bool)
+VALUES (?, ?::boolean),
+       (?, ?::boolean);`))
+}
+
+func TestFingerprint(t *testing.T) {
+	selectWith := func(values ...Expression) Statement {
+		return newTestStatement(SelectStatementType, &ClauseSelect{ProjectionList: ProjectionList{table1Col1}},
+			&ClauseFrom{Tables: []Serializer{table1}}, &ClauseWhere{Condition: table1ColInt.IN(values...)})
+	}
+
+	fingerprint := selectWith(Int(1)).Fingerprint()
+
+	require.Len(t, fingerprint, 16)
+	require.Equal(t, fingerprint, selectWith(Int(2), Int(3)).Fingerprint())
+	require.NotEqual(t, fingerprint, selectWith(table2ColInt).Fingerprint())
+}
@@ -1,5 +1,10 @@
 package jet
 
+import (
+	"fmt"
+	"time"
+)
+
 // AND function adds AND operator between expressions. This function can be used, instead of method AND,
 // to have a better inlining of a complex condition in the Go code and in the generated SQL.
 func AND(expressions ...BoolExpression) BoolExpression {
@@ -17,6 +22,29 @@ func ROW(expressions ...Expression) Expression {
 	return NewFunc("ROW", expressions, nil)
 }
 
+// TableRow is used to reference whole table or sub-query row, for instance as a function argument.
+// Table alias is used if table is aliased, otherwise table name is used.
+func TableRow(table interface{ Alias() string }) Expression {
+	tableRow := &tableRowExpression{table: table}
+	tableRow.ExpressionInterfaceImpl.Parent = tableRow
+
+	return tableRow
+}
+
+type tableRowExpression struct {
+	ExpressionInterfaceImpl
+
+	table interface{ Alias() string }
+}
+
+func (t *tableRowExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if table, ok := t.table.(Table); ok && table.Alias() == "" {
+		out.WriteIdentifier(table.TableName())
+	} else {
+		out.WriteIdentifier(t.table.Alias())
+	}
+}
+
 // ------------------ Mathematical functions ---------------//
 
 // ABSf calculates absolute value from float expression
@@ -642,6 +670,14 @@ func newWindowFunc(name string, expressions ...Expression) windowExpression {
 }
 
 func (f *funcExpressionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if out.references != nil {
+		out.references.addFunction(f.name)
+	}
+
+	if out.grouping != nil && isAggregateFunction(f.name) {
+		out.aggregateWritten()
+	}
+
 	if serializeOverride := out.Dialect.FunctionSerializeOverride(f.name); serializeOverride != nil {
 		serializeOverrideFunc := serializeOverride(ExpressionListToSerializerList(f.expressions)...)
 		serializeOverrideFunc(statement, out, FallTrough(options)...)
@@ -835,3 +871,13 @@ func newTimestampzFunc(name string, expressions ...Expression) *timestampzFunc {
 func Func(name string, expressions ...Expression) Expression {
 	return NewFunc(name, expressions, nil)
 }
+
+// BucketWidthSeconds returns the number of seconds in BUCKET_BY bucket width. Buckets are computed from unix epoch
+// seconds, so bucket width has to be a positive whole number of seconds.
+func BucketWidthSeconds(bucketWidth time.Duration) int64 {
+	if bucketWidth <= 0 || bucketWidth%time.Second != 0 {
+		panic(fmt.Sprintf("jet: BUCKET_BY bucket width has to be a positive whole number of seconds, got %s", bucketWidth))
+	}
+
+	return int64(bucketWidth / time.Second)
+}
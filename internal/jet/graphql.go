@@ -0,0 +1,79 @@
+package jet
+
+import "strings"
+
+// GraphQLType maps GraphQL object type to a database table
+type GraphQLType struct {
+	// Table object type is loaded from
+	Table Serializer
+	// Columns GraphQL object fields are mapped to. Fields are matched with column names ignoring case and underscores,
+	// so 'firstName' field is mapped to 'first_name' column.
+	Columns ColumnList
+	// Key columns are always selected, so that query result can be grouped into nested destinations.
+	Key ColumnList
+	// Relations maps GraphQL object field names to nested object types
+	Relations map[string]GraphQLRelation
+}
+
+// GraphQLRelation is a nested GraphQL object type, joined with the parent object type table using On condition
+type GraphQLRelation struct {
+	GraphQLType
+
+	On BoolExpression
+}
+
+// Select returns projection list and FROM clause table for a GraphQL selection set. Selection set is a list of field
+// paths, where nested object fields are separated with '.'. Tables of the relations with requested fields are joined
+// to the FROM clause table with leftJoin. Unknown fields are ignored.
+func (g GraphQLType) Select(selection []string,
+	leftJoin func(from, table Serializer, onCondition BoolExpression) Serializer) (ProjectionList, Serializer) {
+
+	return g.selectFields(selection, g.Table, leftJoin)
+}
+
+func (g GraphQLType) selectFields(selection []string, from Serializer,
+	leftJoin func(from, table Serializer, onCondition BoolExpression) Serializer) (ProjectionList, Serializer) {
+
+	var fields, relations []string
+	relationSelection := map[string][]string{}
+
+	for _, fieldPath := range selection {
+		pathParts := strings.SplitN(fieldPath, ".", 2)
+
+		if len(pathParts) == 1 {
+			fields = append(fields, pathParts[0])
+			continue
+		}
+
+		if _, ok := g.Relations[pathParts[0]]; !ok {
+			continue
+		}
+
+		if _, ok := relationSelection[pathParts[0]]; !ok {
+			relations = append(relations, pathParts[0])
+		}
+
+		relationSelection[pathParts[0]] = append(relationSelection[pathParts[0]], pathParts[1])
+	}
+
+	var projections ProjectionList
+
+	for _, column := range g.Key {
+		projections = append(projections, column)
+	}
+
+	for _, column := range g.Columns.ByFieldNames(fields...).Except(g.Key) {
+		projections = append(projections, column)
+	}
+
+	for _, relationName := range relations {
+		relation := g.Relations[relationName]
+
+		var relationProjections ProjectionList
+		relationProjections, from = relation.selectFields(relationSelection[relationName],
+			leftJoin(from, relation.Table, relation.On), leftJoin)
+		projections = append(projections, relationProjections...)
+	}
+
+	return projections, from
+}
@@ -1,6 +1,106 @@
 package jet
 
+import "strings"
+
 // GroupByClause interface
 type GroupByClause interface {
 	serializeForGroupBy(statement StatementType, out *SQLBuilder)
 }
+
+// groupingScan counts aggregates and collects columns written while serializing single projection
+type groupingScan struct {
+	aggregates int
+	columns    []GroupByClause
+}
+
+func (s *SQLBuilder) aggregateWritten() {
+	if s.grouping != nil && s.statementDepth == 0 {
+		s.grouping.aggregates++
+	}
+}
+
+func (s *SQLBuilder) groupingColumnWritten(column Expression) {
+	if s.grouping == nil || s.statementDepth != 0 {
+		return
+	}
+
+	s.grouping.columns = appendMissing(s.grouping.columns, column)
+}
+
+var aggregateFunctions = map[string]bool{
+	"AVG": true, "BIT_AND": true, "BIT_OR": true, "BIT_XOR": true, "BOOL_AND": true, "BOOL_OR": true, "COUNT": true,
+	"EVERY": true, "MAX": true, "MIN": true, "SUM": true, "STRING_AGG": true, "ARRAY_AGG": true, "JSON_AGG": true,
+	"JSONB_AGG": true, "JSON_OBJECT_AGG": true, "JSONB_OBJECT_AGG": true, "GROUP_CONCAT": true, "JSON_ARRAYAGG": true,
+	"JSON_OBJECTAGG": true, "STDDEV": true, "STDDEV_POP": true, "STDDEV_SAMP": true, "VARIANCE": true, "VAR_POP": true,
+	"VAR_SAMP": true, "CORR": true, "COVAR_POP": true, "COVAR_SAMP": true, "XMLAGG": true, "ANY_VALUE": true,
+}
+
+func isAggregateFunction(name string) bool {
+	return aggregateFunctions[strings.ToUpper(name)]
+}
+
+// nonAggregatedProjections returns projection expressions which reference columns and do not contain aggregate or
+// window functions. Aliased projections are grouped by their expression, and projection lists are flattened.
+// Projections with query parameters are grouped by the columns they reference instead, because parameters serialized
+// again in GROUP BY get new placeholders, and the database would not match GROUP BY with the projection.
+func nonAggregatedProjections(out *SQLBuilder, projections []Projection) []GroupByClause {
+	var ret []GroupByClause
+
+	for _, projection := range projections {
+		var expression Expression
+
+		switch p := projection.(type) {
+		case ProjectionList:
+			ret = append(ret, nonAggregatedProjections(out, p)...)
+			continue
+		case ColumnList:
+			ret = append(ret, nonAggregatedProjections(out, ColumnListToProjectionList(p))...)
+			continue
+		case *alias:
+			expression = p.expression
+		case Expression:
+			expression = p
+		default:
+			continue
+		}
+
+		if _, isStatement := expression.(Statement); isStatement {
+			continue
+		}
+
+		scan := &SQLBuilder{Dialect: out.Dialect, grouping: &groupingScan{}}
+		expression.serialize(SelectStatementType, scan)
+
+		if scan.grouping.aggregates > 0 || len(scan.grouping.columns) == 0 {
+			continue
+		}
+
+		if len(scan.Args) > 0 {
+			ret = appendMissing(ret, scan.grouping.columns...)
+		} else {
+			ret = append(ret, expression)
+		}
+	}
+
+	return ret
+}
+
+func appendMissing(list []GroupByClause, clauses ...GroupByClause) []GroupByClause {
+	for _, clause := range clauses {
+		if !containsGroupByClause(list, clause) {
+			list = append(list, clause)
+		}
+	}
+
+	return list
+}
+
+func containsGroupByClause(list []GroupByClause, clause GroupByClause) bool {
+	for _, c := range list {
+		if c == clause {
+			return true
+		}
+	}
+
+	return false
+}
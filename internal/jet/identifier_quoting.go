@@ -0,0 +1,77 @@
+package jet
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// identifier quoting overrides, keyed by dialect name and identifier. Map is replaced on each update, so it can be
+// read without locking.
+var identifierQuoting atomic.Value
+var identifierQuotingMutex sync.Mutex
+
+func init() {
+	identifierQuoting.Store(map[string]map[string]bool{})
+}
+
+// SetIdentifierQuoting overrides quoting of identifier name (table, schema or column name) in statements of the
+// dialect. If quote is true, identifier is always quoted, and if it is false identifier is never quoted, regardless
+// of the dialect reserved words list and the default quoting rules (identifiers with upper case or non ascii
+// characters are quoted). Name is matched case-sensitively.
+func SetIdentifierQuoting(dialect Dialect, name string, quote bool) {
+	if name == "" {
+		panic("jet: identifier name is empty")
+	}
+
+	updateIdentifierQuoting(dialect, func(overrides map[string]bool) {
+		overrides[name] = quote
+	})
+}
+
+// ResetIdentifierQuoting removes quoting override of identifier name in statements of the dialect
+func ResetIdentifierQuoting(dialect Dialect, name string) {
+	updateIdentifierQuoting(dialect, func(overrides map[string]bool) {
+		delete(overrides, name)
+	})
+}
+
+func updateIdentifierQuoting(dialect Dialect, update func(overrides map[string]bool)) {
+	identifierQuotingMutex.Lock()
+	defer identifierQuotingMutex.Unlock()
+
+	current := identifierQuoting.Load().(map[string]map[string]bool)
+	updated := make(map[string]map[string]bool, len(current)+1)
+
+	for dialectName, overrides := range current {
+		updated[dialectName] = overrides
+	}
+
+	overrides := map[string]bool{}
+
+	for name, quote := range current[dialect.Name()] {
+		overrides[name] = quote
+	}
+
+	update(overrides)
+
+	if len(overrides) == 0 {
+		delete(updated, dialect.Name())
+	} else {
+		updated[dialect.Name()] = overrides
+	}
+
+	identifierQuoting.Store(updated)
+}
+
+// identifierQuotingOverride returns quoting override of identifier name, if any
+func identifierQuotingOverride(dialect Dialect, name string) (quote bool, ok bool) {
+	overrides := identifierQuoting.Load().(map[string]map[string]bool)
+
+	if len(overrides) == 0 {
+		return false, false
+	}
+
+	quote, ok = overrides[dialect.Name()][name]
+
+	return quote, ok
+}
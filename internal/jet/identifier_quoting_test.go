@@ -0,0 +1,35 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetIdentifierQuoting(t *testing.T) {
+	otherDialect := NewDialect(DialectParams{Name: "other", IdentifierQuoteChar: '`'})
+
+	SetIdentifierQuoting(defaultDialect, "col1", true)
+	SetIdentifierQuoting(otherDialect, "col1", false)
+	SetIdentifierQuoting(otherDialect, "Col2", false)
+
+	assertClauseSerialize(t, table1Col1, `table1."col1"`)
+
+	quote, ok := identifierQuotingOverride(otherDialect, "Col2")
+	require.True(t, ok)
+	require.False(t, quote)
+
+	_, ok = identifierQuotingOverride(otherDialect, "col2")
+	require.False(t, ok)
+
+	ResetIdentifierQuoting(defaultDialect, "col1")
+	ResetIdentifierQuoting(otherDialect, "col1")
+	ResetIdentifierQuoting(otherDialect, "Col2")
+
+	assertClauseSerialize(t, table1Col1, `table1.col1`)
+	require.Empty(t, identifierQuoting.Load().(map[string]map[string]bool))
+
+	require.PanicsWithValue(t, "jet: identifier name is empty", func() {
+		SetIdentifierQuoting(defaultDialect, "", true)
+	})
+}
@@ -0,0 +1,44 @@
+package jet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SetInjectionAudit turns sql injection audit on or off. When turned on, execution of statements containing raw sql
+// fragments (Raw expressions and raw statements) with values marked as tainted in the execution context is rejected
+// with an error, because tainted values have to be passed to the database as parametrized arguments.
+func SetInjectionAudit(enabled bool) {
+	updateGlobalConfig(func(config *Config) {
+		config.InjectionAudit = enabled
+	})
+}
+
+type taintedValuesKey struct{}
+
+// Taint returns new context in which values are marked as tainted (user supplied). Statements executed with this
+// context are not allowed to contain tainted values in raw sql fragments if injection audit is turned on.
+func Taint(ctx context.Context, values ...string) context.Context {
+	tainted, _ := ctx.Value(taintedValuesKey{}).([]string)
+
+	return context.WithValue(ctx, taintedValuesKey{}, append(append([]string{}, tainted...), values...))
+}
+
+func taintedValues(ctx context.Context) []string {
+	tainted, _ := ctx.Value(taintedValuesKey{}).([]string)
+	return tainted
+}
+
+func (s *SQLBuilder) auditRawFragment(raw string) {
+	if s.injectionErr != nil {
+		return
+	}
+
+	for _, value := range s.tainted {
+		if value != "" && strings.Contains(raw, value) {
+			s.injectionErr = fmt.Errorf("jet: raw sql fragment contains unparameterized tainted value %q", value)
+			return
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectionAudit(t *testing.T) {
+	SetInjectionAudit(true)
+	defer SetInjectionAudit(false)
+
+	db := &recordingDB{}
+	userInput := "1 OR 1=1"
+	ctx := Taint(context.Background(), userInput)
+
+	unsafeStmt := RawStatement(defaultDialect, "SELECT * FROM table1 WHERE col1 = "+userInput)
+	_, err := unsafeStmt.ExecContext(ctx, db)
+	require.EqualError(t, err, `jet: raw sql fragment contains unparameterized tainted value "1 OR 1=1"`)
+	require.Empty(t, db.query)
+
+	unsafeExpStmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1Col1}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+		&ClauseWhere{Condition: BoolExp(Raw("table1.col1 = " + userInput))},
+	)
+	_, err = unsafeExpStmt.ExecContext(ctx, db)
+	require.EqualError(t, err, `jet: raw sql fragment contains unparameterized tainted value "1 OR 1=1"`)
+
+	safeStmt := RawStatement(defaultDialect, "SELECT * FROM table1 WHERE col1 = #col1", map[string]interface{}{"#col1": userInput})
+	_, err = safeStmt.ExecContext(ctx, db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, []interface{}{userInput}, db.args)
+
+	// values are tainted only in the context they are marked in
+	_, err = unsafeStmt.ExecContext(context.Background(), db)
+	require.Equal(t, sql.ErrConnDone, err)
+}
+
+func TestInjectionAuditDisabled(t *testing.T) {
+	db := &recordingDB{}
+	ctx := Taint(context.Background(), "1 OR 1=1")
+
+	_, err := RawStatement(defaultDialect, "SELECT * FROM table1 WHERE col1 = 1 OR 1=1").ExecContext(ctx, db)
+	require.Equal(t, sql.ErrConnDone, err)
+}
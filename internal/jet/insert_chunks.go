@@ -0,0 +1,160 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// InsertChunks splits rows of INSERT statement VALUES clause into chunks, each executed as a separate statement
+type InsertChunks struct {
+	// Size is the maximum number of rows in a chunk, or 0 if rows are not split
+	Size int
+}
+
+// ChunkSize returns the number of rows per chunk, so that the statement with columnCount values per row has at most
+// maxParameters parameters. If size is set, it is used instead, but only if it does not exceed parameter limit.
+func ChunkSize(size, columnCount, maxParameters int) int {
+	maxSize := maxParameters
+
+	if columnCount > 0 {
+		maxSize = maxParameters / columnCount
+	}
+
+	if maxSize < 1 {
+		maxSize = 1
+	}
+
+	if size <= 0 || size > maxSize {
+		return maxSize
+	}
+
+	return size
+}
+
+// ExecInChunks executes statement once for each chunk of values rows, or only once if rows are not split. Returned
+// result contains the total number of rows affected and the last insert id of the last chunk. If db is *sql.DB (or
+// other db connection able to begin transaction), chunks are executed in a new transaction.
+func (c InsertChunks) ExecInChunks(ctx context.Context, db qrm.Executable, statement Statement,
+	values *ClauseValues) (sql.Result, error) {
+
+	if c.Size <= 0 {
+		return statement.ExecContext(ctx, db)
+	}
+
+	result := &chunksResult{}
+
+	err := c.inChunks(ctx, db, values, func(db interface{}) error {
+		executable, ok := db.(qrm.Executable)
+
+		if !ok {
+			return errors.New("jet: chunked INSERT requires db connection or transaction which can execute statements")
+		}
+
+		res, err := statement.ExecContext(ctx, executable)
+
+		if err != nil {
+			return err
+		}
+
+		return result.add(res)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// QueryInChunks executes statement once for each chunk of values rows, or only once if rows are not split, and
+// stores rows returned by each statement into destination. Destination has to be a pointer to slice. If db is
+// *sql.DB (or other db connection able to begin transaction), chunks are executed in a new transaction.
+func (c InsertChunks) QueryInChunks(ctx context.Context, db qrm.Queryable, statement Statement,
+	values *ClauseValues, destination interface{}) error {
+
+	if c.Size <= 0 {
+		return statement.QueryContext(ctx, db, destination)
+	}
+
+	return c.inChunks(ctx, db, values, func(db interface{}) error {
+		queryable, ok := db.(qrm.Queryable)
+
+		if !ok {
+			return errors.New("jet: chunked INSERT requires db connection or transaction which can query statements")
+		}
+
+		return statement.QueryContext(ctx, queryable, destination)
+	})
+}
+
+func (c InsertChunks) inChunks(ctx context.Context, db interface{}, values *ClauseValues,
+	exec func(db interface{}) error) error {
+
+	rows := values.Rows
+	defer func() { values.Rows = rows }()
+
+	execChunks := func(db interface{}) error {
+		for start := 0; start < len(rows); start += c.Size {
+			end := start + c.Size
+
+			if end > len(rows) {
+				end = len(rows)
+			}
+
+			values.Rows = rows[start:end]
+
+			if err := exec(db); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if !CanBeginTx(db) || len(rows) <= c.Size {
+		return execChunks(db)
+	}
+
+	tx, wrappedTx, err := BeginTx(ctx, db, nil)
+
+	if err != nil {
+		return err
+	}
+
+	if err := execChunks(wrappedTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type chunksResult struct {
+	lastInsertID    int64
+	lastInsertIDErr error
+	rowsAffected    int64
+}
+
+func (r *chunksResult) add(res sql.Result) error {
+	rowsAffected, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	r.rowsAffected += rowsAffected
+	r.lastInsertID, r.lastInsertIDErr = res.LastInsertId()
+
+	return nil
+}
+
+func (r *chunksResult) LastInsertId() (int64, error) {
+	return r.lastInsertID, r.lastInsertIDErr
+}
+
+func (r *chunksResult) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
@@ -0,0 +1,71 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkSize(t *testing.T) {
+	require.Equal(t, 100, ChunkSize(100, 3, 65535))
+	require.Equal(t, 21845, ChunkSize(0, 3, 65535))
+	require.Equal(t, 21845, ChunkSize(50000, 3, 65535))
+	require.Equal(t, 1, ChunkSize(0, 100, 10))
+}
+
+// chunksDB records executed queries, and returns the number of query arguments as the number of rows affected
+type chunksDB struct {
+	queries []string
+	failAt  int
+}
+
+func (c *chunksDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	c.queries = append(c.queries, query)
+
+	if len(c.queries) == c.failAt {
+		return nil, errors.New("exec failed")
+	}
+
+	return driver.RowsAffected(len(args)), nil
+}
+
+func TestInsertChunksExec(t *testing.T) {
+	values := &ClauseValuesQuery{}
+	stmt := newTestStatement(InsertStatementType,
+		&ClauseInsert{Table: table1, Columns: []Column{table1Col1}},
+		values,
+	)
+
+	for i := 1; i <= 5; i++ {
+		values.Rows = append(values.Rows, UnwindRowFromValues(i, nil))
+	}
+
+	db := &chunksDB{}
+	res, err := InsertChunks{Size: 2}.ExecInChunks(context.Background(), db, stmt, &values.ClauseValues)
+	require.NoError(t, err)
+
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), rowsAffected)
+	require.Equal(t, []string{
+		"\nINSERT INTO db.table1 (col1)\nVALUES ($1),\n       ($2);\n",
+		"\nINSERT INTO db.table1 (col1)\nVALUES ($1),\n       ($2);\n",
+		"\nINSERT INTO db.table1 (col1)\nVALUES ($1);\n",
+	}, db.queries)
+	require.Len(t, values.Rows, 5)
+
+	db = &chunksDB{failAt: 2}
+	_, err = InsertChunks{Size: 2}.ExecInChunks(context.Background(), db, stmt, &values.ClauseValues)
+	require.EqualError(t, err, "exec failed")
+	require.Len(t, db.queries, 2)
+	require.Len(t, values.Rows, 5)
+
+	db = &chunksDB{}
+	_, err = InsertChunks{}.ExecInChunks(context.Background(), db, stmt, &values.ClauseValues)
+	require.NoError(t, err)
+	require.Len(t, db.queries, 1)
+}
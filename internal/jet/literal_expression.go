@@ -32,14 +32,14 @@ func literal(value interface{}, optionalConstant ...bool) *literalExpressionImpl
 	return &exp
 }
 
-// Literal is injected directly to SQL query, and does not appear in parametrized argument list.
+// Literal is a parametrized literal expression. Value is passed to the database as a query argument.
 func Literal(value interface{}) *literalExpressionImpl {
 	exp := literal(value)
 	return exp
 }
 
 // FixedLiteral is injected directly to SQL query, and does not appear in parametrized argument list.
-func FixedLiteral(value interface{}) *literalExpressionImpl {
+func FixedLiteral(value interface{}) LiteralExpression {
 	exp := literal(value)
 	exp.constant = true
 
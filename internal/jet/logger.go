@@ -16,22 +16,25 @@ type PrintableStatement interface {
 // LoggerFunc is a function user can implement to support automatic statement logging.
 type LoggerFunc func(ctx context.Context, statement PrintableStatement)
 
-var logger LoggerFunc
-
 // SetLoggerFunc sets automatic statement logging
 func SetLoggerFunc(loggerFunc LoggerFunc) {
-	logger = loggerFunc
+	updateGlobalConfig(func(config *Config) {
+		config.Logger = loggerFunc
+	})
 }
 
-func callLogger(ctx context.Context, statement Statement) {
-	if logger != nil {
-		logger(ctx, statement)
+func callLogger(ctx context.Context, config Config, statement Statement) {
+	if config.Logger != nil {
+		config.Logger(ctx, statement)
 	}
 }
 
 // QueryInfo contains information about executed query
 type QueryInfo struct {
 	Statement PrintableStatement
+	// Query and Args are sql query and arguments sent to the database
+	Query string
+	Args  []interface{}
 	// Depending on how the statement is executed, RowsProcessed is:
 	// 	- Number of rows returned for Query() and QueryContext() methods
 	// 	- RowsAffected() for Exec() and ExecContext() methods
@@ -44,16 +47,29 @@ type QueryInfo struct {
 // QueryLoggerFunc is a function user can implement to retrieve more information about statement executed.
 type QueryLoggerFunc func(ctx context.Context, info QueryInfo)
 
-var queryLoggerFunc QueryLoggerFunc
-
 // SetQueryLogger sets automatic query logging function.
 func SetQueryLogger(loggerFunc QueryLoggerFunc) {
-	queryLoggerFunc = loggerFunc
+	updateGlobalConfig(func(config *Config) {
+		config.QueryLogger = loggerFunc
+	})
 }
 
-func callQueryLoggerFunc(ctx context.Context, info QueryInfo) {
-	if queryLoggerFunc != nil {
-		queryLoggerFunc(ctx, info)
+type queryLoggerKey struct{}
+
+// WithQueryLogger returns context, which makes statements executed with it call loggerFunc after execution, in
+// addition to configured query logger. Useful to observe only selected statements, for instance in slow query
+// logging of a single request.
+func WithQueryLogger(ctx context.Context, loggerFunc QueryLoggerFunc) context.Context {
+	return context.WithValue(ctx, queryLoggerKey{}, loggerFunc)
+}
+
+func callQueryLoggerFunc(ctx context.Context, config Config, info QueryInfo) {
+	if config.QueryLogger != nil {
+		config.QueryLogger(ctx, info)
+	}
+
+	if loggerFunc, ok := ctx.Value(queryLoggerKey{}).(QueryLoggerFunc); ok && loggerFunc != nil {
+		loggerFunc(ctx, info)
 	}
 }
 
@@ -0,0 +1,48 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithQueryLogger(t *testing.T) {
+	var globalLogged, contextLogged []QueryInfo
+
+	SetQueryLogger(func(ctx context.Context, info QueryInfo) {
+		globalLogged = append(globalLogged, info)
+	})
+	defer SetQueryLogger(nil)
+
+	stmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1ColInt}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+		&ClauseWhere{Condition: table1ColInt.EQ(Int(11))},
+	)
+
+	_, err := stmt.Exec(&recordingDB{})
+	require.Equal(t, sql.ErrConnDone, err)
+
+	ctx := WithQueryLogger(context.Background(), func(ctx context.Context, info QueryInfo) {
+		contextLogged = append(contextLogged, info)
+	})
+
+	var dest []struct{}
+	err = stmt.QueryContext(ctx, &recordingDB{}, &dest)
+	require.Error(t, err)
+
+	require.Len(t, globalLogged, 2)
+	require.Len(t, contextLogged, 1)
+
+	info := contextLogged[0]
+	require.Equal(t, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int = $1;
+`, info.Query)
+	require.Equal(t, []interface{}{int64(11)}, info.Args)
+	require.True(t, errors.Is(info.Err, sql.ErrConnDone))
+}
@@ -49,6 +49,12 @@ func IsNotDistinctFrom(lhs, rhs Expression) BoolExpression {
 	return newBinaryBoolOperatorExpression(lhs, rhs, "IS NOT DISTINCT FROM")
 }
 
+// NullSafeEq returns true if lhs and rhs are equal, treating two NULL values as equal and NULL value as not equal to
+// any non NULL value. Serialized as "a IS NOT DISTINCT FROM b", or with equivalent dialect operator (MySQL "a <=> b").
+func NullSafeEq(lhs, rhs Expression) BoolExpression {
+	return IsNotDistinctFrom(lhs, rhs)
+}
+
 // Lt returns a representation of "a<b"
 func Lt(lhs Expression, rhs Expression) BoolExpression {
 	return newBinaryBoolOperatorExpression(lhs, rhs, "<")
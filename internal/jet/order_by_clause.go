@@ -1,5 +1,10 @@
 package jet
 
+import (
+	"fmt"
+	"strings"
+)
+
 // OrderByClause interface
 type OrderByClause interface {
 	serializeForOrderBy(statement StatementType, out *SQLBuilder)
@@ -27,3 +32,107 @@ func (o *orderByClauseImpl) serializeForOrderBy(statement StatementType, out *SQ
 func newOrderByClause(expression Expression, ascent bool) OrderByClause {
 	return &orderByClauseImpl{expression: expression, ascent: ascent}
 }
+
+// NULLS_FIRST sorts NULL values of order by clause before non NULL values. On databases without NULLS FIRST support
+// (MySQL, SQL Server) ordering is emulated with an additional leading sort expression.
+func NULLS_FIRST(orderBy OrderByClause) OrderByClause {
+	return &nullsOrderByClause{clause: orderBy, nullsFirst: true}
+}
+
+// NULLS_LAST sorts NULL values of order by clause after non NULL values. On databases without NULLS LAST support
+// (MySQL, SQL Server) ordering is emulated with an additional leading sort expression.
+func NULLS_LAST(orderBy OrderByClause) OrderByClause {
+	return &nullsOrderByClause{clause: orderBy, nullsFirst: false}
+}
+
+type nullsOrderByClause struct {
+	clause     OrderByClause
+	nullsFirst bool
+}
+
+func (n *nullsOrderByClause) serializeForOrderBy(statement StatementType, out *SQLBuilder) {
+	if n.clause == nil {
+		panic("jet: nil expression in ORDER BY clause")
+	}
+
+	if out.Dialect == nil || out.Dialect.Supports(FeatureNullsOrdering) {
+		n.clause.serializeForOrderBy(statement, out)
+
+		if n.nullsFirst {
+			out.WriteString("NULLS FIRST")
+		} else {
+			out.WriteString("NULLS LAST")
+		}
+		return
+	}
+
+	expression := orderByExpression(n.clause)
+
+	if expression == nil {
+		panic("jet: NULLS FIRST/LAST can not be emulated for nested order by clause")
+	}
+
+	nullsOrder, valuesOrder := FixedLiteral(1), FixedLiteral(0)
+
+	if n.nullsFirst {
+		nullsOrder, valuesOrder = valuesOrder, nullsOrder
+	}
+
+	CASE().WHEN(expression.IS_NULL()).THEN(nullsOrder).ELSE(valuesOrder).serializeForOrderBy(statement, out)
+	out.WriteString(", ")
+	n.clause.serializeForOrderBy(statement, out)
+}
+
+// orderByExpression returns expression sorted by order by clause
+func orderByExpression(clause OrderByClause) Expression {
+	switch orderBy := clause.(type) {
+	case *orderByClauseImpl:
+		return orderBy.expression
+	case *nullsOrderByClause:
+		return orderByExpression(orderBy.clause)
+	case Expression:
+		return orderBy
+	}
+
+	return nil
+}
+
+// orderByExpressionString returns sql of expression, used to compare order by expressions
+func orderByExpressionString(expression Expression, dialect Dialect) string {
+	out := SQLBuilder{Dialect: dialect, Debug: true}
+	expression.serializeForOrderBy(SelectStatementType, &out)
+	return out.Buff.String()
+}
+
+// ParseOrderBy converts comma separated list of sort fields (for instance "-created_at,name") into a list of
+// order by clauses. Field prefixed with '-' is sorted in descending order, otherwise in ascending order ('+' prefix
+// is optional). Fields are validated against the list of allowed columns, and matched with column names ignoring
+// case and underscores.
+func ParseOrderBy(sort string, allowedColumns ColumnList) ([]OrderByClause, error) {
+	var ret []OrderByClause
+
+	for _, field := range strings.Split(sort, ",") {
+		field = strings.TrimSpace(field)
+
+		if field == "" {
+			continue
+		}
+
+		ascending := true
+
+		if field[0] == '-' || field[0] == '+' {
+			ascending = field[0] == '+'
+			field = strings.TrimSpace(field[1:])
+		}
+
+		columns := allowedColumns.ByFieldNames(field)
+
+		if len(columns) == 0 {
+			return nil, fmt.Errorf("jet: sorting by '%s' is not allowed", field)
+		}
+
+		ret = append(ret, newOrderByClause(columns[0], ascending))
+	}
+
+	return ret, nil
+}
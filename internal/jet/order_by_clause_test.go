@@ -0,0 +1,30 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOrderBy(t *testing.T) {
+	allowedColumns := ColumnList{table2ColInt, table2ColStr, table2ColTimestamp}
+
+	orderBy, err := ParseOrderBy("-col_timestamp, colStr,+COLINT", allowedColumns)
+	require.NoError(t, err)
+
+	out := SQLBuilder{Dialect: defaultDialect}
+	clauseOrderBy := ClauseOrderBy{List: orderBy}
+	clauseOrderBy.Serialize(SelectStatementType, &out)
+
+	require.Equal(t, "\nORDER BY table2.col_timestamp DESC, table2.col_str ASC, table2.col_int ASC", out.Buff.String())
+
+	orderBy, err = ParseOrderBy("", allowedColumns)
+	require.NoError(t, err)
+	require.Empty(t, orderBy)
+
+	_, err = ParseOrderBy("colStr,-col_float", allowedColumns)
+	require.EqualError(t, err, "jet: sorting by 'col_float' is not allowed")
+
+	_, err = ParseOrderBy("col_str;DROP TABLE", allowedColumns)
+	require.EqualError(t, err, "jet: sorting by 'col_str;DROP TABLE' is not allowed")
+}
@@ -53,6 +53,7 @@ type orderSetAggregateFuncExpression struct {
 }
 
 func (p *orderSetAggregateFuncExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.aggregateWritten()
 	out.WriteString(p.name)
 	WRAP(p.fraction).serialize(statement, out, FallTrough(options)...)
 	out.WriteString("WITHIN GROUP")
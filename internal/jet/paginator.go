@@ -0,0 +1,444 @@
+package jet
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// Page contains pagination metadata of the queried page
+type Page struct {
+	// Total is the number of rows of the whole paginated statement, or -1 if total is not counted
+	Total int64
+	// TotalEstimated is true if the number of rows exceeded count limit, and Total is only an estimation
+	TotalEstimated bool
+	// HasNext is true if there are more rows after the queried page
+	HasNext bool
+	// NextCursor is the cursor of the next page in keyset mode, or empty string if there is no next page
+	NextCursor string
+}
+
+// Paginator queries select statement results page by page, either in offset mode (default), or in keyset mode.
+// Paginator never modifies the statement. In offset mode page LIMIT and OFFSET are applied on top of the statement
+// LIMIT and OFFSET, if any, while the statement is serialized for execution. In keyset mode statement is wrapped
+// in a sub-query, ordered by keyset columns.
+type Paginator struct {
+	dialect    Dialect
+	statement  SerializerStatement
+	pageSize   int64
+	page       int64
+	keyset     []Column
+	cursor     string
+	total      bool
+	totalLimit int64
+	estimate   func(ctx context.Context, db qrm.Queryable, statement Statement) (int64, error)
+}
+
+// NewPaginator creates new offset mode paginator of statement, with pageSize rows per page. Estimate function, if
+// not nil, is used to estimate total number of rows above WithTotalUpTo limit.
+func NewPaginator(dialect Dialect, statement SerializerStatement, pageSize int64,
+	estimate func(ctx context.Context, db qrm.Queryable, statement Statement) (int64, error)) Paginator {
+
+	return Paginator{
+		dialect:   dialect,
+		statement: statement,
+		pageSize:  pageSize,
+		page:      1,
+		estimate:  estimate,
+	}
+}
+
+// Page returns new paginator querying the page with the number (starting from 1)
+func (p Paginator) Page(number int64) Paginator {
+	p.page = number
+	return p
+}
+
+// Keyset returns new keyset mode paginator, querying the rows after the cursor, ordered by ascending keyset columns.
+// Empty cursor queries the first page. The values of keyset columns have to be unique, and keyset columns
+// have to be part of the statement projections.
+func (p Paginator) Keyset(cursor string, keyset ...ColumnExpression) Paginator {
+	p.cursor = cursor
+	p.keyset = nil
+
+	for _, column := range keyset {
+		p.keyset = append(p.keyset, column)
+	}
+
+	return p
+}
+
+// WithTotal returns new paginator, which also counts the total number of statement rows
+func (p Paginator) WithTotal() Paginator {
+	p.total = true
+	p.totalLimit = 0
+	return p
+}
+
+// WithTotalUpTo returns new paginator, which counts the total number of statement rows exactly only up to
+// the limit, to avoid the full count of huge tables. If there are more rows, page total is marked as estimated, and
+// it is either estimated by the dialect (postgres uses query planner statistics) or set to limit+1.
+func (p Paginator) WithTotalUpTo(limit int64) Paginator {
+	p.total = true
+	p.totalLimit = limit
+	return p
+}
+
+// Query executes page query and stores page rows into destination. Destination has to be a pointer to slice.
+func (p Paginator) Query(ctx context.Context, db qrm.Queryable, destination interface{}) (Page, error) {
+	total, totalEstimated := int64(-1), false
+
+	if p.total {
+		var err error
+		total, totalEstimated, err = p.queryTotal(ctx, db)
+
+		if err != nil {
+			return Page{Total: -1}, err
+		}
+	}
+
+	pageStatement, pageDB := Statement(p.statement), qrm.Queryable(&pageWindowDB{
+		Queryable: db,
+		limit:     p.pageSize + 1,
+		offset:    (p.page - 1) * p.pageSize,
+	})
+
+	if len(p.keyset) > 0 {
+		paginated := NewSelectTable(p.statement, "paginated")
+
+		condition, err := KeysetCondition(paginated, p.keyset, p.cursor)
+
+		if err != nil {
+			return Page{Total: total}, err
+		}
+
+		pageStatement = newPaginatorSelect(p.dialect,
+			&ClauseSelect{ProjectionList: []Projection{paginated.AllColumns()}},
+			&ClauseFrom{Tables: []Serializer{paginated}},
+			&ClauseWhere{Condition: condition},
+			&ClauseOrderBy{List: KeysetOrderBy(paginated, p.keyset)},
+			&ClauseLimit{Count: p.pageSize + 1},
+		)
+		pageDB = db
+	}
+
+	page, err := QueryPage(ctx, pageDB, pageStatement, p.pageSize, p.keyset, destination)
+	page.Total, page.TotalEstimated = total, totalEstimated
+
+	return page, err
+}
+
+func (p Paginator) queryTotal(ctx context.Context, db qrm.Queryable) (total int64, estimated bool, err error) {
+	paginated := NewSelectTable(p.statement, "paginated")
+	count := &ClauseSelect{ProjectionList: []Projection{COUNT(STAR).AS("count")}}
+
+	if p.totalLimit <= 0 {
+		total, err = QueryCount(ctx, db, newPaginatorSelect(p.dialect, count, &ClauseFrom{Tables: []Serializer{paginated}}))
+		return total, false, err
+	}
+
+	limited := NewSelectTable(newPaginatorSelect(p.dialect,
+		&ClauseSelect{ProjectionList: []Projection{Raw("1")}},
+		&ClauseFrom{Tables: []Serializer{paginated}},
+		&ClauseLimit{Count: p.totalLimit + 1},
+	), "limited")
+
+	var estimate func() (int64, error)
+
+	if p.estimate != nil {
+		estimate = func() (int64, error) {
+			return p.estimate(ctx, db, p.statement)
+		}
+	}
+
+	return QueryLimitedCount(ctx, db, newPaginatorSelect(p.dialect, count, &ClauseFrom{Tables: []Serializer{limited}}),
+		p.totalLimit, estimate)
+}
+
+func newPaginatorSelect(dialect Dialect, clauses ...Clause) SerializerStatement {
+	newSelect := NewStatementImpl(dialect, SelectStatementType, nil, clauses...).(*statementImpl)
+	newSelect.parent = newSelect
+
+	return newSelect
+}
+
+// pageWindowDB wraps db used to execute offset mode page query. Top level SELECT statement executed over it
+// returns at most limit rows, starting offset rows after the statement OFFSET, and not past the statement LIMIT.
+type pageWindowDB struct {
+	qrm.Queryable
+
+	limit  int64
+	offset int64
+}
+
+func (w *pageWindowDB) limitCount(statementLimit int64) int64 {
+	if statementLimit < 0 {
+		return w.limit
+	}
+
+	if remaining := statementLimit - w.offset; remaining < w.limit {
+		if remaining < 0 {
+			return 0
+		}
+		return remaining
+	}
+
+	return w.limit
+}
+
+func (w *pageWindowDB) offsetCount(statementOffset int64) int64 {
+	if statementOffset < 0 {
+		return w.offset
+	}
+
+	return statementOffset + w.offset
+}
+
+// KeysetCondition returns condition selecting sub-query rows with keyset column values greater than the values
+// encoded in the cursor. Nil is returned for an empty cursor.
+func KeysetCondition(subQuery SelectTable, keyset []Column, cursor string) (BoolExpression, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	values, err := decodeCursor(cursor)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) != len(keyset) {
+		return nil, fmt.Errorf("jet: cursor has %d value(s), expected %d", len(values), len(keyset))
+	}
+
+	var columns, literals []Expression
+
+	for i, column := range keyset {
+		columns = append(columns, subQueryColumn(subQuery, column))
+		literals = append(literals, Literal(values[i]))
+	}
+
+	return Gt(WRAP(columns...), WRAP(literals...)), nil
+}
+
+// KeysetOrderBy returns ascending order by clauses of sub-query keyset columns
+func KeysetOrderBy(subQuery SelectTable, keyset []Column) []OrderByClause {
+	var orderBy []OrderByClause
+
+	for _, column := range keyset {
+		orderBy = append(orderBy, subQueryColumn(subQuery, column).ASC())
+	}
+
+	return orderBy
+}
+
+func subQueryColumn(subQuery SelectTable, column Column) Expression {
+	newColumn := &ColumnExpressionImpl{
+		name:      column.Name(),
+		tableName: column.TableName(),
+		subQuery:  subQuery,
+	}
+	newColumn.ExpressionInterfaceImpl.Parent = newColumn
+
+	return newColumn
+}
+
+// QueryCount executes count statement, and returns the value of its "count" column
+func QueryCount(ctx context.Context, db qrm.Queryable, countStatement Statement) (int64, error) {
+	var dest struct {
+		Count int64
+	}
+
+	err := countStatement.QueryContext(ctx, db, &dest)
+
+	return dest.Count, err
+}
+
+// QueryLimitedCount executes count statement, which is expected to count at most limit+1 rows. If there are more
+// than limit rows, total is estimated with estimate function, if set. Estimated total is never lower than limit+1.
+func QueryLimitedCount(ctx context.Context, db qrm.Queryable, countStatement Statement, limit int64,
+	estimate func() (int64, error)) (total int64, estimated bool, err error) {
+
+	total, err = QueryCount(ctx, db, countStatement)
+
+	if err != nil || total <= limit {
+		return total, false, err
+	}
+
+	if estimate != nil {
+		estimation, err := estimate()
+
+		if err != nil {
+			return total, true, err
+		}
+
+		if estimation > total {
+			total = estimation
+		}
+	}
+
+	return total, true, nil
+}
+
+// QueryPage executes page statement, limited to pageSize+1 rows, and stores the first pageSize rows into destination.
+// Destination has to be a pointer to a slice. If keyset is set, the next page cursor is read from the last
+// destination element.
+func QueryPage(ctx context.Context, db qrm.Queryable, pageStatement Statement, pageSize int64, keyset []Column,
+	destination interface{}) (Page, error) {
+
+	page := Page{Total: -1}
+
+	destValue := reflect.ValueOf(destination)
+
+	if destValue.Kind() != reflect.Ptr || destValue.Elem().Kind() != reflect.Slice {
+		return page, fmt.Errorf("jet: paginator destination has to be a pointer to slice, got %T", destination)
+	}
+
+	err := pageStatement.QueryContext(ctx, db, destination)
+
+	if err != nil {
+		return page, err
+	}
+
+	slice := destValue.Elem()
+
+	if int64(slice.Len()) <= pageSize {
+		return page, nil
+	}
+
+	page.HasNext = true
+	slice.Set(slice.Slice(0, int(pageSize)))
+
+	if len(keyset) > 0 && pageSize > 0 {
+		page.NextCursor, err = encodeCursor(slice.Index(slice.Len()-1), keyset)
+	}
+
+	return page, err
+}
+
+func encodeCursor(elem reflect.Value, keyset []Column) (string, error) {
+	var values []interface{}
+
+	for _, column := range keyset {
+		value, ok := findColumnField(elem, "", column)
+
+		if !ok {
+			return "", fmt.Errorf("jet: keyset column %s not found in paginator destination", column.defaultAlias())
+		}
+
+		values = append(values, value.Interface())
+	}
+
+	data, err := json.Marshal(values)
+
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(cursor string) ([]interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+
+	if err != nil {
+		return nil, fmt.Errorf("jet: invalid cursor: %s", err)
+	}
+
+	var values []interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&values); err != nil {
+		return nil, fmt.Errorf("jet: invalid cursor: %s", err)
+	}
+
+	for i, value := range values {
+		number, ok := value.(json.Number)
+
+		if !ok {
+			continue
+		}
+
+		if intValue, err := strconv.ParseInt(string(number), 10, 64); err == nil {
+			values[i] = intValue
+		} else {
+			values[i], _ = strconv.ParseFloat(string(number), 64)
+		}
+	}
+
+	return values, nil
+}
+
+// findColumnField searches struct value for the field column would be scanned into, using the same naming rules
+// as query result mapping: model type name matches table name, and field name matches column name, or the field
+// has an alias tag of the form "table.column".
+func findColumnField(value reflect.Value, tableAlias string, column Column) (reflect.Value, bool) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	if tableAlias == "" {
+		tableAlias = value.Type().Name()
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+
+		if field.PkgPath != "" && !field.Anonymous { // unexported
+			continue
+		}
+
+		aliasTag := field.Tag.Get("alias")
+
+		if aliasTag != "" && strings.Contains(aliasTag, ".") {
+			if commonIdentifier(aliasTag) == commonIdentifier(column.defaultAlias()) {
+				return value.Field(i), true
+			}
+		} else if commonIdentifier(tableAlias) == commonIdentifier(column.TableName()) &&
+			commonIdentifier(field.Name) == commonIdentifier(column.Name()) {
+			return value.Field(i), true
+		}
+
+		if indirectKind(field.Type) == reflect.Struct {
+			fieldTableAlias := ""
+
+			if aliasTag != "" && !strings.Contains(aliasTag, ".") {
+				fieldTableAlias = aliasTag
+			}
+
+			if fieldValue, ok := findColumnField(value.Field(i), fieldTableAlias, column); ok {
+				return fieldValue, true
+			}
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func commonIdentifier(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
+
+func indirectKind(fieldType reflect.Type) reflect.Kind {
+	for fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	return fieldType.Kind()
+}
@@ -0,0 +1,88 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type Table1 struct {
+	Col1   int64
+	ColInt *int32
+}
+
+func TestKeysetCondition(t *testing.T) {
+	subQuery := NewSelectTable(nil, "paginated")
+	keyset := []Column{table1ColInt, table1Col1}
+
+	condition, err := KeysetCondition(subQuery, keyset, "")
+	require.NoError(t, err)
+	require.Nil(t, condition)
+
+	cursor, err := encodeCursor(reflect.ValueOf(Table1{Col1: 9007199254740993, ColInt: new(int32)}), keyset)
+	require.NoError(t, err)
+
+	condition, err = KeysetCondition(subQuery, keyset, cursor)
+	require.NoError(t, err)
+	assertClauseSerialize(t, condition, `((paginated."table1.col_int", paginated."table1.col1") > ($1, $2))`,
+		int64(0), int64(9007199254740993))
+
+	_, err = KeysetCondition(subQuery, keyset[:1], cursor)
+	require.EqualError(t, err, "jet: cursor has 2 value(s), expected 1")
+	_, err = KeysetCondition(subQuery, keyset, "not a cursor")
+	require.Error(t, err)
+}
+
+func TestEncodeCursor(t *testing.T) {
+	type Dest struct {
+		Table1
+		Other struct {
+			Str string `alias:"table2.col_str"`
+		}
+		Table3 *struct {
+			Value int `alias:"col2"`
+		} `alias:"table3"`
+	}
+
+	dest := Dest{Table1: Table1{Col1: 1}}
+	dest.Other.Str = "text"
+
+	cursor, err := encodeCursor(reflect.ValueOf(&dest), []Column{table1Col1, table2ColStr})
+	require.NoError(t, err)
+
+	values, err := decodeCursor(cursor)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(1), "text"}, values)
+
+	_, err = encodeCursor(reflect.ValueOf(&dest), []Column{table3StrCol})
+	require.EqualError(t, err, "jet: keyset column table3.col2 not found in paginator destination")
+}
+
+func TestPaginatorWith(t *testing.T) {
+	cte := CTE("cte")
+	cte.Statement = newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table2Col3}},
+		&ClauseFrom{Tables: []Serializer{table2}},
+		&ClauseLimit{Count: 5},
+		&ClauseOffset{Count: 2},
+	).(SerializerStatement)
+
+	stmt := WITH(defaultDialect, false, &cte)(newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1Col1}},
+		&ClauseFrom{Tables: []Serializer{table1, cte}},
+		&ClauseLimit{Count: -1},
+		&ClauseOffset{Count: -1},
+	)).(SerializerStatement)
+
+	db := &recordingDB{}
+	var dest []Table1
+
+	_, err := NewPaginator(defaultDialect, stmt, 10, nil).Page(2).Query(context.Background(), db, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	// page window is applied only to the primary statement, common table expression keeps its own LIMIT and OFFSET
+	require.Equal(t, []interface{}{int64(5), int64(2), int64(11), int64(10)}, db.args)
+}
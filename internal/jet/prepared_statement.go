@@ -0,0 +1,57 @@
+package jet
+
+import "strings"
+
+// ClausePrepare struct
+type ClausePrepare struct {
+	Name  string
+	Query string
+}
+
+// Serialize serializes clause into SQLBuilder
+func (p *ClausePrepare) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.NewLine()
+	out.WriteString("PREPARE")
+	out.WriteIdentifier(p.Name)
+	out.WriteString("AS")
+	out.WriteString(strings.TrimSuffix(p.Query, ";\n"))
+}
+
+// ClauseExecute struct
+type ClauseExecute struct {
+	Name string
+	Args []interface{}
+}
+
+// Serialize serializes clause into SQLBuilder
+func (e *ClauseExecute) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.NewLine()
+	out.WriteString("EXECUTE")
+	out.WriteIdentifier(e.Name)
+
+	if len(e.Args) == 0 {
+		return
+	}
+
+	out.WriteByte('(')
+	for i, arg := range e.Args {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		// EXECUTE does not accept query parameters, so arguments are always inlined
+		out.insertConstantArgument(arg)
+	}
+	out.WriteByte(')')
+}
+
+// ClauseDeallocate struct
+type ClauseDeallocate struct {
+	Name string
+}
+
+// Serialize serializes clause into SQLBuilder
+func (d *ClauseDeallocate) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.NewLine()
+	out.WriteString("DEALLOCATE")
+	out.WriteIdentifier(d.Name)
+}
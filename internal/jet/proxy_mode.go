@@ -0,0 +1,119 @@
+package jet
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jet/jet/v2/internal/3rdparty/pq"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+type proxyModeDB struct {
+	qrm.DB
+}
+
+// ProxyMode wraps db connection or transaction, so that statements executed over it do not create any session state.
+// Statement arguments are interpolated into the query as escaped literals, so database driver does not need to create
+// prepared statements, and session altering statements (SET, LOCK) are rejected outside of explicit transaction.
+// Useful for connection proxies and poolers (RDS Proxy, Aurora DSQL, PgBouncer in transaction mode), which can not
+// guarantee that consecutive statements are executed over the same database session.
+//
+// Literals are escaped for the default server settings. MySQL strings are escaped with backslashes, so
+// NO_BACKSLASH_ESCAPES sql mode changes the value of strings containing backslash (but the string still can not
+// terminate the literal).
+func ProxyMode(db qrm.DB) qrm.DB {
+	if _, ok := db.(*proxyModeDB); ok {
+		return db
+	}
+
+	return &proxyModeDB{DB: db}
+}
+
+func (p *proxyModeDB) inTransaction() bool {
+	for current := interface{}(p.DB); current != nil; current = innerDB(current) {
+		if _, ok := current.(*sql.Tx); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func altersSessionState(statementType StatementType, query string) bool {
+	if statementType == LockStatementType || statementType == UnLockStatementType {
+		return true
+	}
+
+	query = strings.ToUpper(strings.TrimSpace(query))
+
+	return strings.HasPrefix(query, "SET ") || strings.HasPrefix(query, "LOCK ") || strings.HasPrefix(query, "PREPARE ")
+}
+
+// interpolatedArgument returns statement argument as sql literal escaped for the dialect, used instead of query
+// parameter in proxy mode
+func interpolatedArgument(dialect Dialect, value interface{}) string {
+	if valuer, ok := value.(driver.Valuer); ok {
+		driverValue, err := valuer.Value()
+
+		if err != nil {
+			panic(fmt.Sprintf("jet: can not interpolate argument: %s", err))
+		}
+
+		value = driverValue
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return interpolatedString(dialect, v)
+	case []byte:
+		return interpolatedBytes(dialect, v)
+	case bool:
+		if dialect.Name() == "SQLServer" { // no boolean literals
+			if v {
+				return "1"
+			}
+			return "0"
+		}
+		return argToString(v)
+	case time.Time:
+		return interpolatedString(dialect, string(pq.FormatTimestamp(v)))
+	case toStringInterface:
+		return interpolatedString(dialect, v.String())
+	default:
+		return argToString(v)
+	}
+}
+
+func interpolatedString(dialect Dialect, value string) string {
+	switch dialect.Name() {
+	case "PostgreSQL":
+		// escape string syntax does not depend on standard_conforming_strings setting
+		if strings.Contains(value, `\`) {
+			return "E'" + strings.NewReplacer(`\`, `\\`, "'", "''").Replace(value) + "'"
+		}
+	case "MySQL", "MariaDB":
+		return "'" + strings.NewReplacer(`\`, `\\`, "'", "''", "\x00", `\0`, "\n", `\n`, "\r", `\r`,
+			"\x1a", `\Z`).Replace(value) + "'"
+	case "SQLServer":
+		return "N" + stringQuote(value)
+	}
+
+	return stringQuote(value)
+}
+
+func interpolatedBytes(dialect Dialect, value []byte) string {
+	switch dialect.Name() {
+	case "PostgreSQL":
+		return "decode('" + hex.EncodeToString(value) + "', 'hex')"
+	case "SQLServer":
+		return "0x" + hex.EncodeToString(value)
+	default:
+		return "X'" + hex.EncodeToString(value) + "'"
+	}
+}
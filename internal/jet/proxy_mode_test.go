@@ -0,0 +1,92 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingDB struct {
+	ctx   context.Context
+	query string
+	args  []interface{}
+}
+
+func (r *recordingDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return r.ExecContext(context.Background(), query, args...)
+}
+
+func (r *recordingDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	r.ctx, r.query, r.args = ctx, query, args
+	return nil, sql.ErrConnDone
+}
+
+func (r *recordingDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return r.QueryContext(context.Background(), query, args...)
+}
+
+func (r *recordingDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	r.ctx, r.query, r.args = ctx, query, args
+	return nil, sql.ErrConnDone
+}
+
+func TestProxyMode(t *testing.T) {
+	db := &recordingDB{}
+	stmt := RawStatement(defaultDialect, "SELECT * FROM table1 WHERE col1 = #col1", map[string]interface{}{"#col1": 11})
+
+	_, err := stmt.Exec(db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, "SELECT * FROM table1 WHERE col1 = $1;\n", db.query)
+	require.Equal(t, []interface{}{11}, db.args)
+
+	_, err = stmt.Exec(ProxyMode(db))
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, "SELECT * FROM table1 WHERE col1 = 11;\n", db.query)
+	require.Empty(t, db.args)
+}
+
+func TestProxyModeInterpolatedArguments(t *testing.T) {
+	injection := `\' OR 1=1 -- `
+
+	testData := []struct {
+		dialect  string
+		expected string
+	}{
+		{"PostgreSQL", `E'\\'' OR 1=1 -- ', decode('00ff', 'hex'), TRUE`},
+		{"MySQL", `'\\'' OR 1=1 -- ', X'00ff', TRUE`},
+		{"SQLite", `'\'' OR 1=1 -- ', X'00ff', TRUE`},
+		{"SQLServer", `N'\'' OR 1=1 -- ', 0x00ff, 1`},
+	}
+
+	for _, data := range testData {
+		dialect := NewDialect(DialectParams{
+			Name: data.dialect,
+			ArgumentPlaceholder: func(int) string {
+				return "?"
+			},
+		})
+		db := &recordingDB{}
+
+		_, err := RawStatement(dialect, "SELECT #str, #bytes, #bool", map[string]interface{}{
+			"#str":   injection,
+			"#bytes": []byte{0, 255},
+			"#bool":  true,
+		}).Exec(ProxyMode(db))
+
+		require.Equal(t, sql.ErrConnDone, err)
+		require.Equal(t, "SELECT "+data.expected+";\n", db.query, data.dialect)
+		require.Empty(t, db.args)
+	}
+
+	require.Equal(t, "'it''s'", interpolatedString(NewDialect(DialectParams{Name: "PostgreSQL"}), "it's"))
+}
+
+func TestProxyModeSessionState(t *testing.T) {
+	db := &recordingDB{}
+
+	_, err := RawStatement(defaultDialect, "SET search_path TO test").Exec(ProxyMode(db))
+	require.EqualError(t, err, "jet: session altering statement is not allowed outside of transaction in proxy mode")
+	require.Empty(t, db.query)
+}
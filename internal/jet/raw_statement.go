@@ -14,6 +14,7 @@ func RawStatement(dialect Dialect, rawQuery string, namedArgument ...map[string]
 			dialect:       dialect,
 			statementType: "",
 			parent:        nil,
+			location:      callerLocation(),
 		},
 		RawQuery: rawQuery,
 	}
@@ -0,0 +1,23 @@
+package jet
+
+import (
+	"context"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// QueryRecordBatches executes statement over db connection/transaction and streams result set into columnar record
+// batches of at most batchSize rows, calling fn for each batch. See qrm.ScanRecordBatches for column type mapping.
+func QueryRecordBatches(ctx context.Context, db qrm.Queryable, statement Statement, batchSize int,
+	fn func(batch *qrm.RecordBatch) error) error {
+
+	rows, err := statement.Rows(ctx, db)
+
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	return qrm.ScanRecordBatches(rows.Rows, batchSize, fn)
+}
@@ -0,0 +1,239 @@
+package jet
+
+// TableReference is a database table referenced by the statement
+type TableReference struct {
+	SchemaName string
+	TableName  string
+	Alias      string
+	// Statement is 0 for tables of the top level statement, or an ordinal number of the sub-query
+	// (or common table expression body) table is referenced in
+	Statement int
+}
+
+// ColumnReference is a table column referenced by the statement
+type ColumnReference struct {
+	// TableName is a table name or a table alias the column is qualified with
+	TableName  string
+	ColumnName string
+	// Type is a jet column type (Bool, Integer, Float, String, Date, Time, Timez, Timestamp or Timestampz),
+	// or an empty string if column type is not known.
+	Type string
+}
+
+// Predicate is a column comparison used in the statement conditions
+type Predicate struct {
+	Column   ColumnReference
+	Operator string
+	// Value is a literal value column is compared with, or nil if column is not compared with a literal
+	Value interface{}
+	// OtherColumn is a column compared with, if column is compared with another column (for instance in join condition)
+	OtherColumn *ColumnReference
+}
+
+// ConjunctFilter is a column compared for equality (= or IN) with literal values in the top level AND conjuncts
+// of statement (or sub-query) WHERE condition
+type ConjunctFilter struct {
+	Column ColumnReference
+	// Statement is 0 for filters of the top level statement, or an ordinal number of the sub-query
+	// (or common table expression body) filter belongs to
+	Statement int
+}
+
+// References is a list of tables and columns referenced by the statement
+type References struct {
+	// StatementType is a type of the top level statement, or of the primary statement of WITH statement
+	StatementType StatementType
+	Tables        []TableReference
+	// Columns are all the columns statement reads or writes
+	Columns []ColumnReference
+	// WrittenColumns are columns statement inserts or updates
+	WrittenColumns []ColumnReference
+	// Filters are columns compared for equality (= or IN) with a non-column expression
+	Filters []ColumnReference
+	// ConjunctFilters are filters every row of the column table satisfies, but only within the statement
+	// (or sub-query) filter belongs to. Sub-query filters do not restrict rows the enclosing statement reads or writes.
+	ConjunctFilters []ConjunctFilter
+	// Functions are names of the functions statement calls
+	Functions []string
+	// Predicates are all the column comparisons statement contains
+	Predicates []Predicate
+	// SubQueries is a number of sub-queries (including common table expression bodies) statement contains
+	SubQueries int
+
+	statement int // ordinal number of the statement currently serialized
+}
+
+// StatementReferences serializes statement using dialect and returns list of all tables and columns statement references.
+// Columns of sub-queries and common table expressions are not included, because they do not reference database tables directly.
+func StatementReferences(dialect Dialect, statement PrintableStatement) References {
+	var statementType StatementType
+
+	switch statementImpl := statement.(type) {
+	case *serializerStatementInterfaceImpl:
+		statementType = statementImpl.statementType
+		statement = statementImpl.parent // statement passed to logger functions
+	case *withImpl:
+		statementType = statementImpl.statementType
+	}
+
+	serializer, ok := statement.(Serializer)
+
+	if !ok {
+		panic("jet: statement references are not supported for this statement type")
+	}
+
+	out := &SQLBuilder{Dialect: dialect, references: &References{}}
+
+	serializer.serialize(statementType, out, NoWrap)
+
+	return *out.references
+}
+
+// addStatement registers statement and makes it current statement. Returned function restores enclosing statement.
+func (r *References) addStatement(statementType StatementType, topLevel bool) (restore func()) {
+	enclosingStatement := r.statement
+
+	if topLevel {
+		r.StatementType = statementType
+		r.statement = 0
+	} else {
+		r.SubQueries++
+		r.statement = r.SubQueries
+	}
+
+	return func() { r.statement = enclosingStatement }
+}
+
+func (r *References) addTable(table *tableImpl) {
+	r.Tables = append(r.Tables, TableReference{
+		SchemaName: table.schemaName,
+		TableName:  table.name,
+		Alias:      table.alias,
+		Statement:  r.statement,
+	})
+}
+
+func (r *References) addColumn(column Column) {
+	r.Columns = append(r.Columns, newColumnReference(column))
+}
+
+func (r *References) addWrittenColumn(column Column) {
+	if columnList, ok := column.(ColumnList); ok {
+		for _, c := range columnList {
+			r.addWrittenColumn(c)
+		}
+		return
+	}
+
+	r.WrittenColumns = append(r.WrittenColumns, newColumnReference(column))
+}
+
+func (r *References) addPredicate(lhs, rhs Serializer, operator string) {
+	column, ok := lhs.(Column)
+
+	if !ok {
+		return
+	}
+
+	predicate := Predicate{
+		Column:   newColumnReference(column),
+		Operator: operator,
+	}
+
+	if otherColumn, ok := rhs.(Column); ok {
+		otherColumnReference := newColumnReference(otherColumn)
+		predicate.OtherColumn = &otherColumnReference
+	} else if literal, ok := rhs.(LiteralExpression); ok {
+		predicate.Value = literal.Value()
+	}
+
+	r.Predicates = append(r.Predicates, predicate)
+
+	if predicate.OtherColumn == nil && (operator == "=" || operator == "IN") {
+		r.Filters = append(r.Filters, predicate.Column)
+	}
+}
+
+func (r *References) addConjunctFilters(condition Serializer) {
+	switch expression := condition.(type) {
+	case *boolExpressionWrapper:
+		r.addConjunctFilters(expression.Expression)
+	case *complexExpression:
+		r.addConjunctFilters(expression.expressions)
+	case *expressionListOperator:
+		if expression.operator == "AND" {
+			for _, conjunct := range expression.expressions {
+				r.addConjunctFilters(conjunct)
+			}
+		}
+	case *binaryOperatorExpression:
+		switch expression.operator {
+		case "AND":
+			r.addConjunctFilters(expression.lhs)
+			r.addConjunctFilters(expression.rhs)
+		case "=", "IN":
+			column, ok := expression.lhs.(Column)
+
+			if ok && isLiteralValue(expression.rhs) {
+				r.ConjunctFilters = append(r.ConjunctFilters, ConjunctFilter{
+					Column:    newColumnReference(column),
+					Statement: r.statement,
+				})
+			}
+		}
+	}
+}
+
+// isLiteralValue returns true if expression is a literal, or a list of literals
+func isLiteralValue(expression Serializer) bool {
+	switch value := expression.(type) {
+	case LiteralExpression:
+		return true
+	case *wrap:
+		for _, element := range value.expressions {
+			if !isLiteralValue(element) {
+				return false
+			}
+		}
+		return len(value.expressions) > 0
+	}
+
+	return false
+}
+
+func (r *References) addFunction(name string) {
+	r.Functions = append(r.Functions, name)
+}
+
+func newColumnReference(column Column) ColumnReference {
+	return ColumnReference{
+		TableName:  column.TableName(),
+		ColumnName: column.Name(),
+		Type:       columnTypeName(column),
+	}
+}
+
+func columnTypeName(column interface{}) string {
+	switch column.(type) {
+	case ColumnBool:
+		return "Bool"
+	case ColumnInteger:
+		return "Integer"
+	case ColumnFloat:
+		return "Float"
+	case ColumnString:
+		return "String"
+	case ColumnDate:
+		return "Date"
+	case ColumnTime:
+		return "Time"
+	case ColumnTimez:
+		return "Timez"
+	case ColumnTimestamp:
+		return "Timestamp"
+	case ColumnTimestampz:
+		return "Timestampz"
+	}
+
+	return ""
+}
@@ -0,0 +1,51 @@
+package jet
+
+// RelativeInterval is interval of quantity date or time units (YEAR, MONTH, WEEK, DAY, HOUR, MINUTE or SECOND),
+// used for date arithmetic in dialects without interval type. Dialect serializes ADD and SUB of relative interval
+// with dialect date function (for instance DATETIME or DATEADD), using RelativeIntervalOperator override.
+type RelativeInterval struct {
+	IsIntervalImpl
+
+	Quantity int64
+	Unit     string
+}
+
+// NewRelativeInterval creates new relative interval of quantity units
+func NewRelativeInterval(quantity int64, unit string) *RelativeInterval {
+	return &RelativeInterval{Quantity: quantity, Unit: unit}
+}
+
+func (r *RelativeInterval) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	panic("jet: " + out.Dialect.Name() + " interval can only be added to or subtracted from date and time expressions")
+}
+
+// RelativeIntervalOperator returns '+' or '-' operator serialize override, which serializes date arithmetic with
+// relative interval using dateArithmetic. Interval passed to dateArithmetic has negative quantity for '-' operator.
+// Operands of other types are serialized as regular operator.
+func RelativeIntervalOperator(operator string, dateArithmetic func(date Expression, interval RelativeInterval) Serializer) SerializeOverride {
+	return func(expressions ...Serializer) SerializerFunc {
+		return func(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+			if len(expressions) < 2 {
+				panic("jet: invalid number of expressions for operator " + operator)
+			}
+
+			date, isDate := expressions[0].(Expression)
+			interval, isInterval := expressions[1].(*RelativeInterval)
+
+			if !isDate || !isInterval {
+				expressions[0].serialize(statement, out, options...)
+				out.WriteString(operator)
+				expressions[1].serialize(statement, out, options...)
+				return
+			}
+
+			relative := *interval
+
+			if operator == "-" {
+				relative.Quantity = -relative.Quantity
+			}
+
+			dateArithmetic(date, relative).serialize(statement, out, options...)
+		}
+	}
+}
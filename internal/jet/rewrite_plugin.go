@@ -0,0 +1,120 @@
+package jet
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// RewritePlugin transforms statement clauses before statement serialization. Plugins are the general mechanism
+// for features like multi tenancy (injecting tenant predicate into WHERE clauses), soft delete, auditing, adding
+// optimizer hints or rewriting tables to views. Plugins are applied to top level statements as well as to sub-queries.
+type RewritePlugin struct {
+	// Name identifies the plugin. Registering a plugin with the name of already registered plugin replaces it.
+	Name string
+	// Order of plugin execution. Plugins with lower order are executed first, and each plugin receives clauses
+	// returned by the previous one. Plugins with the same order are executed in registration order.
+	Order int
+	// Rewrite returns transformed statement clauses (for instance *ClauseSelect, *ClauseFrom, *ClauseWhere).
+	// Clauses belong to the statement being serialized, so they must not be modified in place. To change a clause,
+	// plugin has to return a modified copy.
+	// Context is the statement execution context, or context.Background() for Sql() and DebugSql() methods.
+	Rewrite func(ctx context.Context, statementType StatementType, clauses []Clause) []Clause
+}
+
+// registered plugins, keyed by dialect name. Map is replaced on each registration, so it can be read without locking.
+var rewritePlugins atomic.Value
+var rewritePluginsMutex sync.Mutex
+
+func init() {
+	rewritePlugins.Store(map[string][]RewritePlugin{})
+}
+
+// RegisterRewritePlugin registers statement rewrite plugin for the dialect
+func RegisterRewritePlugin(dialect Dialect, plugin RewritePlugin) {
+	if plugin.Name == "" {
+		panic("jet: rewrite plugin name is empty")
+	}
+
+	if plugin.Rewrite == nil {
+		panic("jet: rewrite plugin " + plugin.Name + " Rewrite function is nil")
+	}
+
+	updateRewritePlugins(dialect, func(plugins []RewritePlugin) []RewritePlugin {
+		plugins = removeRewritePlugin(plugins, plugin.Name)
+		plugins = append(plugins, plugin)
+
+		sort.SliceStable(plugins, func(i, j int) bool {
+			return plugins[i].Order < plugins[j].Order
+		})
+
+		return plugins
+	})
+}
+
+// UnregisterRewritePlugin removes statement rewrite plugin with the name from the dialect plugins
+func UnregisterRewritePlugin(dialect Dialect, name string) {
+	updateRewritePlugins(dialect, func(plugins []RewritePlugin) []RewritePlugin {
+		return removeRewritePlugin(plugins, name)
+	})
+}
+
+func updateRewritePlugins(dialect Dialect, update func(plugins []RewritePlugin) []RewritePlugin) {
+	rewritePluginsMutex.Lock()
+	defer rewritePluginsMutex.Unlock()
+
+	current := rewritePlugins.Load().(map[string][]RewritePlugin)
+	updated := make(map[string][]RewritePlugin, len(current)+1)
+
+	for dialectName, plugins := range current {
+		updated[dialectName] = plugins
+	}
+
+	plugins := update(append([]RewritePlugin{}, current[dialect.Name()]...))
+
+	if len(plugins) == 0 {
+		delete(updated, dialect.Name())
+	} else {
+		updated[dialect.Name()] = plugins
+	}
+
+	rewritePlugins.Store(updated)
+}
+
+func removeRewritePlugin(plugins []RewritePlugin, name string) []RewritePlugin {
+	var ret []RewritePlugin
+
+	for _, plugin := range plugins {
+		if plugin.Name != name {
+			ret = append(ret, plugin)
+		}
+	}
+
+	return ret
+}
+
+// rewriteClauses returns statement clauses transformed by the dialect rewrite plugins
+func rewriteClauses(out *SQLBuilder, statementType StatementType, clauses []Clause) []Clause {
+	if out.Dialect == nil {
+		return clauses
+	}
+
+	plugins := rewritePlugins.Load().(map[string][]RewritePlugin)[out.Dialect.Name()]
+
+	if len(plugins) == 0 {
+		return clauses
+	}
+
+	ctx := out.ctx
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for _, plugin := range plugins {
+		clauses = plugin.Rewrite(ctx, statementType, clauses)
+	}
+
+	return clauses
+}
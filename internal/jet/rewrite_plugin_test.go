@@ -0,0 +1,119 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type tenantKey struct{}
+
+// tenantPlugin adds tenant predicate to WHERE clause of SELECT statements
+var tenantPlugin = RewritePlugin{
+	Name:  "tenant",
+	Order: 10,
+	Rewrite: func(ctx context.Context, statementType StatementType, clauses []Clause) []Clause {
+		tenantID, ok := ctx.Value(tenantKey{}).(int64)
+
+		if !ok || statementType != SelectStatementType {
+			return clauses
+		}
+
+		ret := make([]Clause, 0, len(clauses))
+
+		for _, clause := range clauses {
+			if where, ok := clause.(*ClauseWhere); ok {
+				newWhere := *where
+				newWhere.Condition = table1ColInt.EQ(Int(tenantID))
+
+				if where.Condition != nil {
+					newWhere.Condition = where.Condition.AND(newWhere.Condition)
+				}
+
+				clause = &newWhere
+			}
+
+			ret = append(ret, clause)
+		}
+
+		return ret
+	},
+}
+
+func TestRewritePlugin(t *testing.T) {
+	RegisterRewritePlugin(defaultDialect, tenantPlugin)
+	defer UnregisterRewritePlugin(defaultDialect, tenantPlugin.Name)
+
+	where := &ClauseWhere{Condition: table1ColBool.IS_TRUE()}
+
+	stmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1Col1}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+		where,
+	)
+
+	db := &recordingDB{}
+	tenantCtx := context.WithValue(context.Background(), tenantKey{}, int64(7))
+
+	_, err := stmt.ExecContext(tenantCtx, db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, `
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+WHERE table1.col_bool IS TRUE AND (table1.col_int = $1);
+`, db.query)
+	require.Equal(t, []interface{}{int64(7)}, db.args)
+
+	// statement clauses are not modified
+	require.Equal(t, `
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+WHERE table1.col_bool IS TRUE;
+`, stmt.DebugSql())
+	require.Equal(t, table1ColBool.IS_TRUE(), where.Condition)
+}
+
+func TestRewritePluginOrder(t *testing.T) {
+	var called []string
+
+	plugin := func(name string, order int) RewritePlugin {
+		return RewritePlugin{
+			Name:  name,
+			Order: order,
+			Rewrite: func(ctx context.Context, statementType StatementType, clauses []Clause) []Clause {
+				called = append(called, name)
+				return clauses
+			},
+		}
+	}
+
+	RegisterRewritePlugin(defaultDialect, plugin("second", 2))
+	RegisterRewritePlugin(defaultDialect, plugin("first", 1))
+	RegisterRewritePlugin(defaultDialect, plugin("third", 2))
+	RegisterRewritePlugin(defaultDialect, plugin("first", 0)) // replaced
+	defer func() {
+		UnregisterRewritePlugin(defaultDialect, "first")
+		UnregisterRewritePlugin(defaultDialect, "second")
+		UnregisterRewritePlugin(defaultDialect, "third")
+	}()
+
+	newTestStatement(SelectStatementType, &ClauseSelect{ProjectionList: []Projection{table1Col1}}).Sql()
+	require.Equal(t, []string{"first", "second", "third"}, called)
+
+	UnregisterRewritePlugin(defaultDialect, "second")
+	called = nil
+
+	newTestStatement(SelectStatementType, &ClauseSelect{ProjectionList: []Projection{table1Col1}}).Sql()
+	require.Equal(t, []string{"first", "third"}, called)
+}
+
+func TestRewritePluginInvalid(t *testing.T) {
+	require.PanicsWithValue(t, "jet: rewrite plugin name is empty", func() {
+		RegisterRewritePlugin(defaultDialect, RewritePlugin{})
+	})
+	require.PanicsWithValue(t, "jet: rewrite plugin tenant Rewrite function is nil", func() {
+		RegisterRewritePlugin(defaultDialect, RewritePlugin{Name: "tenant"})
+	})
+}
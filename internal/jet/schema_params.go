@@ -0,0 +1,79 @@
+package jet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type schemaParamsKey struct{}
+
+// WithSchemaParams returns new context with values of schema name placeholders. Schema name of a table or view may
+// contain placeholders in the form "{{name}}" (for instance "{{tenant}}" or "app_{{tenant}}"), which are replaced
+// with parameter values when statement is executed with this context. Useful for schema-per-tenant databases, where
+// the same generated tables are used for each tenant schema. Parameters of parent context are preserved, unless
+// overwritten.
+func WithSchemaParams(ctx context.Context, params map[string]string) context.Context {
+	parentParams, _ := ctx.Value(schemaParamsKey{}).(map[string]string)
+
+	merged := make(map[string]string, len(parentParams)+len(params))
+
+	for name, value := range parentParams {
+		merged[name] = value
+	}
+
+	for name, value := range params {
+		merged[name] = value
+	}
+
+	return context.WithValue(ctx, schemaParamsKey{}, merged)
+}
+
+// resolveSchemaName replaces placeholders in schema name with parameter values from execution context. Outside of
+// statement execution (Sql, DebugSql) placeholders are left as is.
+func (s *SQLBuilder) resolveSchemaName(schemaName string) string {
+	if s.ctx == nil || !strings.Contains(schemaName, "{{") {
+		return schemaName
+	}
+
+	params, _ := s.ctx.Value(schemaParamsKey{}).(map[string]string)
+
+	var resolved strings.Builder
+	rest := schemaName
+
+	for {
+		start := strings.Index(rest, "{{")
+		end := strings.Index(rest, "}}")
+
+		if start < 0 || end < start {
+			break
+		}
+
+		name := rest[start+2 : end]
+		value, ok := params[name]
+
+		if !ok {
+			s.setSchemaParamsErr(fmt.Errorf("jet: schema name parameter %q is not set", name))
+			return schemaName
+		}
+
+		if value == "" || strings.ContainsAny(value, "\"`[]\x00") {
+			s.setSchemaParamsErr(fmt.Errorf("jet: invalid value %q of schema name parameter %q", value, name))
+			return schemaName
+		}
+
+		resolved.WriteString(rest[:start])
+		resolved.WriteString(value)
+		rest = rest[end+2:]
+	}
+
+	resolved.WriteString(rest)
+
+	return resolved.String()
+}
+
+func (s *SQLBuilder) setSchemaParamsErr(err error) {
+	if s.schemaParamsErr == nil {
+		s.schemaParamsErr = err
+	}
+}
@@ -0,0 +1,54 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithSchemaParams(t *testing.T) {
+	usersID := IntegerColumn("id")
+	users := NewTable("{{tenant}}", "users", "", usersID)
+	auditUserID := IntegerColumn("user_id")
+	audit := NewTable("{{tenant}}_audit", "log", "", auditUserID)
+
+	stmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{usersID}},
+		&ClauseFrom{Tables: []Serializer{NewJoinTable(users, audit, InnerJoin, usersID.EQ(auditUserID))}},
+	)
+
+	db := &recordingDB{}
+	ctx := WithSchemaParams(context.Background(), map[string]string{"tenant": "acme"})
+
+	_, err := stmt.ExecContext(ctx, db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, `
+SELECT users.id AS "users.id"
+FROM acme.users
+     INNER JOIN acme_audit.log ON (users.id = log.user_id);
+`, db.query)
+
+	_, err = stmt.ExecContext(WithSchemaParams(ctx, map[string]string{"tenant": "Globex"}), db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Contains(t, db.query, `FROM "Globex".users`)
+	require.Contains(t, db.query, `INNER JOIN "Globex_audit".log`)
+
+	require.Contains(t, stmt.DebugSql(), `FROM "{{tenant}}".users`)
+}
+
+func TestWithSchemaParamsErrors(t *testing.T) {
+	stmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1Col1}},
+		&ClauseFrom{Tables: []Serializer{NewTable("{{tenant}}", "table1", "", table1Col1)}},
+	)
+
+	db := &recordingDB{}
+
+	_, err := stmt.ExecContext(context.Background(), db)
+	require.EqualError(t, err, `jet: schema name parameter "tenant" is not set`)
+
+	_, err = stmt.ExecContext(WithSchemaParams(context.Background(), map[string]string{"tenant": `acme"; DROP`}), db)
+	require.EqualError(t, err, `jet: invalid value "acme\"; DROP" of schema name parameter "tenant"`)
+}
@@ -0,0 +1,57 @@
+package jet
+
+// SelectClauses is a set of SELECT statement clauses, which can be constructed separately from the statement. Large
+// applications can split query construction across layers, for instance repository layer constructs base clauses,
+// service layer merges additional filters and sorting, and the result is applied to the statement.
+type SelectClauses struct {
+	Projections []Projection
+	Where       BoolExpression
+	GroupBy     []GroupByClause
+	Having      BoolExpression
+	OrderBy     []OrderByClause
+}
+
+// Merge returns new clauses with other projections, group by and order by lists appended, and other where and having
+// conditions joined with AND.
+func (c SelectClauses) Merge(other SelectClauses) SelectClauses {
+	return SelectClauses{
+		Projections: append(append([]Projection{}, c.Projections...), other.Projections...),
+		Where:       andConditions(c.Where, other.Where),
+		GroupBy:     append(append([]GroupByClause{}, c.GroupBy...), other.GroupBy...),
+		Having:      andConditions(c.Having, other.Having),
+		OrderBy:     append(append([]OrderByClause{}, c.OrderBy...), other.OrderBy...),
+	}
+}
+
+// ApplyTo appends clauses to the statement SELECT, WHERE, GROUP BY, HAVING and ORDER BY clauses
+func (c SelectClauses) ApplyTo(selectClause *ClauseSelect, where *ClauseWhere, groupBy *ClauseGroupBy,
+	having *ClauseHaving, orderBy *ClauseOrderBy) {
+
+	selectClause.ProjectionList = append(selectClause.ProjectionList, c.Projections...)
+	where.And(c.Where)
+	groupBy.List = append(groupBy.List, c.GroupBy...)
+	having.And(c.Having)
+	orderBy.List = append(orderBy.List, c.OrderBy...)
+}
+
+// And joins clause condition with condition using AND operator. Nil condition is ignored.
+func (c *ClauseWhere) And(condition BoolExpression) {
+	c.Condition = andConditions(c.Condition, condition)
+}
+
+// And joins clause condition with condition using AND operator. Nil condition is ignored.
+func (c *ClauseHaving) And(condition BoolExpression) {
+	c.Condition = andConditions(c.Condition, condition)
+}
+
+func andConditions(lhs, rhs BoolExpression) BoolExpression {
+	if lhs == nil {
+		return rhs
+	}
+
+	if rhs == nil {
+		return lhs
+	}
+
+	return lhs.AND(rhs)
+}
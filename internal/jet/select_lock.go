@@ -4,12 +4,14 @@ package jet
 type RowLock interface {
 	Serializer
 
+	OF(tables ...Table) RowLock
 	NOWAIT() RowLock
 	SKIP_LOCKED() RowLock
 }
 
 type selectLockImpl struct {
 	lockStrength       string
+	of                 []Table
 	noWait, skipLocked bool
 }
 
@@ -24,6 +26,12 @@ func newSelectLock(lockStrength string) RowLock {
 	return &selectLockImpl{lockStrength: lockStrength}
 }
 
+// OF limits row lock to the rows of the tables, for instance to the rows of one of the joined tables
+func (s *selectLockImpl) OF(tables ...Table) RowLock {
+	s.of = tables
+	return s
+}
+
 func (s *selectLockImpl) NOWAIT() RowLock {
 	s.noWait = true
 	return s
@@ -35,13 +43,42 @@ func (s *selectLockImpl) SKIP_LOCKED() RowLock {
 }
 
 func (s *selectLockImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
-	out.WriteString(s.lockStrength)
+	if s.lockStrength == "SHARE" && out.Dialect != nil && !out.Dialect.Supports(FeatureLockingReadOptions) && len(s.of) == 0 {
+		// older MySQL versions syntax for shared lock
+		out.WriteString("LOCK IN SHARE MODE")
+	} else {
+		out.WriteString("FOR")
+		out.WriteString(s.lockStrength)
+	}
+
+	if len(s.of) > 0 {
+		out.RequireFeature(FeatureLockingReadOptions)
+		out.WriteString("OF")
+
+		for i, table := range s.of {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+
+			if table == nil {
+				panic("jet: nil table in row lock OF list")
+			}
+
+			if table.Alias() != "" {
+				out.WriteIdentifier(table.Alias())
+			} else {
+				out.WriteIdentifier(table.TableName())
+			}
+		}
+	}
 
 	if s.noWait {
+		out.RequireFeature(FeatureNoWait)
 		out.WriteString("NOWAIT")
 	}
 
 	if s.skipLocked {
+		out.RequireFeature(FeatureSkipLocked)
 		out.WriteString("SKIP LOCKED")
 	}
 }
@@ -54,9 +54,94 @@ func NewLateral(selectStmt SerializerStatement, alias string) SelectTable {
 }
 
 func (s lateralImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.RequireFeature(FeatureLateral)
 	out.WriteString("LATERAL")
 	s.Statement.serialize(statement, out)
 
 	out.WriteString("AS")
 	out.WriteIdentifier(s.alias)
 }
+
+// --------------------------------------
+
+// FunctionTable is interface for set returning function calls used as FROM items
+type FunctionTable interface {
+	Serializer
+	Alias() string
+	AllColumns() ProjectionList
+}
+
+type functionTableImpl struct {
+	function Serializer
+	alias    string
+	columns  []ColumnExpression
+	lateral  bool
+}
+
+// NewFunctionTable creates new FROM item from set returning function call with alias. Columns, if any, name the
+// function result columns, and are bound to the function table alias.
+func NewFunctionTable(function Serializer, alias string, columns ...ColumnExpression) FunctionTable {
+	return newFunctionTable(function, alias, false, columns)
+}
+
+// NewLateralFunctionTable creates new FROM item from set returning function call, which can reference columns of
+// preceding FROM items.
+func NewLateralFunctionTable(function Serializer, alias string, columns ...ColumnExpression) FunctionTable {
+	return newFunctionTable(function, alias, true, columns)
+}
+
+func newFunctionTable(function Serializer, alias string, lateral bool, columns []ColumnExpression) FunctionTable {
+	if alias == "" {
+		panic("jet: function table alias is empty")
+	}
+
+	for _, column := range columns {
+		column.setTableName(alias)
+	}
+
+	return &functionTableImpl{
+		function: function,
+		alias:    alias,
+		columns:  columns,
+		lateral:  lateral,
+	}
+}
+
+func (f *functionTableImpl) Alias() string {
+	return f.alias
+}
+
+func (f *functionTableImpl) AllColumns() ProjectionList {
+	var ret ProjectionList
+
+	for _, column := range f.columns {
+		ret = append(ret, column)
+	}
+
+	return ret
+}
+
+func (f *functionTableImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if f.lateral {
+		out.RequireFeature(FeatureLateral)
+		out.WriteString("LATERAL")
+	}
+
+	f.function.serialize(statement, out, FallTrough(options)...)
+
+	out.WriteString("AS")
+	out.WriteIdentifier(f.alias)
+
+	if len(f.columns) == 0 {
+		return
+	}
+
+	out.WriteByte('(')
+	for i, column := range f.columns {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		out.WriteIdentifier(column.Name())
+	}
+	out.WriteByte(')')
+}
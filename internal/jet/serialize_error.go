@@ -0,0 +1,54 @@
+package jet
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SerializeError is returned by statement execution methods (Query, Exec, Rows...), instead of panic, when statement
+// can not be serialized into sql query, for instance because of nil expression, empty list or invalid clause
+// combination. Set Config.PanicOnSerializeError to panic instead, as in previous versions. Sql and DebugSql still
+// panic, SqlE and DebugSqlE return SerializeError instead.
+type SerializeError struct {
+	Message string
+}
+
+func (e *SerializeError) Error() string {
+	return e.Message
+}
+
+// newSerializeError converts value recovered from serialization panic into SerializeError
+func newSerializeError(recovered interface{}) *SerializeError {
+	var message string
+
+	switch value := recovered.(type) {
+	case *SerializeError:
+		return value
+	case error:
+		message = value.Error()
+	default:
+		message = fmt.Sprint(value)
+	}
+
+	if !strings.HasPrefix(message, "jet: ") {
+		message = "jet: failed to serialize statement, " + message
+	}
+
+	return &SerializeError{Message: message}
+}
+
+// serializeStatement serializes statement into out. Serialization panics are returned as SerializeError, unless
+// panicOnError is set.
+func serializeStatement(statement *serializerStatementInterfaceImpl, out *SQLBuilder, panicOnError bool) (err error) {
+	if !panicOnError {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = newSerializeError(recovered)
+			}
+		}()
+	}
+
+	statement.parent.serialize(statement.statementType, out, NoWrap)
+
+	return nil
+}
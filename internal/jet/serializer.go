@@ -32,6 +32,20 @@ const (
 	LockStatementType   StatementType = "LOCK"
 	UnLockStatementType StatementType = "UNLOCK"
 	WithStatementType   StatementType = "WITH"
+
+	PrepareStatementType    StatementType = "PREPARE"
+	ExecuteStatementType    StatementType = "EXECUTE"
+	DeallocateStatementType StatementType = "DEALLOCATE"
+
+	SetConstraintsStatementType StatementType = "SET CONSTRAINTS"
+
+	PrepareTransactionStatementType StatementType = "PREPARE TRANSACTION"
+	CommitPreparedStatementType     StatementType = "COMMIT PREPARED"
+	RollbackPreparedStatementType   StatementType = "ROLLBACK PREPARED"
+
+	DeclareCursorStatementType StatementType = "DECLARE CURSOR"
+	FetchStatementType         StatementType = "FETCH"
+	CloseCursorStatementType   StatementType = "CLOSE"
 )
 
 // Serializer interface
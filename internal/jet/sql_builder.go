@@ -2,6 +2,7 @@ package jet
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/go-jet/jet/v2/internal/3rdparty/pq"
 	"github.com/go-jet/jet/v2/internal/utils"
@@ -23,7 +24,29 @@ type SQLBuilder struct {
 	lastChar byte
 	ident    int
 
+	references *References
+
+	columnAccess      func(column Column) bool
+	deniedColumns     []string
+	readDeniedColumns []string
+	projectionsDenied bool
+
+	tainted      []string
+	injectionErr error
+
+	schemaParamsErr error
+
+	statementDepth int
+	grouping       *groupingScan // set only while looking for non aggregated projections
+	safetyLimit    int64         // applied to top level SELECT statements, if positive
+	pageWindow     *pageWindowDB // applied to top level SELECT statement LIMIT and OFFSET, if set
+
+	ctx context.Context // statement execution context, passed to rewrite plugins
+
 	Debug bool
+
+	fingerprint bool // parametrized arguments are replaced with '?', and not added to the argument list
+	interpolate bool // parametrized arguments are interpolated as escaped literals (proxy mode)
 }
 
 const tabSize = 4
@@ -55,6 +78,10 @@ func (s *SQLBuilder) DecreaseIdent(ident ...int) {
 
 // WriteProjections func
 func (s *SQLBuilder) WriteProjections(statement StatementType, projections []Projection) {
+	if !s.canReadAny(projections) {
+		s.projectionsDenied = true
+	}
+
 	s.IncreaseIdent()
 	SerializeProjectionList(statement, projections, s)
 	s.DecreaseIdent()
@@ -80,17 +107,17 @@ func (s *SQLBuilder) write(data []byte) {
 }
 
 func isPreSeparator(b byte) bool {
-	return b == ' ' || b == '.' || b == ',' || b == '(' || b == '\n' || b == ':'
+	return b == ' ' || b == '.' || b == ',' || b == '(' || b == '[' || b == '\n' || b == ':'
 }
 
 func isPostSeparator(b byte) bool {
-	return b == ' ' || b == '.' || b == ',' || b == ')' || b == '\n' || b == ':'
+	return b == ' ' || b == '.' || b == ',' || b == ')' || b == ']' || b == '\n' || b == ':'
 }
 
 // WriteAlias is used to add alias to output SQL
 func (s *SQLBuilder) WriteAlias(str string) {
-	aliasQuoteChar := string(s.Dialect.AliasQuoteChar())
-	s.WriteString(aliasQuoteChar + str + aliasQuoteChar)
+	aliasQuoteChar := s.Dialect.AliasQuoteChar()
+	s.WriteString(string(aliasQuoteChar) + str + string(closingQuoteChar(aliasQuoteChar)))
 }
 
 // WriteString writes sting to output SQL
@@ -101,15 +128,53 @@ func (s *SQLBuilder) WriteString(str string) {
 // WriteIdentifier adds identifier to output SQL
 func (s *SQLBuilder) WriteIdentifier(name string, alwaysQuote ...bool) {
 	if s.shouldQuote(name, alwaysQuote...) {
-		identQuoteChar := string(s.Dialect.IdentifierQuoteChar())
-		s.WriteString(identQuoteChar + name + identQuoteChar)
+		identQuoteChar := s.Dialect.IdentifierQuoteChar()
+		s.WriteString(string(identQuoteChar) + name + string(closingQuoteChar(identQuoteChar)))
 	} else {
 		s.WriteString(name)
 	}
 }
 
+// closingQuoteChar returns character closing quoted identifier, which differs from opening character only for
+// bracket quoted (SQL Server) identifiers.
+func closingQuoteChar(quoteChar byte) byte {
+	if quoteChar == '[' {
+		return ']'
+	}
+
+	return quoteChar
+}
+
 func (s *SQLBuilder) shouldQuote(name string, alwaysQuote ...bool) bool {
-	return s.Dialect.IsReservedWord(name) || shouldQuoteIdentifier(name) || len(alwaysQuote) > 0
+	if len(alwaysQuote) > 0 {
+		return true
+	}
+
+	if quote, ok := identifierQuotingOverride(s.Dialect, name); ok {
+		return quote
+	}
+
+	return s.Dialect.IsReservedWord(name) || shouldQuoteIdentifier(name)
+}
+
+// writeColumnName writes column name to output SQL
+func (s *SQLBuilder) writeColumnName(column Column) {
+	s.columnWritten(column)
+
+	if s.references != nil {
+		s.references.addColumn(column)
+	}
+
+	s.WriteIdentifier(column.Name())
+}
+
+// columnWritten checks write access to the column and records it as written by the statement
+func (s *SQLBuilder) columnWritten(column Column) {
+	s.checkWriteAccess(column)
+
+	if s.references != nil {
+		s.references.addWrittenColumn(column)
+	}
 }
 
 // WriteByte writes byte to output SQL
@@ -122,22 +187,41 @@ func (s *SQLBuilder) finalize() (string, []interface{}) {
 }
 
 func (s *SQLBuilder) insertConstantArgument(arg interface{}) {
-	s.WriteString(argToString(arg))
+	if boolArg, ok := arg.(bool); ok && s.Dialect != nil && !s.Dialect.Supports(FeatureBoolLiterals) {
+		arg = 0 // bool constants are written as BIT values
+		if boolArg {
+			arg = 1
+		}
+	}
+
+	s.WriteString(argToString(bindValue(s.Dialect, arg)))
 }
 
 func (s *SQLBuilder) insertParametrizedArgument(arg interface{}) {
+	if s.fingerprint {
+		s.WriteString("?")
+		return
+	}
+
 	if s.Debug {
 		s.insertConstantArgument(arg)
 		return
 	}
 
-	s.Args = append(s.Args, arg)
+	if s.interpolate {
+		s.WriteString(interpolatedArgument(s.Dialect, bindValue(s.Dialect, arg)))
+		return
+	}
+
+	s.Args = append(s.Args, bindValue(s.Dialect, arg))
 	argPlaceholder := s.Dialect.ArgumentPlaceholder()(len(s.Args))
 
 	s.WriteString(argPlaceholder)
 }
 
 func (s *SQLBuilder) insertRawQuery(raw string, namedArg map[string]interface{}) {
+	s.auditRawFragment(raw)
+
 	type namedArgumentPosition struct {
 		Name     string
 		Value    interface{}
@@ -184,6 +268,12 @@ func (s *SQLBuilder) insertRawQuery(raw string, namedArg map[string]interface{})
 		if !strings.Contains(raw, namedArgumentPos.Name) {
 			continue
 		}
+
+		if s.interpolate {
+			raw = strings.Replace(raw, namedArgumentPos.Name, interpolatedArgument(s.Dialect, namedArgumentPos.Value), -1)
+			continue
+		}
+
 		s.Args = append(s.Args, namedArgumentPos.Value)
 		currentArgNum := len(s.Args)
 
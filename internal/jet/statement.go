@@ -3,6 +3,7 @@ package jet
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"github.com/go-jet/jet/v2/qrm"
 	"time"
 )
@@ -10,24 +11,43 @@ import (
 //Statement is common interface for all statements(SELECT, INSERT, UPDATE, DELETE, LOCK)
 type Statement interface {
 	// Sql returns parametrized sql query with list of arguments.
+	// Sql panics if statement can not be serialized, because its signature can not return an error without breaking
+	// existing code. Use SqlE to get SerializeError instead.
 	Sql() (query string, args []interface{})
 	// DebugSql returns debug query where every parametrized placeholder is replaced with its argument.
 	// Do not use it in production. Use it only for debug purposes.
+	// DebugSql panics if statement can not be serialized. Use DebugSqlE to get SerializeError instead.
 	DebugSql() (query string)
+	// SqlE is the same as Sql, except that it returns SerializeError instead of panic, if statement can not be
+	// serialized (for instance because of nil expression or empty column list). Useful for statements assembled
+	// dynamically, for instance in request handlers. Statement execution methods (Query, Exec, Rows...) already
+	// return SerializeError instead of panic.
+	SqlE() (query string, args []interface{}, err error)
+	// DebugSqlE is the same as DebugSql, except that it returns SerializeError instead of panic.
+	DebugSqlE() (query string, err error)
+	// Fingerprint returns stable hash of the statement shape, where parametrized literal values, number of IN list
+	// elements and number of inserted rows are ignored. Useful for metrics aggregation and query logging.
+	Fingerprint() string
 	// Query executes statement over database connection/transaction db and stores row result in destination.
 	// Destination can be either pointer to struct or pointer to a slice.
 	// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
-	Query(db qrm.DB, destination interface{}) error
+	Query(db qrm.Queryable, destination interface{}) error
 	// QueryContext executes statement with a context over database connection/transaction db and stores row result in destination.
 	// Destination can be either pointer to struct or pointer to a slice.
 	// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
-	QueryContext(ctx context.Context, db qrm.DB, destination interface{}) error
+	QueryContext(ctx context.Context, db qrm.Queryable, destination interface{}) error
+	// QueryFast is the same as Query, except that destination models generated with scanner option are scanned
+	// without reflection, if statement projection list matches model columns (for instance table.AllColumns).
+	QueryFast(db qrm.Queryable, destination interface{}) error
+	// QueryFastContext is the same as QueryContext, except that destination models generated with scanner option
+	// are scanned without reflection, if statement projection list matches model columns.
+	QueryFastContext(ctx context.Context, db qrm.Queryable, destination interface{}) error
 	// Exec executes statement over db connection/transaction without returning any rows.
-	Exec(db qrm.DB) (sql.Result, error)
+	Exec(db qrm.Executable) (sql.Result, error)
 	// ExecContext executes statement with context over db connection/transaction without returning any rows.
-	ExecContext(ctx context.Context, db qrm.DB) (sql.Result, error)
+	ExecContext(ctx context.Context, db qrm.Executable) (sql.Result, error)
 	// Rows executes statements over db connection/transaction and returns rows
-	Rows(ctx context.Context, db qrm.DB) (*Rows, error)
+	Rows(ctx context.Context, db qrm.Queryable) (*Rows, error)
 }
 
 // Rows wraps sql.Rows type to add query result mapping for Scan method
@@ -42,6 +62,19 @@ func (r *Rows) Scan(destination interface{}) error {
 	return qrm.ScanOneRowToDest(r.scanContext, r.Rows, destination)
 }
 
+// ForEach scans rows one at a time into struct destination, and calls fn after each row is scanned. Destination is
+// reset before each row, so large result sets are processed in constant memory. Rows are closed when ForEach returns.
+// For instance:
+//
+//	var actor model.Actor
+//
+//	err = rows.ForEach(&actor, func() error {
+//		return process(actor)
+//	})
+func (r *Rows) ForEach(destination interface{}, fn func() error) error {
+	return qrm.ScanEachRowToDest(r.scanContext, r.Rows, destination, fn)
+}
+
 // SerializerStatement interface
 type SerializerStatement interface {
 	Serializer
@@ -65,6 +98,7 @@ type serializerStatementInterfaceImpl struct {
 	dialect       Dialect
 	statementType StatementType
 	parent        SerializerStatement
+	location      string // file:line where statement is constructed, if statement location capture is on
 }
 
 func (s *serializerStatementInterfaceImpl) Sql() (query string, args []interface{}) {
@@ -86,84 +120,175 @@ func (s *serializerStatementInterfaceImpl) DebugSql() (query string) {
 	return
 }
 
-func (s *serializerStatementInterfaceImpl) Query(db qrm.DB, destination interface{}) error {
+func (s *serializerStatementInterfaceImpl) SqlE() (query string, args []interface{}, err error) {
+	queryData := &SQLBuilder{Dialect: s.dialect}
+
+	if err := serializeStatement(s, queryData, false); err != nil {
+		return "", nil, s.locateError(err)
+	}
+
+	query, args = queryData.finalize()
+	return
+}
+
+func (s *serializerStatementInterfaceImpl) DebugSqlE() (query string, err error) {
+	sqlBuilder := &SQLBuilder{Dialect: s.dialect, Debug: true}
+
+	if err := serializeStatement(s, sqlBuilder, false); err != nil {
+		return "", s.locateError(err)
+	}
+
+	query, _ = sqlBuilder.finalize()
+	return
+}
+
+func (s *serializerStatementInterfaceImpl) Query(db qrm.Queryable, destination interface{}) error {
 	return s.QueryContext(context.Background(), db, destination)
 }
 
-func (s *serializerStatementInterfaceImpl) QueryContext(ctx context.Context, db qrm.DB, destination interface{}) error {
-	query, args := s.Sql()
+func (s *serializerStatementInterfaceImpl) QueryContext(ctx context.Context, db qrm.Queryable, destination interface{}) error {
+	return s.queryContext(ctx, db, destination, qrm.Query)
+}
+
+func (s *serializerStatementInterfaceImpl) QueryFast(db qrm.Queryable, destination interface{}) error {
+	return s.QueryFastContext(context.Background(), db, destination)
+}
+
+func (s *serializerStatementInterfaceImpl) QueryFastContext(ctx context.Context, db qrm.Queryable, destination interface{}) error {
+	return s.queryContext(ctx, db, destination, qrm.QueryFast)
+}
+
+func (s *serializerStatementInterfaceImpl) queryContext(ctx context.Context, db qrm.Queryable, destination interface{},
+	queryFunc func(ctx context.Context, db qrm.Queryable, query string, args []interface{}, destPtr interface{}) (int64, error)) error {
 
-	callLogger(ctx, s)
+	config := executionConfig(db)
+	query, args, err := statementSql(ctx, s, db, config)
+
+	if err != nil {
+		return s.locateError(err)
+	}
+
+	callLogger(ctx, config, s)
+
+	spanCtx, span := startSpan(ctx, config, SpanInfo{Statement: s, StatementType: s.statementType, Operation: "Query", ArgsCount: len(args)})
+	spanCtx = withJetExecution(spanCtx)
+
+	if config.ResultLimit != (qrm.ResultLimit{}) {
+		spanCtx = qrm.WithResultLimit(spanCtx, config.ResultLimit)
+	}
+
+	if config.TolerantMode != nil {
+		spanCtx = qrm.WithTolerantMode(spanCtx, config.TolerantMode)
+	}
 
 	var rowsProcessed int64
-	var err error
 
 	duration := duration(func() {
-		rowsProcessed, err = qrm.Query(ctx, db, query, args, destination)
+		rowsProcessed, err = queryFunc(spanCtx, db, query, args, destination)
 	})
 
-	callQueryLoggerFunc(ctx, QueryInfo{
+	err = s.locateError(err)
+
+	queryInfo := QueryInfo{
 		Statement:     s,
+		Query:         query,
+		Args:          args,
 		RowsProcessed: rowsProcessed,
 		Duration:      duration,
 		Err:           err,
-	})
+	}
+
+	endSpan(span, queryInfo)
+	callQueryLoggerFunc(ctx, config, queryInfo)
 
 	return err
 }
 
-func (s *serializerStatementInterfaceImpl) Exec(db qrm.DB) (res sql.Result, err error) {
+func (s *serializerStatementInterfaceImpl) Exec(db qrm.Executable) (res sql.Result, err error) {
 	return s.ExecContext(context.Background(), db)
 }
 
-func (s *serializerStatementInterfaceImpl) ExecContext(ctx context.Context, db qrm.DB) (res sql.Result, err error) {
-	query, args := s.Sql()
+func (s *serializerStatementInterfaceImpl) ExecContext(ctx context.Context, db qrm.Executable) (res sql.Result, err error) {
+	config := executionConfig(db)
+	query, args, err := statementSql(ctx, s, db, config)
 
-	callLogger(ctx, s)
+	if err != nil {
+		return nil, s.locateError(err)
+	}
+
+	callLogger(ctx, config, s)
+
+	spanCtx, span := startSpan(ctx, config, SpanInfo{Statement: s, StatementType: s.statementType, Operation: "Exec", ArgsCount: len(args)})
+	spanCtx = withJetExecution(spanCtx)
 
 	duration := duration(func() {
-		res, err = db.ExecContext(ctx, query, args...)
+		res, err = db.ExecContext(spanCtx, query, args...)
 	})
 
+	err = s.locateError(err)
+
 	var rowsAffected int64
 
 	if err == nil {
 		rowsAffected, _ = res.RowsAffected()
 	}
 
-	callQueryLoggerFunc(ctx, QueryInfo{
+	queryInfo := QueryInfo{
 		Statement:     s,
+		Query:         query,
+		Args:          args,
 		RowsProcessed: rowsAffected,
 		Duration:      duration,
 		Err:           err,
-	})
+	}
+
+	endSpan(span, queryInfo)
+	callQueryLoggerFunc(ctx, config, queryInfo)
 
 	return res, err
 }
 
-func (s *serializerStatementInterfaceImpl) Rows(ctx context.Context, db qrm.DB) (*Rows, error) {
-	query, args := s.Sql()
+func (s *serializerStatementInterfaceImpl) Rows(ctx context.Context, db qrm.Queryable) (*Rows, error) {
+	config := executionConfig(db)
+	query, args, err := statementSql(ctx, s, db, config)
+
+	if err != nil {
+		return nil, s.locateError(err)
+	}
 
-	callLogger(ctx, s)
+	callLogger(ctx, config, s)
+
+	spanCtx, span := startSpan(ctx, config, SpanInfo{Statement: s, StatementType: s.statementType, Operation: "Rows", ArgsCount: len(args)})
+	spanCtx = withJetExecution(spanCtx)
+
+	if config.TolerantMode != nil {
+		spanCtx = qrm.WithTolerantMode(spanCtx, config.TolerantMode)
+	}
 
 	var rows *sql.Rows
-	var err error
 
 	duration := duration(func() {
-		rows, err = db.QueryContext(ctx, query, args...)
+		rows, err = db.QueryContext(spanCtx, query, args...)
 	})
 
-	callQueryLoggerFunc(ctx, QueryInfo{
+	err = s.locateError(err)
+
+	queryInfo := QueryInfo{
 		Statement: s,
+		Query:     query,
+		Args:      args,
 		Duration:  duration,
 		Err:       err,
-	})
+	}
+
+	endSpan(span, queryInfo)
+	callQueryLoggerFunc(ctx, config, queryInfo)
 
 	if err != nil {
 		return nil, err
 	}
 
-	scanContext, err := qrm.NewScanContext(rows)
+	scanContext, err := qrm.NewScanContextWithContext(spanCtx, rows)
 
 	if err != nil {
 		return nil, err
@@ -175,6 +300,75 @@ func (s *serializerStatementInterfaceImpl) Rows(ctx context.Context, db qrm.DB)
 	}, nil
 }
 
+// statementSql returns statement sql query and arguments, used to execute statement over db in the context ctx
+func statementSql(ctx context.Context, statement *serializerStatementInterfaceImpl, db interface{},
+	config Config) (query string, args []interface{}, err error) {
+
+	var proxyDB *proxyModeDB
+	var safetyLimit int64
+	var pageWindow *pageWindowDB
+	var comment string
+
+	for current := db; current != nil; current = innerDB(current) {
+		switch wrapper := current.(type) {
+		case *proxyModeDB:
+			proxyDB = wrapper
+		case *deadlineLimitDB:
+			safetyLimit = wrapper.limit(ctx)
+		case *txDB:
+			comment = wrapper.options.Comment
+		case *pageWindowDB:
+			pageWindow = wrapper
+		}
+	}
+
+	out := &SQLBuilder{
+		Dialect:     statement.dialect,
+		safetyLimit: safetyLimit,
+		pageWindow:  pageWindow,
+		interpolate: proxyDB != nil,
+		ctx:         ctx,
+	}
+
+	if config.ColumnAccessPolicy != nil {
+		out.columnAccess = func(column Column) bool {
+			return config.ColumnAccessPolicy(ctx, column)
+		}
+	}
+
+	if config.InjectionAudit {
+		out.tainted = taintedValues(ctx)
+	}
+
+	if err := serializeStatement(statement, out, config.PanicOnSerializeError); err != nil {
+		return "", nil, err
+	}
+
+	if err := out.accessError(); err != nil {
+		return "", nil, err
+	}
+
+	if out.injectionErr != nil {
+		return "", nil, out.injectionErr
+	}
+
+	if out.schemaParamsErr != nil {
+		return "", nil, out.schemaParamsErr
+	}
+
+	query, args = out.finalize()
+
+	if proxyDB != nil && !proxyDB.inTransaction() && altersSessionState(statement.statementType, query) {
+		return "", nil, errors.New("jet: session altering statement is not allowed outside of transaction in proxy mode")
+	}
+
+	if comment != "" {
+		query = "/* " + comment + " */\n" + query
+	}
+
+	return query, args, nil
+}
+
 func duration(f func()) time.Duration {
 	start := time.Now()
 
@@ -199,6 +393,7 @@ func NewExpressionStatementImpl(Dialect Dialect, statementType StatementType, pa
 				parent:        parent,
 				dialect:       Dialect,
 				statementType: statementType,
+				location:      callerLocation(),
 			},
 			Clauses: clauses,
 		},
@@ -221,6 +416,7 @@ func NewStatementImpl(Dialect Dialect, statementType StatementType, parent Seria
 			parent:        parent,
 			dialect:       Dialect,
 			statementType: statementType,
+			location:      callerLocation(),
 		},
 		Clauses: clauses,
 	}
@@ -243,6 +439,14 @@ func (s *statementImpl) projections() ProjectionList {
 }
 
 func (s *statementImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if out.references != nil {
+		restoreStatement := out.references.addStatement(s.statementType, out.statementDepth == 0)
+		defer restoreStatement()
+	}
+
+	out.statementDepth++
+	defer func() { out.statementDepth-- }()
+
 	if !contains(options, NoWrap) {
 		out.WriteString("(")
 		out.IncreaseIdent()
@@ -252,7 +456,7 @@ func (s *statementImpl) serialize(statement StatementType, out *SQLBuilder, opti
 		out.IncreaseIdent()
 	}
 
-	for _, clause := range s.Clauses {
+	for _, clause := range rewriteClauses(out, s.statementType, s.Clauses) {
 		clause.Serialize(s.statementType, out, FallTrough(options)...)
 	}
 
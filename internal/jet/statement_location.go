@@ -0,0 +1,53 @@
+package jet
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// SetStatementLocation turns statement location capture on or off. When turned on, file and line where statement is
+// constructed are recorded with each new statement, and errors returned from statement execution are annotated with
+// it (for instance "jet: rhs of 'AND' operator is nil, statement built at /app/store/orders.go:42"), so errors in
+// large codebases point to the offending query. qrm.ErrNoRows is returned as is.
+func SetStatementLocation(enabled bool) {
+	updateGlobalConfig(func(config *Config) {
+		config.StatementLocation = enabled
+	})
+}
+
+const jetPackagePrefix = "github.com/go-jet/jet/v2/"
+
+// callerLocation returns file:line of the first caller outside of jet packages, if statement location capture is on
+func callerLocation() string {
+	if !GlobalConfig().StatementLocation {
+		return ""
+	}
+
+	var pcs [16]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs[:])])
+
+	for {
+		frame, more := frames.Next()
+
+		if !strings.HasPrefix(frame.Function, jetPackagePrefix) || strings.HasSuffix(frame.File, "_test.go") {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+// locateError annotates statement execution error with statement location, if captured
+func (s *serializerStatementInterfaceImpl) locateError(err error) error {
+	if err == nil || s.location == "" || errors.Is(err, qrm.ErrNoRows) {
+		return err
+	}
+
+	return fmt.Errorf("%w, statement built at %s", err, s.location)
+}
@@ -0,0 +1,146 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// queryOnlyDB implements only qrm.Queryable interface
+type queryOnlyDB struct {
+	query string
+}
+
+func (q *queryOnlyDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	q.query = query
+	return nil, sql.ErrConnDone
+}
+
+// execOnlyDB implements only qrm.Executable interface
+type execOnlyDB struct {
+	query string
+}
+
+func (e *execOnlyDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e.query = query
+	return nil, sql.ErrConnDone
+}
+
+func TestStatementQueryableExecutable(t *testing.T) {
+	stmt := RawStatement(defaultDialect, "SELECT 1")
+
+	queryDB := &queryOnlyDB{}
+
+	err := stmt.Query(queryDB, &struct{}{})
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Equal(t, "SELECT 1;\n", queryDB.query)
+
+	_, err = stmt.Rows(context.Background(), queryDB)
+	require.Equal(t, sql.ErrConnDone, err)
+
+	execDB := &execOnlyDB{}
+
+	_, err = stmt.Exec(execDB)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, "SELECT 1;\n", execDB.query)
+}
+
+func TestStatementSerializeError(t *testing.T) {
+	stmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1ColInt}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+		&ClauseWhere{Condition: table1ColInt.EQ(table1ColInt).AND(nil)},
+	)
+
+	db := &recordingDB{}
+
+	err := stmt.Query(db, &struct{}{})
+	require.EqualError(t, err, "jet: rhs of 'AND' operator is nil")
+
+	var serializeErr *SerializeError
+	require.True(t, errors.As(err, &serializeErr))
+
+	_, err = stmt.Exec(db)
+	require.EqualError(t, err, "jet: rhs of 'AND' operator is nil")
+
+	_, err = stmt.Rows(context.Background(), db)
+	require.EqualError(t, err, "jet: rhs of 'AND' operator is nil")
+	require.Empty(t, db.query)
+
+	panicDB := WithConfig(db, Config{PanicOnSerializeError: true})
+
+	require.PanicsWithValue(t, "jet: rhs of 'AND' operator is nil", func() {
+		_, _ = stmt.Exec(panicDB)
+	})
+}
+
+func TestStatementSqlE(t *testing.T) {
+	stmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1ColInt}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+		&ClauseWhere{Condition: table1ColInt.EQ(Int(1))},
+	)
+
+	query, args, err := stmt.SqlE()
+	require.NoError(t, err)
+	require.Equal(t, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int = $1;
+`, query)
+	require.Equal(t, []interface{}{int64(1)}, args)
+
+	debugQuery, err := stmt.DebugSqlE()
+	require.NoError(t, err)
+	require.Equal(t, stmt.DebugSql(), debugQuery)
+
+	stmt = newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1ColInt}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+		&ClauseWhere{Condition: table1ColInt.EQ(nil)},
+	)
+
+	query, args, err = stmt.SqlE()
+	require.EqualError(t, err, "jet: rhs of '=' operator is nil")
+	require.Empty(t, query)
+	require.Nil(t, args)
+
+	var serializeErr *SerializeError
+	require.True(t, errors.As(err, &serializeErr))
+
+	_, err = stmt.DebugSqlE()
+	require.EqualError(t, err, "jet: rhs of '=' operator is nil")
+
+	require.Panics(t, func() {
+		stmt.Sql()
+	})
+}
+
+func TestNewSerializeError(t *testing.T) {
+	require.Equal(t, "jet: nil clause", newSerializeError("jet: nil clause").Error())
+	require.Equal(t, "jet: failed to serialize statement, runtime error: index out of range",
+		newSerializeError(errors.New("runtime error: index out of range")).Error())
+}
+
+func TestStatementLocation(t *testing.T) {
+	stmt := RawStatement(defaultDialect, "SELECT 1")
+
+	_, err := stmt.Exec(&execOnlyDB{})
+	require.Equal(t, sql.ErrConnDone, err)
+
+	SetStatementLocation(true)
+	defer SetStatementLocation(false)
+
+	stmt = RawStatement(defaultDialect, "SELECT 1")
+
+	_, err = stmt.Exec(&execOnlyDB{})
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Regexp(t, `^sql: connection is already closed, statement built at .*statement_test\.go:\d+$`, err.Error())
+
+	err = stmt.Query(&queryOnlyDB{}, &struct{}{})
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Contains(t, err.Error(), "statement_test.go:")
+}
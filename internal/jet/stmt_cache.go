@@ -0,0 +1,218 @@
+package jet
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"sync"
+)
+
+// StmtPreparer is a database connection or transaction statements can be prepared on, for instance *sql.DB or *sql.Tx
+type StmtPreparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// StmtCacheStats contains prepared statement cache metrics
+type StmtCacheStats struct {
+	Size      int   // number of currently cached statements
+	Hits      int64 // number of executions with already prepared statement
+	Misses    int64 // number of executions which had to prepare a new statement
+	Evictions int64 // number of statements removed from the cache because cache was full, or with Evict
+}
+
+// StmtCache is a db connection or transaction wrapper, which prepares each distinct query once and reuses
+// prepared statement for all subsequent executions of the same query. When wrapping *sql.DB, database/sql
+// transparently re-prepares cached statement on each pool connection it is executed on, so the query is parsed
+// once per connection instead of once per execution.
+type StmtCache struct {
+	db      StmtPreparer
+	maxSize int
+
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	stats   StmtCacheStats
+}
+
+type stmtCacheEntry struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+// WithStmtCache wraps db connection or transaction, so that statements executed over it are prepared once and
+// reused, keyed by the statement sql text. At most maxSize statements are kept prepared, least recently used
+// statement is closed when the limit is reached. Statements with inlined arguments (for instance debug sql, or
+// statements executed in ProxyMode) have a distinct sql text for each argument value, and they should not be executed
+// over StmtCache. Statements cached over transaction are valid only until transaction ends, so StmtCache over
+// transaction should not outlive it.
+func WithStmtCache(db StmtPreparer, maxSize int) *StmtCache {
+	if maxSize <= 0 {
+		panic("jet: statement cache size has to be greater than 0")
+	}
+
+	return &StmtCache{
+		db:      db,
+		maxSize: maxSize,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+// Exec executes query without returning any rows, using cached prepared statement
+func (c *StmtCache) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return c.ExecContext(context.Background(), query, args...)
+}
+
+// ExecContext executes query without returning any rows, using cached prepared statement
+func (c *StmtCache) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	entry, err := c.acquire(ctx, query)
+
+	if err != nil {
+		return nil, err
+	}
+	defer c.release(entry)
+
+	return entry.stmt.ExecContext(ctx, args...)
+}
+
+// Query executes query that returns rows, using cached prepared statement
+func (c *StmtCache) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return c.QueryContext(context.Background(), query, args...)
+}
+
+// QueryContext executes query that returns rows, using cached prepared statement
+func (c *StmtCache) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	entry, err := c.acquire(ctx, query)
+
+	if err != nil {
+		return nil, err
+	}
+	defer c.release(entry)
+
+	return entry.stmt.QueryContext(ctx, args...)
+}
+
+// Stats returns current cache metrics
+func (c *StmtCache) Stats() StmtCacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	stats := c.stats
+	stats.Size = c.lru.Len()
+
+	return stats
+}
+
+// Evict closes and removes prepared statement for the query from the cache, if any. Statement currently in use
+// is closed after execution completes.
+func (c *StmtCache) Evict(query string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.entries[query]; ok {
+		c.evict(element)
+	}
+}
+
+// Close closes and removes all the cached prepared statements. StmtCache can still be used after Close, new
+// statements will be prepared as needed.
+func (c *StmtCache) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	var firstErr error
+
+	for element := c.lru.Front(); element != nil; element = c.lru.Front() {
+		if err := c.remove(element); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+func (c *StmtCache) acquire(ctx context.Context, query string) (*stmtCacheEntry, error) {
+	if entry := c.get(query); entry != nil {
+		return entry, nil
+	}
+
+	stmt, err := c.db.PrepareContext(ctx, query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.stats.Misses++
+
+	if element, ok := c.entries[query]; ok { // prepared concurrently
+		_ = stmt.Close()
+		entry := element.Value.(*stmtCacheEntry)
+		entry.refs++
+		c.lru.MoveToFront(element)
+		return entry, nil
+	}
+
+	for c.lru.Len() >= c.maxSize {
+		c.evict(c.lru.Back())
+	}
+
+	entry := &stmtCacheEntry{query: query, stmt: stmt, refs: 1}
+	c.entries[query] = c.lru.PushFront(entry)
+
+	return entry, nil
+}
+
+func (c *StmtCache) get(query string) *stmtCacheEntry {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.entries[query]
+
+	if !ok {
+		return nil
+	}
+
+	c.stats.Hits++
+	c.lru.MoveToFront(element)
+
+	entry := element.Value.(*stmtCacheEntry)
+	entry.refs++
+
+	return entry
+}
+
+func (c *StmtCache) release(entry *stmtCacheEntry) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry.refs--
+
+	if entry.evicted && entry.refs == 0 {
+		_ = entry.stmt.Close()
+	}
+}
+
+func (c *StmtCache) evict(element *list.Element) {
+	c.stats.Evictions++
+	_ = c.remove(element)
+}
+
+// remove removes element from the cache, and closes its statement if it is not in use
+func (c *StmtCache) remove(element *list.Element) error {
+	entry := element.Value.(*stmtCacheEntry)
+
+	c.lru.Remove(element)
+	delete(c.entries, entry.query)
+	entry.evicted = true
+
+	if entry.refs > 0 {
+		return nil
+	}
+
+	return entry.stmt.Close()
+}
@@ -0,0 +1,120 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countingDriver counts prepared and closed statements, and returns empty result for every query
+type countingDriver struct {
+	prepared []string
+	closed   int
+}
+
+func (d *countingDriver) Open(name string) (driver.Conn, error) { return &countingConn{driver: d}, nil }
+
+type countingConn struct{ driver *countingDriver }
+
+func (c *countingConn) Prepare(query string) (driver.Stmt, error) {
+	c.driver.prepared = append(c.driver.prepared, query)
+	return &countingStmt{driver: c.driver}, nil
+}
+func (c *countingConn) Close() error              { return nil }
+func (c *countingConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type countingStmt struct{ driver *countingDriver }
+
+func (s *countingStmt) Close() error {
+	s.driver.closed++
+	return nil
+}
+func (s *countingStmt) NumInput() int { return -1 }
+func (s *countingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+func (s *countingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &emptyRows{}, nil
+}
+
+type emptyRows struct{}
+
+func (r *emptyRows) Columns() []string              { return []string{"col1"} }
+func (r *emptyRows) Close() error                   { return nil }
+func (r *emptyRows) Next(dest []driver.Value) error { return io.EOF }
+
+var countingDriverInstance = &countingDriver{}
+
+func init() {
+	sql.Register("jet_counting", countingDriverInstance)
+}
+
+func TestStmtCache(t *testing.T) {
+	countingDriverInstance.prepared = nil
+	countingDriverInstance.closed = 0
+
+	db, err := sql.Open("jet_counting", "")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	cache := WithStmtCache(db, 2)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err = cache.ExecContext(ctx, "DELETE FROM table1 WHERE col1 = $1", i)
+		require.NoError(t, err)
+	}
+
+	rows, err := cache.QueryContext(ctx, "SELECT col1 FROM table1")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+
+	require.Equal(t, []string{"DELETE FROM table1 WHERE col1 = $1", "SELECT col1 FROM table1"}, countingDriverInstance.prepared)
+	require.Equal(t, StmtCacheStats{Size: 2, Hits: 2, Misses: 2}, cache.Stats())
+
+	_, err = cache.Exec("UPDATE table1 SET col1 = $1", 1)
+	require.NoError(t, err)
+	require.Equal(t, StmtCacheStats{Size: 2, Hits: 2, Misses: 3, Evictions: 1}, cache.Stats())
+	require.Equal(t, 1, countingDriverInstance.closed) // least recently used DELETE statement
+
+	cache.Evict("SELECT col1 FROM table1")
+	require.Equal(t, StmtCacheStats{Size: 1, Hits: 2, Misses: 3, Evictions: 2}, cache.Stats())
+
+	require.NoError(t, cache.Close())
+	require.Equal(t, 0, cache.Stats().Size)
+	require.Equal(t, 3, countingDriverInstance.closed)
+}
+
+func TestStmtCacheStatement(t *testing.T) {
+	countingDriverInstance.prepared = nil
+
+	db, err := sql.Open("jet_counting", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	cache := WithStmtCache(db, 10)
+
+	stmt := newTestStatement(SelectStatementType,
+		&ClauseSelect{ProjectionList: []Projection{table1Col1}},
+		&ClauseFrom{Tables: []Serializer{table1}},
+		&ClauseWhere{Condition: table1Col1.EQ(Int(1))},
+	)
+
+	var dest []struct{}
+	require.NoError(t, stmt.QueryContext(context.Background(), cache, &dest))
+	require.NoError(t, stmt.QueryContext(context.Background(), cache, &dest))
+
+	require.Len(t, countingDriverInstance.prepared, 1)
+	require.Equal(t, int64(1), cache.Stats().Hits)
+}
+
+func TestStmtCacheInvalidSize(t *testing.T) {
+	require.PanicsWithValue(t, "jet: statement cache size has to be greater than 0", func() {
+		WithStmtCache(nil, 0)
+	})
+}
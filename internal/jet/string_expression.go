@@ -23,6 +23,13 @@ type StringExpression interface {
 
 	REGEXP_LIKE(pattern StringExpression, caseSensitive ...bool) BoolExpression
 	NOT_REGEXP_LIKE(pattern StringExpression, caseSensitive ...bool) BoolExpression
+
+	// COLLATE returns string expression compared and sorted using collation, for instance "de-DE-x-icu" (PostgreSQL),
+	// utf8mb4_0900_ai_ci (MySQL) or NOCASE (SQLite)
+	COLLATE(collation string) StringExpression
+	// EQ_IGNORE_CASE compares lower case values of the expression and rhs. Columns of case-insensitive types
+	// (PostgreSQL citext) or collations (MySQL _ci) should be compared with EQ instead, so that column index can be used.
+	EQ_IGNORE_CASE(rhs StringExpression) BoolExpression
 }
 
 type stringInterfaceImpl struct {
@@ -89,6 +96,14 @@ func (s *stringInterfaceImpl) NOT_REGEXP_LIKE(pattern StringExpression, caseSens
 	return newBinaryBoolOperatorExpression(s.parent, pattern, StringNotRegexpLikeOperator, Bool(len(caseSensitive) > 0 && caseSensitive[0]))
 }
 
+func (s *stringInterfaceImpl) COLLATE(collation string) StringExpression {
+	return newCollateExpression(s.parent, collation)
+}
+
+func (s *stringInterfaceImpl) EQ_IGNORE_CASE(rhs StringExpression) BoolExpression {
+	return Eq(LOWER(s.parent), LOWER(rhs))
+}
+
 //---------------------------------------------------//
 func newBinaryStringOperatorExpression(lhs, rhs Expression, operator string) StringExpression {
 	return StringExp(NewBinaryOperatorExpression(lhs, rhs, operator))
@@ -96,6 +111,46 @@ func newBinaryStringOperatorExpression(lhs, rhs Expression, operator string) Str
 
 //---------------------------------------------------//
 
+type collateExpression struct {
+	ExpressionInterfaceImpl
+	stringInterfaceImpl
+
+	expression Expression
+	collation  string
+}
+
+func newCollateExpression(expression Expression, collation string) StringExpression {
+	if collation == "" {
+		panic("jet: collation name is empty")
+	}
+
+	ret := &collateExpression{
+		expression: expression,
+		collation:  collation,
+	}
+
+	ret.ExpressionInterfaceImpl.Parent = ret
+	ret.stringInterfaceImpl.parent = ret
+
+	return ret
+}
+
+func (c *collateExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if !contains(options, NoWrap) {
+		out.WriteString("(")
+	}
+
+	c.expression.serialize(statement, out, FallTrough(options)...)
+	out.WriteString("COLLATE")
+	out.WriteIdentifier(c.collation)
+
+	if !contains(options, NoWrap) {
+		out.WriteString(")")
+	}
+}
+
+//---------------------------------------------------//
+
 type stringExpressionWrapper struct {
 	stringInterfaceImpl
 	Expression
@@ -2,6 +2,8 @@ package jet
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestStringEQ(t *testing.T) {
@@ -80,3 +82,17 @@ func TestStringExp(t *testing.T) {
 	assertClauseSerialize(t, StringExp(table2ColFloat), "table2.col_float")
 	assertClauseSerialize(t, StringExp(table2ColFloat).NOT_LIKE(String("abc")), "(table2.col_float NOT LIKE $1)", "abc")
 }
+
+func TestStringCOLLATE(t *testing.T) {
+	assertClauseSerialize(t, table3StrCol.COLLATE("C"), `(table3.col2 COLLATE "C")`)
+	assertClauseSerialize(t, table3StrCol.COLLATE("de-DE-x-icu").EQ(String("Straße")),
+		`((table3.col2 COLLATE "de-DE-x-icu") = $1)`, "Straße")
+	assertClauseSerialize(t, table3StrCol.COLLATE("und_ci").LT(table2ColStr), `((table3.col2 COLLATE und_ci) < table2.col_str)`)
+	require.PanicsWithValue(t, "jet: collation name is empty", func() {
+		table3StrCol.COLLATE("")
+	})
+}
+
+func TestStringEQ_IGNORE_CASE(t *testing.T) {
+	assertClauseSerialize(t, table3StrCol.EQ_IGNORE_CASE(String("JOHN")), "(LOWER(table3.col2) = LOWER($1))", "JOHN")
+}
@@ -75,9 +75,13 @@ func (t *tableImpl) serialize(statement StatementType, out *SQLBuilder, options
 		panic("jet: tableImpl is nil")
 	}
 
+	if out.references != nil {
+		out.references.addTable(t)
+	}
+
 	// Use default schema if the schema name is not set
 	if len(t.schemaName) > 0 {
-		out.WriteIdentifier(t.schemaName)
+		out.WriteIdentifier(out.resolveSchemaName(t.schemaName))
 		out.WriteString(".")
 	}
 
@@ -99,6 +103,9 @@ const (
 	RightJoin
 	FullJoin
 	CrossJoin
+	InnerJoinLateral
+	LeftJoinLateral
+	CrossJoinLateral
 )
 
 // Join expressions are pseudo readable tables.
@@ -177,6 +184,15 @@ func (t *joinTableImpl) serialize(statement StatementType, out *SQLBuilder, opti
 		out.WriteString("FULL JOIN")
 	case CrossJoin:
 		out.WriteString("CROSS JOIN")
+	case InnerJoinLateral:
+		out.RequireFeature(FeatureLateral)
+		out.WriteString("INNER JOIN LATERAL")
+	case LeftJoinLateral:
+		out.RequireFeature(FeatureLateral)
+		out.WriteString("LEFT JOIN LATERAL")
+	case CrossJoinLateral:
+		out.RequireFeature(FeatureLateral)
+		out.WriteString("CROSS JOIN LATERAL")
 	}
 
 	if utils.IsNil(t.rhs) {
@@ -185,7 +201,7 @@ func (t *joinTableImpl) serialize(statement StatementType, out *SQLBuilder, opti
 
 	t.rhs.serialize(statement, out)
 
-	if t.onCondition == nil && t.joinType != CrossJoin {
+	if t.onCondition == nil && t.joinType != CrossJoin && t.joinType != CrossJoinLateral {
 		panic("jet: join condition is nil")
 	}
 
@@ -0,0 +1,50 @@
+package jet
+
+// inRange returns half-open interval condition: from <= expression < to. Nil bound is unbounded, and if both bounds
+// are nil, condition is always true (1 = 1).
+func inRange(expression, from, to Expression) BoolExpression {
+	var conditions []BoolExpression
+
+	if from != nil {
+		conditions = append(conditions, GtEq(expression, from))
+	}
+
+	if to != nil {
+		conditions = append(conditions, Lt(expression, to))
+	}
+
+	return allConditions(conditions)
+}
+
+// OVERLAPS_PERIOD returns true if half-open period [start, end) overlaps half-open period [from, to). Period
+// start and end are usually table columns, and NULL start or end is unbounded (for instance, ongoing period).
+// Nil from or to is unbounded as well.
+func OVERLAPS_PERIOD(start, end, from, to Expression) BoolExpression {
+	if start == nil || end == nil {
+		panic("jet: OVERLAPS_PERIOD period start and end can not be nil")
+	}
+
+	var conditions []BoolExpression
+
+	if to != nil {
+		conditions = append(conditions, OR(start.IS_NULL(), Lt(start, to)))
+	}
+
+	if from != nil {
+		conditions = append(conditions, OR(end.IS_NULL(), Gt(end, from)))
+	}
+
+	return allConditions(conditions)
+}
+
+func allConditions(conditions []BoolExpression) BoolExpression {
+	switch len(conditions) {
+	case 0:
+		// 1 = 1 instead of TRUE literal, which is not supported by all the dialects (sqlserver)
+		return Eq(FixedLiteral(1), FixedLiteral(1))
+	case 1:
+		return conditions[0]
+	default:
+		return AND(conditions...)
+	}
+}
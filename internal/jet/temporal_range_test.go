@@ -0,0 +1,44 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIN_RANGE(t *testing.T) {
+	assertClauseSerialize(t, table1ColTimestamp.IN_RANGE(table2ColTimestamp, timestamp),
+		`(
+    (table1.col_timestamp >= table2.col_timestamp)
+        AND (table1.col_timestamp < $1)
+)`, "2000-01-31 10:20:00.003")
+	assertClauseSerialize(t, table1ColTimestamp.IN_RANGE(nil, table2ColTimestamp),
+		"(table1.col_timestamp < table2.col_timestamp)")
+	assertClauseSerialize(t, table1ColTimestampz.IN_RANGE(table2ColTimestampz, nil),
+		"(table1.col_timestampz >= table2.col_timestampz)")
+	assertClauseSerialize(t, table1ColDate.IN_RANGE(nil, nil), "(1 = 1)")
+}
+
+func TestOVERLAPS_PERIOD(t *testing.T) {
+	assertClauseSerialize(t, OVERLAPS_PERIOD(table1ColTimestamp, table2ColTimestamp, timestamp, nil),
+		`(
+    table2.col_timestamp IS NULL
+        OR (table2.col_timestamp > $1)
+)`, "2000-01-31 10:20:00.003")
+	assertClauseSerialize(t, OVERLAPS_PERIOD(table1ColDate, table2ColDate, table3ColInt, table3Col1),
+		`(
+    (
+            table1.col_date IS NULL
+                OR (table1.col_date < table3.col1)
+        )
+        AND (
+                table2.col_date IS NULL
+                    OR (table2.col_date > table3.col_int)
+            )
+)`)
+	assertClauseSerialize(t, OVERLAPS_PERIOD(table1ColDate, table2ColDate, nil, nil), "(1 = 1)")
+
+	require.PanicsWithValue(t, "jet: OVERLAPS_PERIOD period start and end can not be nil", func() {
+		OVERLAPS_PERIOD(nil, table2ColDate, nil, nil)
+	})
+}
@@ -15,6 +15,8 @@ type TimestampExpression interface {
 	GT_EQ(rhs TimestampExpression) BoolExpression
 	BETWEEN(min, max TimestampExpression) BoolExpression
 	NOT_BETWEEN(min, max TimestampExpression) BoolExpression
+	// IN_RANGE returns half-open interval condition from <= expression < to. Nil from or to is unbounded.
+	IN_RANGE(from, to TimestampExpression) BoolExpression
 
 	ADD(rhs Interval) TimestampExpression
 	SUB(rhs Interval) TimestampExpression
@@ -64,6 +66,10 @@ func (t *timestampInterfaceImpl) NOT_BETWEEN(min, max TimestampExpression) BoolE
 	return NewBetweenOperatorExpression(t.parent, min, max, true)
 }
 
+func (t *timestampInterfaceImpl) IN_RANGE(from, to TimestampExpression) BoolExpression {
+	return inRange(t.parent, from, to)
+}
+
 func (t *timestampInterfaceImpl) ADD(rhs Interval) TimestampExpression {
 	return TimestampExp(Add(t.parent, rhs))
 }
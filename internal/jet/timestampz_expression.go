@@ -15,6 +15,8 @@ type TimestampzExpression interface {
 	GT_EQ(rhs TimestampzExpression) BoolExpression
 	BETWEEN(min, max TimestampzExpression) BoolExpression
 	NOT_BETWEEN(min, max TimestampzExpression) BoolExpression
+	// IN_RANGE returns half-open interval condition from <= expression < to. Nil from or to is unbounded.
+	IN_RANGE(from, to TimestampzExpression) BoolExpression
 
 	ADD(rhs Interval) TimestampzExpression
 	SUB(rhs Interval) TimestampzExpression
@@ -64,6 +66,10 @@ func (t *timestampzInterfaceImpl) NOT_BETWEEN(min, max TimestampzExpression) Boo
 	return NewBetweenOperatorExpression(t.parent, min, max, true)
 }
 
+func (t *timestampzInterfaceImpl) IN_RANGE(from, to TimestampzExpression) BoolExpression {
+	return inRange(t.parent, from, to)
+}
+
 func (t *timestampzInterfaceImpl) ADD(rhs Interval) TimestampzExpression {
 	return TimestampzExp(Add(t.parent, rhs))
 }
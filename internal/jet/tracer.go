@@ -0,0 +1,68 @@
+package jet
+
+import "context"
+
+// Tracer starts a span around each statement execution. Tracer is a minimal interface, so it can be implemented
+// over OpenTelemetry (or any other tracing library) in a few lines, without jet depending on tracing libraries:
+//
+//	type otelTracer struct{ tracer trace.Tracer }
+//
+//	func (t otelTracer) StartSpan(ctx context.Context, info jet.SpanInfo) (context.Context, jet.Span) {
+//		ctx, span := t.tracer.Start(ctx, string(info.StatementType), trace.WithSpanKind(trace.SpanKindClient))
+//		span.SetAttributes(
+//			attribute.String("db.statement", info.Statement.DebugSql()),
+//			attribute.Int("db.args_count", info.ArgsCount),
+//		)
+//		return ctx, otelSpan{span}
+//	}
+//
+//	type otelSpan struct{ span trace.Span }
+//
+//	func (s otelSpan) End(info jet.QueryInfo) {
+//		s.span.SetAttributes(attribute.Int64("db.rows", info.RowsProcessed))
+//		if info.Err != nil {
+//			s.span.RecordError(info.Err)
+//			s.span.SetStatus(codes.Error, info.Err.Error())
+//		}
+//		s.span.End()
+//	}
+type Tracer interface {
+	// StartSpan is called before statement execution. Returned context is used to execute the statement.
+	StartSpan(ctx context.Context, info SpanInfo) (context.Context, Span)
+}
+
+// Span is a statement execution span started by Tracer
+type Span interface {
+	// End is called after statement execution. For Rows() method span ends once query is executed, before rows are read.
+	End(info QueryInfo)
+}
+
+// SpanInfo contains information about statement to be executed
+type SpanInfo struct {
+	Statement     PrintableStatement
+	StatementType StatementType
+	// Operation is name of the statement method executed, Query, Exec or Rows
+	Operation string
+	ArgsCount int
+}
+
+// SetTracer sets tracer used to start a span around each statement execution
+func SetTracer(tracer Tracer) {
+	updateGlobalConfig(func(config *Config) {
+		config.Tracer = tracer
+	})
+}
+
+func startSpan(ctx context.Context, config Config, info SpanInfo) (context.Context, Span) {
+	if config.Tracer == nil {
+		return ctx, nil
+	}
+
+	return config.Tracer.StartSpan(ctx, info)
+}
+
+func endSpan(span Span, info QueryInfo) {
+	if span != nil {
+		span.End(info)
+	}
+}
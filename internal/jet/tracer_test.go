@@ -0,0 +1,64 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type spanKey struct{}
+
+type testTracer struct {
+	started []SpanInfo
+	ended   []QueryInfo
+}
+
+func (t *testTracer) StartSpan(ctx context.Context, info SpanInfo) (context.Context, Span) {
+	t.started = append(t.started, info)
+	return context.WithValue(ctx, spanKey{}, info.Operation), t
+}
+
+func (t *testTracer) End(info QueryInfo) {
+	t.ended = append(t.ended, info)
+}
+
+func TestTracer(t *testing.T) {
+	recordingDB := &recordingDB{}
+	tracer := &testTracer{}
+	db := WithConfig(recordingDB, Config{Tracer: tracer})
+
+	stmt := RawStatement(defaultDialect, "SELECT * FROM table1 WHERE col1 = #col1", map[string]interface{}{"#col1": 11})
+
+	_, err := stmt.ExecContext(context.Background(), db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, "Exec", recordingDB.ctx.Value(spanKey{}))
+
+	var dest []struct{}
+	err = stmt.QueryContext(context.Background(), db, &dest)
+	require.Error(t, err)
+	require.Equal(t, "Query", recordingDB.ctx.Value(spanKey{}))
+
+	_, err = stmt.Rows(context.Background(), db)
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, "Rows", recordingDB.ctx.Value(spanKey{}))
+
+	require.Len(t, tracer.started, 3)
+	require.Equal(t, "Exec", tracer.started[0].Operation)
+	require.Equal(t, 1, tracer.started[0].ArgsCount)
+	require.Equal(t, "SELECT * FROM table1 WHERE col1 = 11;\n", tracer.started[0].Statement.DebugSql())
+
+	require.Len(t, tracer.ended, 3)
+	for _, info := range tracer.ended {
+		require.Error(t, info.Err)
+	}
+}
+
+func TestTracerNotSet(t *testing.T) {
+	ctx, span := startSpan(context.Background(), Config{}, SpanInfo{})
+	require.Equal(t, context.Background(), ctx)
+	require.Nil(t, span)
+
+	endSpan(nil, QueryInfo{}) // no panic
+}
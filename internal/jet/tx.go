@@ -0,0 +1,107 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// StatementInterceptor is called before statement execution. If interceptor returns an error, statement is not
+// executed and the error is returned to the caller.
+type StatementInterceptor func(ctx context.Context, statement Statement) error
+
+// TxOptions contains defaults applied to all the statements executed in a transaction
+type TxOptions struct {
+	// Timeout, if set, limits execution time of each statement
+	Timeout time.Duration
+	// Comment, if set, is prepended to each statement sql query as /* Comment */
+	Comment string
+	// Interceptors are called in order before each statement execution
+	Interceptors []StatementInterceptor
+}
+
+// Tx binds statements to a transaction (or db connection) and applies transaction defaults on their execution
+type Tx struct {
+	db *txDB
+}
+
+type txDB struct {
+	qrm.DB
+
+	options TxOptions
+}
+
+// WrapTx creates new Tx over transaction tx with options applied to all the statements executed in the transaction.
+func WrapTx(tx qrm.DB, options TxOptions) *Tx {
+	if strings.Contains(options.Comment, "*/") {
+		panic("jet: transaction comment can not contain '*/'")
+	}
+
+	return &Tx{
+		db: &txDB{
+			DB:      tx,
+			options: options,
+		},
+	}
+}
+
+// DB returns transaction wrapped so that statements executed over it have transaction comment prepended
+func (t *Tx) DB() qrm.DB {
+	return t.db
+}
+
+// Wrap binds statement to the transaction
+func (t *Tx) Wrap(statement Statement) *TxStatement {
+	return &TxStatement{
+		tx:        t,
+		statement: statement,
+	}
+}
+
+// TxStatement is statement bound to a transaction
+type TxStatement struct {
+	tx        *Tx
+	statement Statement
+}
+
+// Query executes statement in the transaction and stores row result in destination.
+func (s *TxStatement) Query(ctx context.Context, destination interface{}) error {
+	ctx, cancel, err := s.tx.prepare(ctx, s.statement)
+
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	return s.statement.QueryContext(ctx, s.tx.db, destination)
+}
+
+// Exec executes statement in the transaction without returning any rows.
+func (s *TxStatement) Exec(ctx context.Context) (sql.Result, error) {
+	ctx, cancel, err := s.tx.prepare(ctx, s.statement)
+
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	return s.statement.ExecContext(ctx, s.tx.db)
+}
+
+func (t *Tx) prepare(ctx context.Context, statement Statement) (context.Context, context.CancelFunc, error) {
+	for _, interceptor := range t.db.options.Interceptors {
+		if err := interceptor(ctx, statement); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if t.db.options.Timeout > 0 {
+		ctx, cancel := context.WithTimeout(ctx, t.db.options.Timeout)
+		return ctx, cancel, nil
+	}
+
+	return ctx, func() {}, nil
+}
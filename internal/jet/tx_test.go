@@ -0,0 +1,71 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxWrap(t *testing.T) {
+	db := &recordingDB{}
+	var intercepted []string
+
+	tx := WrapTx(db, TxOptions{
+		Timeout: time.Minute,
+		Comment: "service: orders",
+		Interceptors: []StatementInterceptor{
+			func(ctx context.Context, statement Statement) error {
+				intercepted = append(intercepted, statement.DebugSql())
+				return nil
+			},
+		},
+	})
+
+	stmt := RawStatement(defaultDialect, "SELECT * FROM table1 WHERE col1 = #col1", map[string]interface{}{"#col1": 11})
+
+	_, err := tx.Wrap(stmt).Exec(context.Background())
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, "/* service: orders */\nSELECT * FROM table1 WHERE col1 = $1;\n", db.query)
+	require.Equal(t, []interface{}{11}, db.args)
+	require.Equal(t, []string{"SELECT * FROM table1 WHERE col1 = 11;\n"}, intercepted)
+
+	deadline, ok := db.ctx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+
+	var dest []struct{}
+	err = tx.Wrap(stmt).Query(context.Background(), &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Len(t, intercepted, 2)
+
+	_, err = stmt.Exec(ProxyMode(tx.DB()))
+	require.Equal(t, sql.ErrConnDone, err)
+	require.Equal(t, "/* service: orders */\nSELECT * FROM table1 WHERE col1 = 11;\n", db.query)
+}
+
+func TestTxWrapInterceptorError(t *testing.T) {
+	db := &recordingDB{}
+	interceptorErr := errors.New("read only transaction")
+
+	tx := WrapTx(db, TxOptions{
+		Interceptors: []StatementInterceptor{
+			func(ctx context.Context, statement Statement) error {
+				return interceptorErr
+			},
+		},
+	})
+
+	_, err := tx.Wrap(RawStatement(defaultDialect, "DELETE FROM table1")).Exec(context.Background())
+	require.Equal(t, interceptorErr, err)
+	require.Empty(t, db.query)
+}
+
+func TestTxInvalidComment(t *testing.T) {
+	require.PanicsWithValue(t, "jet: transaction comment can not contain '*/'", func() {
+		WrapTx(&recordingDB{}, TxOptions{Comment: "*/ DROP TABLE table1; /*"})
+	})
+}
@@ -42,16 +42,23 @@ func serializeExpressionList(
 
 // SerializeProjectionList func
 func SerializeProjectionList(statement StatementType, projections []Projection, out *SQLBuilder) {
-	for i, col := range projections {
-		if i > 0 {
-			out.WriteString(",")
-			out.NewLine()
-		}
+	first := true
 
+	for _, col := range projections {
 		if col == nil {
 			panic("jet: Projection is nil")
 		}
 
+		if !out.canRead(col) {
+			continue
+		}
+
+		if !first {
+			out.WriteString(",")
+			out.NewLine()
+		}
+		first = false
+
 		col.serializeForProjection(statement, out)
 	}
 }
@@ -67,7 +74,7 @@ func SerializeColumnNames(columns []Column, out *SQLBuilder) {
 			panic("jet: nil column in columns list")
 		}
 
-		out.WriteIdentifier(col.Name())
+		out.writeColumnName(col)
 	}
 }
 
@@ -87,6 +94,30 @@ func SerializeColumnExpressions(columns []ColumnExpression, statementType Statem
 	}
 }
 
+// SerializeIndexExpressions serializes list of index columns and expressions, for instance ON CONFLICT target.
+// Columns are serialized by name, and the other expressions are wrapped in parentheses, as required for expression
+// index elements.
+func SerializeIndexExpressions(expressions []Expression, statementType StatementType, out *SQLBuilder) {
+	for i, expression := range expressions {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+
+		if expression == nil {
+			panic("jet: nil expression in index expressions list")
+		}
+
+		if column, ok := expression.(ColumnExpression); ok {
+			column.serialize(statementType, out, ShortName)
+			continue
+		}
+
+		out.WriteString("(")
+		expression.serialize(statementType, out, ShortName, NoWrap)
+		out.WriteString(")")
+	}
+}
+
 // SerializeColumnExpressionNames func
 func SerializeColumnExpressionNames(columns []ColumnExpression, out *SQLBuilder) {
 	for i, col := range columns {
@@ -98,7 +129,7 @@ func SerializeColumnExpressionNames(columns []ColumnExpression, out *SQLBuilder)
 			panic("jet: nil column in columns list")
 		}
 
-		out.WriteIdentifier(col.Name())
+		out.writeColumnName(col)
 	}
 }
 
@@ -124,6 +155,17 @@ func BoolExpressionListToExpressionList(expressions []BoolExpression) []Expressi
 	return ret
 }
 
+// ColumnListToExpressionList converts list of column expressions to list of expressions
+func ColumnListToExpressionList(columns []ColumnExpression) []Expression {
+	var ret []Expression
+
+	for _, column := range columns {
+		ret = append(ret, column)
+	}
+
+	return ret
+}
+
 // ColumnListToProjectionList func
 func ColumnListToProjectionList(columns []ColumnExpression) []Projection {
 	var ret []Projection
@@ -146,9 +188,20 @@ func ToSerializerValue(value interface{}) Serializer {
 
 // UnwindRowFromModel func
 func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
+	row := []Serializer{}
+
+	for _, value := range RowValuesFromModel(columns, data) {
+		row = append(row, literal(value))
+	}
+
+	return row
+}
+
+// RowValuesFromModel returns values of data struct fields matching columns, in columns order
+func RowValuesFromModel(columns []Column, data interface{}) []interface{} {
 	structValue := reflect.Indirect(reflect.ValueOf(data))
 
-	row := []Serializer{}
+	values := []interface{}{}
 
 	utils.ValueMustBe(structValue, reflect.Struct, "jet: data has to be a struct")
 
@@ -170,10 +223,17 @@ func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
 			field = reflect.Indirect(structField).Interface()
 		}
 
-		row = append(row, literal(field))
+		// fields tagged with `sql:"json"` are stored into json columns
+		fieldType, _ := structValue.Type().FieldByName(structFieldName)
+
+		if field != nil && fieldType.Tag.Get("sql") == "json" {
+			field = jsonText(field)
+		}
+
+		values = append(values, field)
 	}
 
-	return row
+	return values
 }
 
 // UnwindRowsFromModels func
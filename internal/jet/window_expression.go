@@ -16,6 +16,8 @@ func (w *commonWindowImpl) over(window ...Window) {
 func (w *commonWindowImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 	w.expression.serialize(statement, out)
 	if w.window != nil {
+		out.aggregateWritten()
+		out.RequireFeature(FeatureWindowFunctions)
 		out.WriteString("OVER")
 		w.window.serialize(statement, out, FallTrough(options)...)
 	}
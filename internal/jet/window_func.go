@@ -7,6 +7,15 @@ type Window interface {
 	ROWS(start FrameExtent, end ...FrameExtent) Window
 	RANGE(start FrameExtent, end ...FrameExtent) Window
 	GROUPS(start FrameExtent, end ...FrameExtent) Window
+
+	// EXCLUDE_CURRENT_ROW excludes the current row from the frame
+	EXCLUDE_CURRENT_ROW() Window
+	// EXCLUDE_GROUP excludes the current row and its ordering peers from the frame
+	EXCLUDE_GROUP() Window
+	// EXCLUDE_TIES excludes any peers of the current row from the frame, but not the current row itself
+	EXCLUDE_TIES() Window
+	// EXCLUDE_NO_OTHERS explicitly specifies the default behavior of not excluding any rows from the frame
+	EXCLUDE_NO_OTHERS() Window
 }
 
 type windowImpl struct {
@@ -14,6 +23,7 @@ type windowImpl struct {
 	orderBy     ClauseOrderBy
 	frameUnits  string
 	start, end  FrameExtent
+	exclusion   string
 
 	parent Window
 }
@@ -43,6 +53,10 @@ func (w *windowImpl) serialize(statement StatementType, out *SQLBuilder, options
 	w.orderBy.Serialize(statement, out, FallTrough(options)...)
 
 	if w.frameUnits != "" {
+		if w.frameUnits == "GROUPS" {
+			out.RequireFeature(FeatureWindowFrameGroups)
+		}
+
 		out.WriteString(w.frameUnits)
 
 		if w.end == nil {
@@ -55,6 +69,16 @@ func (w *windowImpl) serialize(statement StatementType, out *SQLBuilder, options
 		}
 	}
 
+	if w.exclusion != "" {
+		if w.frameUnits == "" {
+			panic("jet: window frame exclusion requires frame clause (ROWS, RANGE or GROUPS)")
+		}
+
+		out.RequireFeature(FeatureWindowFrameExclusion)
+
+		out.WriteString("EXCLUDE " + w.exclusion)
+	}
+
 	if !contains(options, NoWrap) {
 		out.WriteByte(')')
 	}
@@ -83,6 +107,26 @@ func (w *windowImpl) GROUPS(start FrameExtent, end ...FrameExtent) Window {
 	return w.parent
 }
 
+func (w *windowImpl) EXCLUDE_CURRENT_ROW() Window {
+	w.exclusion = "CURRENT ROW"
+	return w.parent
+}
+
+func (w *windowImpl) EXCLUDE_GROUP() Window {
+	w.exclusion = "GROUP"
+	return w.parent
+}
+
+func (w *windowImpl) EXCLUDE_TIES() Window {
+	w.exclusion = "TIES"
+	return w.parent
+}
+
+func (w *windowImpl) EXCLUDE_NO_OTHERS() Window {
+	w.exclusion = "NO OTHERS"
+	return w.parent
+}
+
 func (w *windowImpl) setFrameRange(start FrameExtent, end ...FrameExtent) {
 	w.start = start
 	if len(end) > 0 {
@@ -104,6 +148,21 @@ func ORDER_BY(expr ...OrderByClause) Window {
 	return funImpl
 }
 
+// ROWS window function constructor, for windows with ROWS frame over not partitioned and not ordered rows
+func ROWS(start FrameExtent, end ...FrameExtent) Window {
+	return newWindowImpl(nil).ROWS(start, end...)
+}
+
+// RANGE window function constructor, for windows with RANGE frame over not partitioned and not ordered rows
+func RANGE(start FrameExtent, end ...FrameExtent) Window {
+	return newWindowImpl(nil).RANGE(start, end...)
+}
+
+// GROUPS window function constructor, for windows with GROUPS frame over not partitioned and not ordered rows
+func GROUPS(start FrameExtent, end ...FrameExtent) Window {
+	return newWindowImpl(nil).GROUPS(start, end...)
+}
+
 // -----------------------------------------------
 
 // FrameExtent interface
@@ -18,4 +18,23 @@ func TestWindowFunctions(t *testing.T) {
 		"(ORDER BY table1.col1 RANGE BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING)")
 	assertClauseSerialize(t, ORDER_BY(table1Col1).RANGE(PRECEDING(UNBOUNDED), CURRENT_ROW),
 		"(ORDER BY table1.col1 RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)")
+	assertClauseSerialize(t, ROWS(PRECEDING(UNBOUNDED), CURRENT_ROW),
+		"(ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)")
+	assertClauseSerialize(t, RANGE(CURRENT_ROW, FOLLOWING(UNBOUNDED)),
+		"(RANGE BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)")
+	assertClauseSerialize(t, GROUPS(PRECEDING(Int(1)), FOLLOWING(Int(1))).EXCLUDE_TIES(),
+		"(GROUPS BETWEEN $1 PRECEDING AND $2 FOLLOWING EXCLUDE TIES)", int64(1), int64(1))
+}
+
+func TestWindowFrameExclusion(t *testing.T) {
+	assertClauseSerialize(t, ORDER_BY(table1Col1).ROWS(PRECEDING(Int(1)), FOLLOWING(Int(1))).EXCLUDE_CURRENT_ROW(),
+		"(ORDER BY table1.col1 ROWS BETWEEN $1 PRECEDING AND $2 FOLLOWING EXCLUDE CURRENT ROW)", int64(1), int64(1))
+	assertClauseSerialize(t, ORDER_BY(table1Col1).GROUPS(PRECEDING(UNBOUNDED), CURRENT_ROW).EXCLUDE_GROUP(),
+		"(ORDER BY table1.col1 GROUPS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW EXCLUDE GROUP)")
+	assertClauseSerialize(t, ORDER_BY(table1Col1).RANGE(PRECEDING(UNBOUNDED)).EXCLUDE_TIES(),
+		"(ORDER BY table1.col1 RANGE UNBOUNDED PRECEDING EXCLUDE TIES)")
+	assertClauseSerialize(t, PARTITION_BY(table1Col3).ORDER_BY(table1Col1).ROWS(CURRENT_ROW).EXCLUDE_NO_OTHERS(),
+		"(PARTITION BY table1.col3 ORDER BY table1.col1 ROWS CURRENT ROW EXCLUDE NO OTHERS)")
+	assertClauseSerializeErr(t, ORDER_BY(table1Col1).EXCLUDE_TIES(),
+		"jet: window frame exclusion requires frame clause (ROWS, RANGE or GROUPS)")
 }
@@ -10,6 +10,7 @@ func WITH(dialect Dialect, recursive bool, cte ...*CommonTableExpression) func(s
 		serializerStatementInterfaceImpl: serializerStatementInterfaceImpl{
 			dialect:       dialect,
 			statementType: WithStatementType,
+			location:      callerLocation(),
 		},
 	}
 	newWithImpl.parent = newWithImpl
@@ -32,6 +33,7 @@ type withImpl struct {
 }
 
 func (w withImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.RequireFeature(FeatureCommonTableExpressions)
 	out.NewLine()
 	out.WriteString("WITH")
 
@@ -39,6 +41,8 @@ func (w withImpl) serialize(statement StatementType, out *SQLBuilder, options ..
 		out.WriteString("RECURSIVE")
 	}
 
+	out.statementDepth++ // common table expression bodies are not top level statements
+
 	for i, cte := range w.ctes {
 		if i > 0 {
 			out.WriteString(",")
@@ -46,6 +50,9 @@ func (w withImpl) serialize(statement StatementType, out *SQLBuilder, options ..
 
 		cte.serialize(statement, out, FallTrough(options)...)
 	}
+
+	out.statementDepth--
+
 	w.primaryStatement.serialize(statement, out, NoWrap.WithFallTrough(options)...)
 }
 
@@ -108,3 +115,55 @@ func (c CommonTableExpression) AllColumns() ProjectionList {
 
 	return c.selectTableImpl.AllColumns()
 }
+
+// CTESelf creates typed references to the columns of common table expression by column name. It is used in the
+// recursive term of WITH RECURSIVE CTE definition, where CTE references its own columns (including computed columns
+// without underlying table column), before the CTE statement is defined.
+type CTESelf struct {
+	cte SelectTable
+}
+
+// NewCTESelf creates new CTESelf for common table expression cte
+func NewCTESelf(cte SelectTable) CTESelf {
+	return CTESelf{cte: cte}
+}
+
+// Bool returns reference to the bool column name of the CTE
+func (s CTESelf) Bool(name string) ColumnBool {
+	return BoolColumn(name).From(s.cte)
+}
+
+// Integer returns reference to the integer column name of the CTE
+func (s CTESelf) Integer(name string) ColumnInteger {
+	return IntegerColumn(name).From(s.cte)
+}
+
+// Float returns reference to the float column name of the CTE
+func (s CTESelf) Float(name string) ColumnFloat {
+	return FloatColumn(name).From(s.cte)
+}
+
+// String returns reference to the string column name of the CTE
+func (s CTESelf) String(name string) ColumnString {
+	return StringColumn(name).From(s.cte)
+}
+
+// Date returns reference to the date column name of the CTE
+func (s CTESelf) Date(name string) ColumnDate {
+	return DateColumn(name).From(s.cte)
+}
+
+// Time returns reference to the time column name of the CTE
+func (s CTESelf) Time(name string) ColumnTime {
+	return TimeColumn(name).From(s.cte)
+}
+
+// Timestamp returns reference to the timestamp column name of the CTE
+func (s CTESelf) Timestamp(name string) ColumnTimestamp {
+	return TimestampColumn(name).From(s.cte)
+}
+
+// Timestampz returns reference to the timestamp with time zone column name of the CTE
+func (s CTESelf) Timestampz(name string) ColumnTimestampz {
+	return TimestampzColumn(name).From(s.cte)
+}
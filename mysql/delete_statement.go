@@ -10,16 +10,19 @@ type DeleteStatement interface {
 	WHERE(expression BoolExpression) DeleteStatement
 	ORDER_BY(orderByClauses ...OrderByClause) DeleteStatement
 	LIMIT(limit int64) DeleteStatement
+	// RETURNING returns projections of deleted rows. Supported only by MariaDB dialect.
+	RETURNING(projections ...Projection) DeleteStatement
 }
 
 type deleteStatementImpl struct {
 	jet.SerializerStatement
 
-	Delete  jet.ClauseStatementBegin
-	Using   jet.ClauseFrom
-	Where   jet.ClauseWhere
-	OrderBy jet.ClauseOrderBy
-	Limit   jet.ClauseLimit
+	Delete    jet.ClauseStatementBegin
+	Using     jet.ClauseFrom
+	Where     jet.ClauseWhere
+	OrderBy   jet.ClauseOrderBy
+	Limit     jet.ClauseLimit
+	Returning clauseReturning
 }
 
 func newDeleteStatement(table Table) DeleteStatement {
@@ -29,7 +32,8 @@ func newDeleteStatement(table Table) DeleteStatement {
 		&newDelete.Using,
 		&newDelete.Where,
 		&newDelete.OrderBy,
-		&newDelete.Limit)
+		&newDelete.Limit,
+		&newDelete.Returning)
 
 	newDelete.Delete.Name = "DELETE FROM"
 	newDelete.Using.Name = "USING"
@@ -59,3 +63,8 @@ func (d *deleteStatementImpl) LIMIT(limit int64) DeleteStatement {
 	d.Limit.Count = limit
 	return d
 }
+
+func (d *deleteStatementImpl) RETURNING(projections ...Projection) DeleteStatement {
+	d.Returning.ProjectionList = projections
+	return d
+}
@@ -5,9 +5,54 @@ import (
 )
 
 // Dialect is implementation of MySQL dialect for SQL Builder serialisation.
+// Dialect targets the latest database version. To target older database version, Dialect can be replaced with
+// versioned dialect at program start, before any statement is created:
+//
+//	mysql.Dialect = mysql.Dialect.WithVersion(5, 7)
 var Dialect = newDialect()
 
+// MariaDB is implementation of MariaDB dialect for SQL Builder serialisation. It differs from MySQL dialect in
+// supported features, for instance it supports native RETURNING clause (MariaDB 10.5+), which is emulated for MySQL.
+// To target MariaDB, Dialect should be replaced at program start, before any statement is created:
+//
+//	mysql.Dialect = mysql.MariaDB
+var MariaDB = newMariaDBDialect()
+
 func newDialect() jet.Dialect {
+	mySQLDialectParams := dialectParams()
+	mySQLDialectParams.FeatureVersions = map[jet.Feature]jet.Version{
+		jet.FeatureWindowFunctions:        {Major: 8},
+		jet.FeatureCommonTableExpressions: {Major: 8},
+		jet.FeatureLateral:                {Major: 8}, // 8.0.14
+		jet.FeatureLockingReadOptions:     {Major: 8},
+		jet.FeatureNoWait:                 {Major: 8},
+		jet.FeatureSkipLocked:             {Major: 8},
+	}
+	mySQLDialectParams.UnsupportedFeatures = []jet.Feature{jet.FeatureNullsOrdering, jet.FeatureReturning}
+
+	return jet.NewDialect(mySQLDialectParams)
+}
+
+func newMariaDBDialect() jet.Dialect {
+	mariaDBDialectParams := dialectParams()
+	mariaDBDialectParams.Name = "MariaDB"
+	mariaDBDialectParams.FeatureVersions = map[jet.Feature]jet.Version{
+		jet.FeatureWindowFunctions:        {Major: 10, Minor: 2},
+		jet.FeatureCommonTableExpressions: {Major: 10, Minor: 2},
+		jet.FeatureNoWait:                 {Major: 10, Minor: 3},
+		jet.FeatureReturning:              {Major: 10, Minor: 5},
+		jet.FeatureSkipLocked:             {Major: 10, Minor: 6},
+	}
+	mariaDBDialectParams.UnsupportedFeatures = []jet.Feature{
+		jet.FeatureNullsOrdering,
+		jet.FeatureLateral,
+		jet.FeatureLockingReadOptions,
+	}
+
+	return jet.NewDialect(mariaDBDialectParams)
+}
+
+func dialectParams() jet.DialectParams {
 	operatorSerializeOverrides := map[string]jet.SerializeOverride{}
 	operatorSerializeOverrides[jet.StringRegexpLikeOperator] = mysqlREGEXPLIKEoperator
 	operatorSerializeOverrides[jet.StringNotRegexpLikeOperator] = mysqlNOTREGEXPLIKEoperator
@@ -29,7 +74,7 @@ func newDialect() jet.Dialect {
 		ReservedWords: reservedWords,
 	}
 
-	return jet.NewDialect(mySQLDialectParams)
+	return mySQLDialectParams
 }
 
 func mysqlBitXor(expressions ...jet.Serializer) jet.SerializerFunc {
@@ -2,6 +2,8 @@ package mysql
 
 import (
 	"testing"
+
+	"github.com/go-jet/jet/v2/internal/jet"
 )
 
 func TestBoolExpressionIS_DISTINCT_FROM(t *testing.T) {
@@ -9,6 +11,10 @@ func TestBoolExpressionIS_DISTINCT_FROM(t *testing.T) {
 	assertSerialize(t, table1ColBool.IS_DISTINCT_FROM(Bool(false)), "(NOT(table1.col_bool <=> ?))", false)
 }
 
+func TestNullSafeEq(t *testing.T) {
+	assertSerialize(t, NullSafeEq(table1ColInt, table2ColInt), "(table1.col_int <=> table2.col_int)")
+}
+
 func TestBoolExpressionIS_NOT_DISTINCT_FROM(t *testing.T) {
 	assertSerialize(t, table1ColBool.IS_NOT_DISTINCT_FROM(table2ColBool), "(table1.col_bool <=> table2.col_bool)")
 	assertSerialize(t, table1ColBool.IS_NOT_DISTINCT_FROM(Bool(false)), "(table1.col_bool <=> ?)", false)
@@ -60,3 +66,69 @@ func TestString_NOT_REGEXP_LIKE_operator(t *testing.T) {
 	assertSerialize(t, table3StrCol.NOT_REGEXP_LIKE(String("JOHN"), false), "(table3.col2 NOT REGEXP ?)", "JOHN")
 	assertSerialize(t, table3StrCol.NOT_REGEXP_LIKE(String("JOHN"), true), "(table3.col2 NOT REGEXP BINARY ?)", "JOHN")
 }
+
+func TestDialectVersion(t *testing.T) {
+	defer func(dialect jet.Dialect) { Dialect = dialect }(Dialect)
+	Dialect = Dialect.WithVersion(5, 7)
+
+	cte := CTE("cte")
+
+	assertStatementSqlErr(t,
+		WITH(cte.AS(SELECT(table1ColInt).FROM(table1)))(
+			SELECT(cte.AllColumns()).FROM(cte),
+		),
+		"jet: WITH clause not supported by MySQL 5.7")
+	assertStatementSqlErr(t,
+		SELECT(ROW_NUMBER().OVER(ORDER_BY(table1ColInt))).FROM(table1),
+		"jet: window functions not supported by MySQL 5.7")
+	assertStatementSqlErr(t,
+		SELECT(table1ColInt).FROM(table1).FOR(UPDATE().SKIP_LOCKED()),
+		"jet: SKIP LOCKED not supported by MySQL 5.7")
+	assertStatementSqlErr(t,
+		SELECT(table1ColInt).FROM(table1).FOR(UPDATE().OF(table1)),
+		"jet: FOR SHARE and OF row lock options not supported by MySQL 5.7")
+
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).FOR(SHARE()), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+LOCK IN SHARE MODE;
+`)
+
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+}
+
+func TestMariaDBDialect(t *testing.T) {
+	defer func(dialect jet.Dialect) { Dialect = dialect }(Dialect)
+	Dialect = MariaDB
+
+	assertStatementSql(t, table1.INSERT(table1Col1).VALUES(1).RETURNING(table1ColInt), `
+INSERT INTO db.table1 (col1)
+VALUES (?)
+RETURNING table1.col_int AS "table1.col_int";
+`, 1)
+	assertStatementSql(t, table1.DELETE().WHERE(table1ColInt.EQ(Int(1))).RETURNING(table1Col1, table1ColInt), `
+DELETE FROM db.table1
+WHERE table1.col_int = ?
+RETURNING table1.col1 AS "table1.col1",
+          table1.col_int AS "table1.col_int";
+`, int64(1))
+	assertStatementSqlErr(t, SELECT(table1ColInt).FROM(table1).FOR(SHARE().OF(table1)),
+		"jet: FOR SHARE and OF row lock options not supported by MariaDB")
+
+	Dialect = MariaDB.WithVersion(10, 4)
+
+	assertStatementSql(t, table1.INSERT(table1Col1).VALUES(1).RETURNING(table1ColInt), `
+INSERT INTO db.table1 (col1)
+VALUES (?);
+`, 1)
+	assertStatementSqlErr(t, table1.DELETE().WHERE(table1ColInt.EQ(Int(1))).RETURNING(table1Col1),
+		"jet: RETURNING clause not supported by MariaDB 10.4")
+}
+
+func TestMySQLDeleteReturning(t *testing.T) {
+	assertStatementSqlErr(t, table1.DELETE().WHERE(table1ColInt.EQ(Int(1))).RETURNING(table1Col1),
+		"jet: RETURNING clause not supported by MySQL")
+}
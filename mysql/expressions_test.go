@@ -60,3 +60,19 @@ func TestRawType(t *testing.T) {
 	assertSerialize(t, RawDate("table.colDate").EQ(DateT(time)),
 		"((table.colDate) = CAST(? AS DATE))", time)
 }
+
+func TestBUCKET_BY(t *testing.T) {
+	assertSerialize(t, BUCKET_BY(table1ColTimestamp, 15*time2.Minute),
+		"FROM_UNIXTIME(FLOOR(UNIX_TIMESTAMP(table1.col_timestamp) / ?) * ?)", int64(900), int64(900))
+
+	assertPanicErr(t, func() { BUCKET_BY(table1ColTimestamp, 500*time2.Millisecond) },
+		"jet: BUCKET_BY bucket width has to be a positive whole number of seconds, got 500ms")
+	assertPanicErr(t, func() { BUCKET_BY(table1ColTimestamp, -time2.Hour) },
+		"jet: BUCKET_BY bucket width has to be a positive whole number of seconds, got -1h0m0s")
+}
+
+func TestHashFunctions(t *testing.T) {
+	assertSerialize(t, SHA2(table2ColStr, Int(256)), "SHA2(table2.col_str, ?)", int64(256))
+	assertSerialize(t, AES_DECRYPT(AES_ENCRYPT(table2ColStr, String("key")), String("key")),
+		"AES_DECRYPT(AES_ENCRYPT(table2.col_str, ?), ?)", "key", "key")
+}
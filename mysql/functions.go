@@ -1,6 +1,10 @@
 package mysql
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"time"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
 
 // This functions can be used, instead of its method counterparts, to have a better indentation of a complex condition
 // in the Go code and in the generated SQL.
@@ -11,6 +15,16 @@ var (
 	OR = jet.OR
 )
 
+// ConditionChain accumulates optional conditions, and combines them with AND or OR operator
+type ConditionChain = jet.ConditionChain
+
+var (
+	// AllOf creates new condition chain, which combines conditions with AND operator
+	AllOf = jet.AllOf
+	// AnyOf creates new condition chain, which combines conditions with OR operator
+	AnyOf = jet.AnyOf
+)
+
 // ROW is construct one table row from list of expressions.
 var ROW = jet.ROW
 
@@ -207,6 +221,22 @@ func RPAD(str jet.StringExpression, length jet.IntegerExpression, text jet.Strin
 // MD5 calculates the MD5 hash of string, returning the result in hexadecimal
 var MD5 = jet.MD5
 
+// SHA2 calculates the SHA-2 family hash of string, returning the result in hexadecimal. The hashLength
+// is the desired bit length of the result (224, 256, 384 or 512).
+func SHA2(str StringExpression, hashLength IntegerExpression) StringExpression {
+	return jet.NewStringFunc("SHA2", str, hashLength)
+}
+
+// AES_ENCRYPT encrypts the string using key, returning binary string
+func AES_ENCRYPT(str, key StringExpression) StringExpression {
+	return jet.NewStringFunc("AES_ENCRYPT", str, key)
+}
+
+// AES_DECRYPT decrypts the encrypted string using key
+func AES_DECRYPT(cryptStr, key StringExpression) StringExpression {
+	return jet.NewStringFunc("AES_DECRYPT", cryptStr, key)
+}
+
 // REPEAT repeats string the specified number of times
 var REPEAT = jet.REPEAT
 
@@ -255,10 +285,43 @@ func UNIX_TIMESTAMP(str StringExpression) TimestampExpression {
 	return jet.NewTimestampFunc("UNIX_TIMESTAMP", str)
 }
 
+// BUCKET_BY groups timestamps into buckets of bucketWidth duration, counting from unix epoch. Bucket width has to be
+// a positive whole number of seconds.
+func BUCKET_BY(ts Expression, bucketWidth time.Duration) DateTimeExpression {
+	seconds := Int(jet.BucketWidthSeconds(bucketWidth))
+	epoch := jet.NewFloatFunc("UNIX_TIMESTAMP", ts)
+
+	return jet.NewTimestampFunc("FROM_UNIXTIME", FLOOR(epoch.DIV(seconds)).MUL(seconds))
+}
+
+//----------- Insert functions ---------------//
+
+// VALUES references value that would be inserted into column, in ON DUPLICATE KEY UPDATE assignments. Returned
+// expression has no type, so it has to be wrapped with type wrapper, for instance Link.Name.SET(StringExp(VALUES(Link.Name))).
+// Deprecated by MySQL 8.0.20 in favor of row alias (see InsertStatement AS_NEW).
+func VALUES(column Column) Expression {
+	return jet.NewFunc("VALUES", []Expression{column}, nil)
+}
+
+// LAST_INSERT_ID returns the first automatically generated AUTO_INCREMENT value of the most recently executed INSERT
+// statement on the connection. If expr is given, LAST_INSERT_ID returns its value, and it is remembered as the
+// value to be returned by the next LAST_INSERT_ID call.
+func LAST_INSERT_ID(expr ...IntegerExpression) IntegerExpression {
+	if len(expr) > 0 {
+		return IntExp(jet.Func("LAST_INSERT_ID", expr[0]))
+	}
+
+	return IntExp(jet.Func("LAST_INSERT_ID"))
+}
+
 //----------- Comparison operators ---------------//
 
 // EXISTS checks for existence of the rows in subQuery
 var EXISTS = jet.EXISTS
 
+// OVERLAPS_PERIOD returns true if half-open period [start, end) overlaps half-open period [from, to). Period start
+// or end NULL value is unbounded, and nil from or to is unbounded as well.
+var OVERLAPS_PERIOD = jet.OVERLAPS_PERIOD
+
 // CASE create CASE operator with optional list of expressions
 var CASE = jet.CASE
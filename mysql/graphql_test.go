@@ -0,0 +1,51 @@
+package mysql
+
+import "testing"
+
+func TestGraphQLSelect(t *testing.T) {
+	graphQLType := GraphQLType{
+		Table:   table1,
+		Columns: ColumnList{table1Col1, table1ColInt, table1ColBool},
+		Key:     ColumnList{table1Col1},
+		Relations: map[string]GraphQLRelation{
+			"table2": {
+				GraphQLType: GraphQLType{
+					Table:   table2,
+					Columns: ColumnList{table2Col3, table2ColStr},
+					Key:     ColumnList{table2Col3},
+					Relations: map[string]GraphQLRelation{
+						"table3": {
+							GraphQLType: GraphQLType{
+								Table:   table3,
+								Columns: ColumnList{table3Col1, table3StrCol},
+							},
+							On: table3ColInt.EQ(table2ColInt),
+						},
+					},
+				},
+				On: table2ColInt.EQ(table1ColInt),
+			},
+		},
+	}
+
+	projections, from := GraphQLSelect(graphQLType, []string{"colBool", "__typename", "table2.colStr", "table2.table3.col2"})
+
+	assertStatementSql(t, SELECT(projections).FROM(from), `
+SELECT table1.col1 AS "table1.col1",
+     table1.col_bool AS "table1.col_bool",
+     table2.col3 AS "table2.col3",
+     table2.col_str AS "table2.col_str",
+     table3.col2 AS "table3.col2"
+FROM db.table1
+     LEFT JOIN db.table2 ON (table2.col_int = table1.col_int)
+     LEFT JOIN db.table3 ON (table3.col_int = table2.col_int);
+`)
+
+	projections, from = GraphQLSelect(graphQLType, []string{"colInt", "unknown.field"})
+
+	assertStatementSql(t, SELECT(projections).FROM(from), `
+SELECT table1.col1 AS "table1.col1",
+     table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+}
@@ -1,6 +1,18 @@
 package mysql
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// maxParameters is the maximum number of parameters MySQL accepts in a single statement
+const maxParameters = 65535
 
 // InsertStatement is interface for SQL INSERT statements
 type InsertStatement interface {
@@ -12,19 +24,46 @@ type InsertStatement interface {
 	// If data is not struct or there is no field for every column selected, this method will panic.
 	MODEL(data interface{}) InsertStatement
 	MODELS(data interface{}) InsertStatement
+	// MODELS_CHUNKED inserts rows of values extracted from the slice of structures data, like MODELS. When executed,
+	// rows are split into chunks of at most chunkSize rows, each inserted with a separate statement, so that the
+	// statement parameter limit is not exceeded. If chunkSize is 0, or too big for the parameter limit, the maximum
+	// chunk size is used. If db is *sql.DB, chunks are inserted in a new transaction. Exec returns the total number
+	// of rows affected. Chunk size should also be small enough for the statement to fit into max_allowed_packet.
+	MODELS_CHUNKED(data interface{}, chunkSize int) InsertStatement
 
+	// AS_NEW sets row alias "new" for the inserted rows (MySQL 8.0.19+), so that ON DUPLICATE KEY UPDATE assignments
+	// can reference inserted values using table aliased as "new", for instance Link.AS("new").Name.
+	AS_NEW() InsertStatement
 	ON_DUPLICATE_KEY_UPDATE(assigments ...ColumnAssigment) InsertStatement
 
 	QUERY(selectStatement SelectStatement) InsertStatement
+
+	// RETURNING returns projections of inserted rows (autoIncrement column if projections are not set). With MariaDB
+	// dialect native RETURNING clause is used. MySQL does not support RETURNING clause, so it is emulated: Query and
+	// QueryContext execute INSERT statement, and then SELECT statement with projections, which fetches inserted rows
+	// by the range of generated autoIncrement column values (from LAST_INSERT_ID and the number of inserted rows).
+	// If db is *sql.DB, both statements are executed in a new transaction. Emulation requires consecutive auto
+	// increment values for multi-row inserts (innodb_autoinc_lock_mode 0 or 1, or simple inserts with mode 2), and
+	// it is not supported for statements with ON DUPLICATE KEY UPDATE or QUERY clause. If destination is a slice and
+	// the number of selected rows differs from the number of inserted rows, because auto increment values are not
+	// consecutive, an error is returned (and the new transaction is rolled back).
+	RETURNING(autoIncrement ColumnInteger, projections ...Projection) InsertStatement
+
+	// ExecAndReturnID executes single row INSERT statement, and returns generated AUTO_INCREMENT value of the
+	// inserted row
+	ExecAndReturnID(ctx context.Context, db qrm.Executable) (int64, error)
 }
 
 func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
 	newInsert := &insertStatementImpl{}
 	newInsert.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newInsert,
-		&newInsert.Insert, &newInsert.ValuesQuery, &newInsert.OnDuplicateKey)
+		&newInsert.Insert, &newInsert.ValuesQuery, &newInsert.OnDuplicateKey, &newInsert.Returning)
 
 	newInsert.Insert.Table = table
 	newInsert.Insert.Columns = columns
+	newInsert.Returning.Emulated = true
+	newInsert.table = table
+	newInsert.dialect = Dialect
 
 	return newInsert
 }
@@ -35,6 +74,17 @@ type insertStatementImpl struct {
 	Insert         jet.ClauseInsert
 	ValuesQuery    jet.ClauseValuesQuery
 	OnDuplicateKey onDuplicateKeyUpdateClause
+	Returning      clauseReturning
+
+	table     Table
+	dialect   jet.Dialect
+	returning *insertReturning
+	chunks    jet.InsertChunks
+}
+
+type insertReturning struct {
+	autoIncrement ColumnInteger
+	projections   []Projection
 }
 
 func (is *insertStatementImpl) VALUES(value interface{}, values ...interface{}) InsertStatement {
@@ -52,8 +102,19 @@ func (is *insertStatementImpl) MODELS(data interface{}) InsertStatement {
 	return is
 }
 
+func (is *insertStatementImpl) MODELS_CHUNKED(data interface{}, chunkSize int) InsertStatement {
+	is.MODELS(data)
+	is.chunks.Size = jet.ChunkSize(chunkSize, len(is.Insert.GetColumns()), maxParameters)
+	return is
+}
+
+func (is *insertStatementImpl) AS_NEW() InsertStatement {
+	is.OnDuplicateKey.RowAlias = "new"
+	return is
+}
+
 func (is *insertStatementImpl) ON_DUPLICATE_KEY_UPDATE(assigments ...ColumnAssigment) InsertStatement {
-	is.OnDuplicateKey = assigments
+	is.OnDuplicateKey.Assigments = assigments
 	return is
 }
 
@@ -62,25 +123,201 @@ func (is *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertStat
 	return is
 }
 
-type onDuplicateKeyUpdateClause []jet.ColumnAssigment
+func (is *insertStatementImpl) RETURNING(autoIncrement ColumnInteger, projections ...Projection) InsertStatement {
+	is.returning = &insertReturning{
+		autoIncrement: autoIncrement,
+		projections:   projections,
+	}
+
+	if len(projections) == 0 {
+		projections = []Projection{autoIncrement}
+	}
+
+	is.Returning.ProjectionList = projections
+	return is
+}
+
+func (is *insertStatementImpl) ExecAndReturnID(ctx context.Context, db qrm.Executable) (int64, error) {
+	if len(is.ValuesQuery.Rows) > 1 {
+		return 0, errors.New("jet: ExecAndReturnID requires single row INSERT statement")
+	}
+
+	res, err := is.ExecContext(ctx, db)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+func (is *insertStatementImpl) Exec(db qrm.Executable) (sql.Result, error) {
+	return is.ExecContext(context.Background(), db)
+}
+
+func (is *insertStatementImpl) ExecContext(ctx context.Context, db qrm.Executable) (sql.Result, error) {
+	return is.chunks.ExecInChunks(ctx, db, is.SerializerStatement, &is.ValuesQuery.ClauseValues)
+}
+
+func (is *insertStatementImpl) Query(db qrm.Queryable, destination interface{}) error {
+	return is.QueryContext(context.Background(), db, destination)
+}
+
+func (is *insertStatementImpl) QueryContext(ctx context.Context, db qrm.Queryable, destination interface{}) error {
+	if is.returning == nil || is.dialect.Supports(jet.FeatureReturning) {
+		return is.chunks.QueryInChunks(ctx, db, is.SerializerStatement, &is.ValuesQuery.ClauseValues, destination)
+	}
+
+	if is.chunks.Size > 0 {
+		return errors.New("jet: RETURNING emulation is not supported for chunked INSERT")
+	}
+
+	execDB, ok := db.(qrm.DB)
+
+	if !ok {
+		return errors.New("jet: RETURNING emulation requires db connection or transaction which can execute statements")
+	}
+
+	if len(is.OnDuplicateKey.Assigments) > 0 || is.ValuesQuery.Query != nil {
+		return errors.New("jet: RETURNING emulation is not supported for INSERT with ON DUPLICATE KEY UPDATE or QUERY clause")
+	}
+
+	return inTransaction(ctx, execDB, func(db qrm.DB) error {
+		return is.insertAndSelect(ctx, db, destination)
+	})
+}
+
+// inTransaction calls fn in a new transaction if db is db connection, or with db if db is already a transaction.
+// New transaction is wrapped with the same jet db wrappers as db.
+func inTransaction(ctx context.Context, db qrm.DB, fn func(db qrm.DB) error) error {
+	if !jet.CanBeginTx(db) {
+		return fn(db)
+	}
+
+	tx, wrappedTx, err := jet.BeginTx(ctx, db, nil)
+
+	if err != nil {
+		return err
+	}
+
+	if err := fn(wrappedTx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (is *insertStatementImpl) insertAndSelect(ctx context.Context, db qrm.DB, destination interface{}) error {
+	res, err := is.ExecContext(ctx, db)
+
+	if err != nil {
+		return err
+	}
+
+	firstID, err := res.LastInsertId()
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+
+	if err != nil {
+		return err
+	}
+
+	if firstID == 0 || rowsAffected == 0 {
+		return errors.New("jet: RETURNING emulation requires auto increment value generated by INSERT statement")
+	}
+
+	autoIncrement := is.returning.autoIncrement
+	projections := is.returning.projections
+
+	if len(projections) == 0 {
+		projections = []Projection{autoIncrement}
+	}
+
+	destinationSlice := reflect.Indirect(reflect.ValueOf(destination))
+	rowsBefore := -1
+
+	if destinationSlice.Kind() == reflect.Slice {
+		rowsBefore = destinationSlice.Len()
+	}
+
+	// inserted rows are selected by the range of auto increment values, assuming the values are consecutive
+	err = is.table.
+		SELECT(projections[0], projections[1:]...).
+		WHERE(autoIncrement.BETWEEN(Int(firstID), Int(firstID+rowsAffected-1))).
+		ORDER_BY(autoIncrement).
+		QueryContext(ctx, db, destination)
+
+	if err != nil {
+		return err
+	}
+
+	if rowsBefore >= 0 && int64(destinationSlice.Len()-rowsBefore) != rowsAffected {
+		return fmt.Errorf("jet: RETURNING emulation selected %d row(s), but %d row(s) were inserted, auto increment "+
+			"values are not consecutive", destinationSlice.Len()-rowsBefore, rowsAffected)
+	}
+
+	return nil
+}
+
+type onDuplicateKeyUpdateClause struct {
+	RowAlias   string
+	Assigments []jet.ColumnAssigment
+}
 
 // Serialize for SetClause
 func (s onDuplicateKeyUpdateClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
-	if len(s) == 0 {
+	if s.RowAlias != "" {
+		out.WriteString("AS")
+		out.WriteIdentifier(s.RowAlias)
+	}
+
+	if len(s.Assigments) == 0 {
 		return
 	}
 	out.NewLine()
 	out.WriteString("ON DUPLICATE KEY UPDATE")
 	out.IncreaseIdent(24)
 
-	for i, assigment := range s {
+	assigmentOptions := jet.ShortName.WithFallTrough(options)
+
+	if s.RowAlias != "" {
+		// row alias columns have to be qualified
+		assigmentOptions = jet.FallTrough(options)
+	}
+
+	for i, assigment := range s.Assigments {
 		if i > 0 {
 			out.WriteString(",")
 			out.NewLine()
 		}
 
-		jet.Serialize(assigment, statementType, out, jet.ShortName.WithFallTrough(options)...)
+		jet.Serialize(assigment, statementType, out, assigmentOptions...)
 	}
 
 	out.DecreaseIdent(24)
 }
+
+// clauseReturning is RETURNING clause, supported only by MariaDB. Emulated clause is not serialized for dialects
+// without RETURNING support, because it is emulated with a separate statement.
+type clauseReturning struct {
+	jet.ClauseReturning
+	Emulated bool
+}
+
+func (r *clauseReturning) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if len(r.ProjectionList) == 0 {
+		return
+	}
+
+	if r.Emulated && !out.Dialect.Supports(jet.FeatureReturning) {
+		return
+	}
+
+	out.RequireFeature(jet.FeatureReturning)
+	r.ClauseReturning.Serialize(statementType, out, options...)
+}
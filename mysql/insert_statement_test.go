@@ -1,9 +1,14 @@
 package mysql
 
 import (
-	"github.com/stretchr/testify/require"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestInvalidInsert(t *testing.T) {
@@ -178,4 +183,176 @@ ON DUPLICATE KEY UPDATE col_bool = ?,
                         col_date = CAST(? AS DATE);
 `, "two", true, int64(11), 11.1, "str", "11:23:11", "2020-01-22 03:04:05", "2020-12-01")
 	})
+
+	t.Run("values function", func(t *testing.T) {
+		stmt := stmt().ON_DUPLICATE_KEY_UPDATE(
+			table1ColFloat.SET(FloatExp(VALUES(table1ColFloat))),
+			table1Col1.SET(IntExp(VALUES(table1Col1)).ADD(Int(1))),
+		)
+		assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES (DEFAULT, ?)
+ON DUPLICATE KEY UPDATE col_float = VALUES(table1.col_float),
+                        col1 = (VALUES(table1.col1) + ?);
+`, "two", int64(1))
+	})
+
+	t.Run("row alias", func(t *testing.T) {
+		newColFloat := FloatColumn("col_float")
+		_ = NewTable("db", "table1", "new", newColFloat)
+
+		stmt := stmt().AS_NEW().ON_DUPLICATE_KEY_UPDATE(
+			table1ColFloat.SET(newColFloat),
+			table1ColInt.SET(table1ColInt.ADD(Int(1))),
+		)
+		assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES (DEFAULT, ?) AS new
+ON DUPLICATE KEY UPDATE col_float = new.col_float,
+                        col_int = (table1.col_int + ?);
+`, "two", int64(1))
+	})
+}
+
+// returningDriver records executed statements, reports two inserted rows with first auto increment value 10, and
+// returns inserted rows for each query
+type returningDriver struct {
+	statements []string
+	rows       []int64
+}
+
+func (d *returningDriver) Open(name string) (driver.Conn, error) {
+	return &returningConn{driver: d}, nil
+}
+
+type returningConn struct{ driver *returningDriver }
+
+func (c *returningConn) Prepare(query string) (driver.Stmt, error) {
+	return &returningStmt{driver: c.driver, query: query}, nil
+}
+func (c *returningConn) Close() error { return nil }
+func (c *returningConn) Begin() (driver.Tx, error) {
+	c.driver.statements = append(c.driver.statements, "BEGIN")
+	return &returningTx{driver: c.driver}, nil
+}
+
+type returningTx struct{ driver *returningDriver }
+
+func (t *returningTx) Commit() error {
+	t.driver.statements = append(t.driver.statements, "COMMIT")
+	return nil
+}
+func (t *returningTx) Rollback() error {
+	t.driver.statements = append(t.driver.statements, "ROLLBACK")
+	return nil
+}
+
+type returningStmt struct {
+	driver *returningDriver
+	query  string
+}
+
+func (s *returningStmt) Close() error  { return nil }
+func (s *returningStmt) NumInput() int { return -1 }
+func (s *returningStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.driver.statements = append(s.driver.statements, s.query)
+	return returningResult{}, nil
+}
+func (s *returningStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.driver.statements = append(s.driver.statements, s.query)
+	return &returningRows{values: append([]int64{}, s.driver.rows...)}, nil
+}
+
+type returningResult struct{}
+
+func (r returningResult) LastInsertId() (int64, error) { return 10, nil }
+func (r returningResult) RowsAffected() (int64, error) { return 2, nil }
+
+type returningRows struct{ values []int64 }
+
+func (r *returningRows) Columns() []string { return []string{"table1.col_int"} }
+func (r *returningRows) Close() error      { return nil }
+func (r *returningRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
+
+var returning = &returningDriver{rows: []int64{10, 11}}
+
+func init() {
+	sql.Register("jet_mysql_returning", returning)
+}
+
+func TestInsertReturningEmulation(t *testing.T) {
+	returning.statements = nil
+
+	db, err := sql.Open("jet_mysql_returning", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	stmt := table1.INSERT(table1Col1).
+		VALUES(1).
+		VALUES(2).
+		RETURNING(table1ColInt, table1ColInt)
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1)
+VALUES (?),
+       (?);
+`, 1, 2)
+
+	var dest []struct {
+		ColInt int64 `alias:"table1.col_int"`
+	}
+
+	require.NoError(t, stmt.QueryContext(context.Background(), db, &dest))
+	require.Len(t, dest, 2)
+	require.Equal(t, int64(11), dest[1].ColInt)
+	require.Equal(t, []string{
+		"BEGIN",
+		"\nINSERT INTO db.table1 (col1)\nVALUES (?),\n       (?);\n",
+		"\nSELECT table1.col_int AS \"table1.col_int\"\nFROM db.table1\nWHERE table1.col_int BETWEEN ? AND ?\nORDER BY table1.col_int;\n",
+		"COMMIT",
+	}, returning.statements)
+
+	returning.statements, returning.rows = nil, []int64{10}
+	defer func() { returning.rows = []int64{10, 11} }()
+
+	dest = nil
+	err = stmt.QueryContext(context.Background(), db, &dest)
+	require.EqualError(t, err, "jet: RETURNING emulation selected 1 row(s), but 2 row(s) were inserted, auto increment values are not consecutive")
+	require.Equal(t, "ROLLBACK", returning.statements[len(returning.statements)-1])
+
+	err = table1.INSERT(table1Col1).
+		VALUES(1).
+		ON_DUPLICATE_KEY_UPDATE(table1Col1.SET(Int(2))).
+		RETURNING(table1ColInt).
+		Query(db, &dest)
+	require.EqualError(t, err, "jet: RETURNING emulation is not supported for INSERT with ON DUPLICATE KEY UPDATE or QUERY clause")
+}
+
+func TestInsertExecAndReturnID(t *testing.T) {
+	db, err := sql.Open("jet_mysql_returning", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	id, err := table1.INSERT(table1Col1).VALUES(1).ExecAndReturnID(context.Background(), db)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), id)
+
+	_, err = table1.INSERT(table1Col1).VALUES(1).VALUES(2).ExecAndReturnID(context.Background(), db)
+	require.EqualError(t, err, "jet: ExecAndReturnID requires single row INSERT statement")
+}
+
+func TestLAST_INSERT_ID(t *testing.T) {
+	assertSerialize(t, LAST_INSERT_ID(), "LAST_INSERT_ID()")
+	assertSerialize(t, LAST_INSERT_ID(table1ColInt.ADD(Int(1))), "LAST_INSERT_ID(table1.col_int + ?)", int64(1))
+
+	assertStatementSql(t, table1.INSERT(table1Col1).VALUES(LAST_INSERT_ID()), `
+INSERT INTO db.table1 (col1)
+VALUES (LAST_INSERT_ID());
+`)
 }
@@ -171,6 +171,41 @@ func INTERVALd(duration time.Duration) Interval {
 	return INTERVAL(sign*microsec, MICROSECOND)
 }
 
+// Years creates interval of years. For example: NOW().SUB(Years(1))
+func Years(years int64) Interval {
+	return INTERVAL(years, YEAR)
+}
+
+// Months creates interval of months. For example: CURRENT_DATE().ADD(Months(1))
+func Months(months int64) Interval {
+	return INTERVAL(months, MONTH)
+}
+
+// Weeks creates interval of weeks
+func Weeks(weeks int64) Interval {
+	return INTERVAL(weeks, WEEK)
+}
+
+// Days creates interval of days. For example: NOW().SUB(Days(30))
+func Days(days int64) Interval {
+	return INTERVAL(days, DAY)
+}
+
+// Hours creates interval of hours
+func Hours(hours int64) Interval {
+	return INTERVAL(hours, HOUR)
+}
+
+// Minutes creates interval of minutes
+func Minutes(minutes int64) Interval {
+	return INTERVAL(minutes, MINUTE)
+}
+
+// Seconds creates interval of seconds
+func Seconds(seconds int64) Interval {
+	return INTERVAL(seconds, SECOND)
+}
+
 var (
 	regexSecondMicrosecond = regexp.MustCompile(`^-?\d{1,2}\.\d+$`)                //'SECONDS.MICROSECONDS'
 	regexMinuteMicrosecond = regexp.MustCompile(`^-?\d{1,2}:\d{2}\.\d+$`)          //'MINUTE:SECONDS.MICROSECONDS'
@@ -193,3 +228,8 @@ func isNumericType(value interface{}) bool {
 		return false
 	}
 }
+
+// EXTRACT retrieves unit part from date, time or datetime expression. For example: EXTRACT(YEAR_MONTH, table.Date)
+func EXTRACT(unit unitType, from Expression) IntegerExpression {
+	return IntExp(jet.EXTRACT(string(unit), from))
+}
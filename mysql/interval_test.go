@@ -97,3 +97,18 @@ func TestINTERVALe(t *testing.T) {
 	assertSerialize(t, INTERVALe(table1ColFloat, QUARTER), "INTERVAL table1.col_float QUARTER")
 	assertSerialize(t, INTERVALe(table1ColFloat, YEAR), "INTERVAL table1.col_float YEAR")
 }
+
+func TestEXTRACT(t *testing.T) {
+	assertSerialize(t, EXTRACT(YEAR, table1ColTimestamp), "EXTRACT(YEAR FROM table1.col_timestamp)")
+	assertSerialize(t, EXTRACT(DAY_HOUR, table1ColTimestamp).GT(Int(10)), "(EXTRACT(DAY_HOUR FROM table1.col_timestamp) > ?)", int64(10))
+}
+
+func TestRelativeInterval(t *testing.T) {
+	assertSerialize(t, NOW().SUB(Days(30)), "(NOW() - INTERVAL 30 DAY)")
+	assertSerialize(t, CURRENT_DATE().ADD(Months(1)), "(CURRENT_DATE + INTERVAL 1 MONTH)")
+	assertSerialize(t, table1ColTimestamp.ADD(Years(2)), "(table1.col_timestamp + INTERVAL 2 YEAR)")
+	assertSerialize(t, Weeks(1), "INTERVAL 1 WEEK")
+	assertSerialize(t, Hours(3), "INTERVAL 3 HOUR")
+	assertSerialize(t, Minutes(4), "INTERVAL 4 MINUTE")
+	assertSerialize(t, Seconds(-5), "INTERVAL -5 SECOND")
+}
@@ -81,3 +81,10 @@ func TestTimestamp(t *testing.T) {
 	assertSerialize(t, Timestamp(2010, time.March, 30, 10, 15, 30), `TIMESTAMP(?)`, "2010-03-30 10:15:30")
 	assertSerialize(t, TimestampT(time.Now()), `TIMESTAMP(?)`)
 }
+
+func TestFixedLiteral(t *testing.T) {
+	assertSerialize(t, FixedLiteral(true), `TRUE`)
+	assertSerialize(t, FixedLiteral(11), `11`)
+	assertSerialize(t, FixedLiteral("active"), `'active'`)
+	assertSerialize(t, table1ColBool.EQ(BoolExp(FixedLiteral(false))), "(table1.col_bool = FALSE)")
+}
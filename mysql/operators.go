@@ -10,3 +10,13 @@ var BIT_NOT = jet.BIT_NOT
 
 // DISTINCT operator can be used to return distinct values of expr
 var DISTINCT = jet.DISTINCT
+
+// NullSafeEq returns true if lhs and rhs are equal, treating two NULL values as equal and NULL value as not equal to
+// any non NULL value
+var NullSafeEq = jet.NullSafeEq
+
+// NULLS_FIRST sorts NULL values of order by clause before non NULL values
+var NULLS_FIRST = jet.NULLS_FIRST
+
+// NULLS_LAST sorts NULL values of order by clause after non NULL values
+var NULLS_LAST = jet.NULLS_LAST
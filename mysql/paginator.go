@@ -0,0 +1,16 @@
+package mysql
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// Page contains pagination metadata of the queried page
+type Page = jet.Page
+
+// Paginator queries select statement results page by page, either in offset mode (default), or in keyset mode.
+// Paginator never modifies the statement. In offset mode page LIMIT and OFFSET are applied on top of the statement
+// LIMIT and OFFSET, if any. In keyset mode statement is wrapped in a sub-query, ordered by keyset columns.
+type Paginator = jet.Paginator
+
+// NewPaginator creates new offset mode paginator of statement, with pageSize rows per page
+func NewPaginator(statement SelectStatement, pageSize int64) Paginator {
+	return jet.NewPaginator(Dialect, statement, pageSize, nil)
+}
@@ -19,14 +19,18 @@ var (
 	ORDER_BY     = jet.ORDER_BY
 	UNBOUNDED    = jet.UNBOUNDED
 	CURRENT_ROW  = jet.CURRENT_ROW
+	ROWS         = jet.ROWS
+	RANGE        = jet.RANGE
 )
 
-// PRECEDING window frame clause
+// PRECEDING window frame clause. Offset is an integer, UNBOUNDED, or an expression (for instance interval
+// expression for RANGE frames over date and time columns).
 func PRECEDING(offset interface{}) jet.FrameExtent {
 	return jet.PRECEDING(toJetFrameOffset(offset))
 }
 
-// FOLLOWING window frame clause
+// FOLLOWING window frame clause. Offset is an integer, UNBOUNDED, or an expression (for instance interval
+// expression for RANGE frames over date and time columns).
 func FOLLOWING(offset interface{}) jet.FrameExtent {
 	return jet.FOLLOWING(toJetFrameOffset(offset))
 }
@@ -44,9 +48,15 @@ type SelectStatement interface {
 	FROM(tables ...ReadableTable) SelectStatement
 	WHERE(expression BoolExpression) SelectStatement
 	GROUP_BY(groupByClauses ...GroupByClause) SelectStatement
+	// GROUP_BY_NON_AGGREGATED groups by all the projections referencing columns and not containing aggregate or
+	// window functions, followed by additional groupByClauses. Aliased projections are grouped by their expression.
+	GROUP_BY_NON_AGGREGATED(groupByClauses ...GroupByClause) SelectStatement
 	HAVING(boolExpression BoolExpression) SelectStatement
 	WINDOW(name string) windowExpand
 	ORDER_BY(orderByClauses ...OrderByClause) SelectStatement
+	// APPLY appends projections, group by and order by lists of the clauses to the statement, and joins where and
+	// having conditions of the clauses with the statement conditions using AND operator
+	APPLY(clauses ...SelectClauses) SelectStatement
 	LIMIT(limit int64) SelectStatement
 	OFFSET(offset int64) SelectStatement
 	FOR(lock RowLock) SelectStatement
@@ -117,6 +127,13 @@ func (s *selectStatementImpl) WHERE(condition BoolExpression) SelectStatement {
 
 func (s *selectStatementImpl) GROUP_BY(groupByClauses ...GroupByClause) SelectStatement {
 	s.GroupBy.List = groupByClauses
+	s.GroupBy.NonAggregatedProjections = nil
+	return s
+}
+
+func (s *selectStatementImpl) GROUP_BY_NON_AGGREGATED(groupByClauses ...GroupByClause) SelectStatement {
+	s.GroupBy.List = groupByClauses
+	s.GroupBy.NonAggregatedProjections = s.Select.ProjectionList
 	return s
 }
 
@@ -135,6 +152,13 @@ func (s *selectStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) SelectSt
 	return s
 }
 
+func (s *selectStatementImpl) APPLY(clauses ...SelectClauses) SelectStatement {
+	for _, clause := range clauses {
+		clause.ApplyTo(&s.Select, &s.Where, &s.GroupBy, &s.Having, &s.OrderBy)
+	}
+	return s
+}
+
 func (s *selectStatementImpl) LIMIT(limit int64) SelectStatement {
 	s.Limit.Count = limit
 	return s
@@ -179,10 +203,9 @@ func toJetFrameOffset(offset interface{}) jet.Serializer {
 		return jet.UNBOUNDED
 	}
 
-	// check for interval expression
-	//if exp, ok := offset.(Expression); ok {
-	//	return exp
-	//}
+	if exp, ok := offset.(jet.Serializer); ok {
+		return exp
+	}
 
 	return jet.FixedLiteral(offset)
 }
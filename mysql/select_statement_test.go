@@ -95,6 +95,11 @@ ORDER BY table2.col_int DESC;
 SELECT table2.col_float AS "table2.col_float"
 FROM db.table2
 ORDER BY table2.col_int DESC, table2.col_int ASC;
+`)
+	assertStatementSql(t, SELECT(table2ColFloat).FROM(table2).ORDER_BY(NULLS_LAST(table2ColInt.DESC()), NULLS_FIRST(table2ColFloat)), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY (CASE WHEN table2.col_int IS NULL THEN 1 ELSE 0 END), table2.col_int DESC, (CASE WHEN table2.col_float IS NULL THEN 0 ELSE 1 END), table2.col_float;
 `)
 }
 
@@ -122,6 +127,13 @@ FOR UPDATE;
 SELECT table1.col_bool AS "table1.col_bool"
 FROM db.table1
 FOR SHARE NOWAIT;
+`)
+	testutils.AssertStatementSql(t, SELECT(table1ColBool).FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+		FOR(UPDATE().OF(table1).SKIP_LOCKED()), `
+SELECT table1.col_bool AS "table1.col_bool"
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+FOR UPDATE OF table1 SKIP LOCKED;
 `)
 }
 
@@ -154,3 +166,15 @@ WHERE NOT (EXISTS (
       ));
 `)
 }
+
+func TestSelectWindowFrame(t *testing.T) {
+	assertStatementSql(t,
+		SELECT(
+			SUMi(table1ColInt).OVER(ROWS(PRECEDING(UNBOUNDED), CURRENT_ROW)),
+			COUNT(STAR).OVER(ORDER_BY(table1ColTimestamp).RANGE(PRECEDING(INTERVAL(1, DAY)), CURRENT_ROW)),
+		).FROM(table1), `
+SELECT SUM(table1.col_int) OVER (ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW),
+     COUNT(*) OVER (ORDER BY table1.col_timestamp RANGE BETWEEN INTERVAL 1 DAY PRECEDING AND CURRENT ROW)
+FROM db.table1;
+`)
+}
@@ -1,6 +1,12 @@
 package mysql
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"errors"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
 
 // UpdateStatement is interface of SQL UPDATE statement
 type UpdateStatement interface {
@@ -10,15 +16,19 @@ type UpdateStatement interface {
 	MODEL(data interface{}) UpdateStatement
 
 	WHERE(expression BoolExpression) UpdateStatement
+	ORDER_BY(orderByClauses ...OrderByClause) UpdateStatement
+	LIMIT(limit int64) UpdateStatement
 }
 
 type updateStatementImpl struct {
 	jet.SerializerStatement
 
-	Update jet.ClauseUpdate
-	Set    jet.SetClause
-	SetNew jet.SetClauseNew
-	Where  jet.ClauseWhere
+	Update  jet.ClauseUpdate
+	Set     jet.SetClause
+	SetNew  jet.SetClauseNew
+	Where   jet.ClauseWhere
+	OrderBy jet.ClauseOrderBy
+	Limit   jet.ClauseLimit
 }
 
 func newUpdateStatement(table Table, columns []jet.Column) UpdateStatement {
@@ -27,11 +37,14 @@ func newUpdateStatement(table Table, columns []jet.Column) UpdateStatement {
 		&update.Update,
 		&update.Set,
 		&update.SetNew,
-		&update.Where)
+		&update.Where,
+		&update.OrderBy,
+		&update.Limit)
 
 	update.Update.Table = table
 	update.Set.Columns = columns
 	update.Where.Mandatory = true
+	update.Limit.Count = -1
 
 	return update
 }
@@ -60,3 +73,51 @@ func (u *updateStatementImpl) WHERE(expression BoolExpression) UpdateStatement {
 	u.Where.Condition = expression
 	return u
 }
+
+func (u *updateStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) UpdateStatement {
+	u.OrderBy.List = orderByClauses
+	return u
+}
+
+func (u *updateStatementImpl) LIMIT(limit int64) UpdateStatement {
+	u.Limit.Count = limit
+	return u
+}
+
+// UpdateAndReload executes update statement, and refreshes model (pointer to struct, or pointer to slice for multiple
+// rows update) with the updated row values. MySQL does not support RETURNING clause, so updated rows are selected
+// again with the statement WHERE condition, in the same transaction. Update of the columns used in WHERE condition
+// can not be reloaded this way, because updated rows would not satisfy the condition anymore.
+func UpdateAndReload(ctx context.Context, db qrm.DB, stmt UpdateStatement, model interface{}) error {
+	update, ok := stmt.(*updateStatementImpl)
+
+	if !ok {
+		panic("jet: UpdateAndReload requires UPDATE statement created with table UPDATE method")
+	}
+
+	table, ok := update.Update.Table.(ReadableTable)
+
+	if !ok {
+		return errors.New("jet: UpdateAndReload requires readable table")
+	}
+
+	if update.Limit.Count >= 0 {
+		return errors.New("jet: UpdateAndReload does not support UPDATE statement with LIMIT")
+	}
+
+	projections := update.Update.Projections()
+
+	if len(projections) == 0 || update.Where.Condition == nil {
+		return errors.New("jet: UpdateAndReload requires table columns and WHERE condition")
+	}
+
+	return inTransaction(ctx, db, func(db qrm.DB) error {
+		if _, err := update.ExecContext(ctx, db); err != nil {
+			return err
+		}
+
+		return table.SELECT(projections[0], projections[1:]...).
+			WHERE(update.Where.Condition).
+			QueryContext(ctx, db, model)
+	})
+}
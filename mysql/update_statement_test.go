@@ -1,9 +1,13 @@
 package mysql
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestUpdateWithOneValue(t *testing.T) {
@@ -37,6 +41,16 @@ WHERE table1.col_int >= ?;
 	assertStatementSql(t, stmt, expectedSQL, 1, 22.2, int64(33))
 }
 
+func TestUpdateWithOrderByLimit(t *testing.T) {
+	assertStatementSql(t, table1.UPDATE(table1ColInt).SET(1).WHERE(table1ColInt.GT_EQ(Int(33))).ORDER_BY(table1Col1.DESC()).LIMIT(100), `
+UPDATE db.table1
+SET col_int = ?
+WHERE table1.col_int >= ?
+ORDER BY table1.col1 DESC
+LIMIT ?;
+`, 1, int64(33), int64(100))
+}
+
 func TestUpdateOneColumnWithSelect(t *testing.T) {
 	expectedSQL := `
 UPDATE db.table1
@@ -80,3 +94,27 @@ func TestInvalidInputs(t *testing.T) {
 	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
 	assertStatementSqlErr(t, table1.UPDATE(nil).SET(1), "jet: nil column in columns list for SET clause")
 }
+
+func TestUpdateAndReload(t *testing.T) {
+	returning.statements = nil
+
+	db, err := sql.Open("jet_mysql_returning", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	var dest []struct {
+		ColInt int64 `alias:"table1.col_int"`
+	}
+
+	stmt := table3.UPDATE(table3StrCol).SET(String("new")).WHERE(table3ColInt.GT(Int(5)))
+
+	require.NoError(t, UpdateAndReload(context.Background(), db, stmt, &dest))
+	require.Len(t, dest, 2)
+	require.Equal(t, []string{
+		"BEGIN",
+		"\nUPDATE db.table3\nSET col2 = ?\nWHERE table3.col_int > ?;\n",
+		"\nSELECT table3.col1 AS \"table3.col1\",\n     table3.col_int AS \"table3.col_int\",\n     table3.col2 AS \"table3.col2\"\n" +
+			"FROM db.table3\nWHERE table3.col_int > ?;\n",
+		"COMMIT",
+	}, returning.statements)
+}
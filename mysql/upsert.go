@@ -0,0 +1,31 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// UpsertOrGet executes single row INSERT ON DUPLICATE KEY UPDATE statement, and reports whether the row was inserted
+// or already existed. Because MySQL INSERT statement does not return rows, get statement is executed afterwards to
+// scan the row into destination. To get the existing row in the same state, both statements should be executed
+// in the same transaction.
+// Inserted row is recognized by the number of affected rows (1 for inserted, 2 for updated and 0 for unchanged row),
+// so the connection should not be opened with CLIENT_FOUND_ROWS flag (clientFoundRows=true).
+func UpsertOrGet(ctx context.Context, db qrm.DB, stmt InsertStatement, get SelectStatement, destination interface{}) (inserted bool, err error) {
+	res, err := stmt.ExecContext(ctx, db)
+
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+
+	if err != nil {
+		return false, err
+	}
+
+	err = get.QueryContext(ctx, db, destination)
+
+	return rowsAffected == 1, err
+}
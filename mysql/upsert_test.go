@@ -0,0 +1,62 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type execRecorder struct {
+	queries      []string
+	rowsAffected int64
+}
+
+func (r *execRecorder) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return r.ExecContext(context.Background(), query, args...)
+}
+
+func (r *execRecorder) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	r.queries = append(r.queries, query)
+	return driverResult(r.rowsAffected), nil
+}
+
+func (r *execRecorder) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return r.QueryContext(context.Background(), query, args...)
+}
+
+func (r *execRecorder) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	r.queries = append(r.queries, query)
+	return nil, sql.ErrConnDone
+}
+
+type driverResult int64
+
+func (r driverResult) LastInsertId() (int64, error) { return 0, nil }
+func (r driverResult) RowsAffected() (int64, error) { return int64(r), nil }
+
+func TestUpsertOrGet(t *testing.T) {
+	stmt := table1.INSERT(table1Col1).VALUES(1).ON_DUPLICATE_KEY_UPDATE(table1Col1.SET(table1Col1))
+	get := SELECT(table1Col1).FROM(table1).WHERE(table1Col1.EQ(Int(1)))
+
+	var dest struct{ Col1 int64 }
+
+	db := &execRecorder{rowsAffected: 1}
+	inserted, err := UpsertOrGet(context.Background(), db, stmt, get, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.True(t, inserted)
+	require.Equal(t, []string{`
+INSERT INTO db.table1 (col1)
+VALUES (?)
+ON DUPLICATE KEY UPDATE col1 = col1;
+`, `
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+WHERE table1.col1 = ?;
+`}, db.queries)
+
+	inserted, _ = UpsertOrGet(context.Background(), &execRecorder{rowsAffected: 2}, stmt, get, &dest)
+	require.False(t, inserted)
+}
@@ -0,0 +1,91 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// Vitess contains Vitess (PlanetScale) keyspace sharding information. It is used to check statements for constructs
+// not supported by Vitess, and for statements that would be executed across multiple shards.
+type Vitess struct {
+	// ShardingColumns maps sharded table name to the name of its sharding (primary vindex) column
+	ShardingColumns map[string]string
+}
+
+// Warnings returns list of statement incompatibilities with Vitess. Empty list means statement is shard-safe.
+func (v Vitess) Warnings(statement PrintableStatement) []string {
+	var warnings []string
+
+	references := jet.StatementReferences(Dialect, statement)
+
+	var shardedTables []string
+
+	for _, table := range references.Tables {
+		shardingColumn, sharded := v.ShardingColumns[table.TableName]
+
+		if !sharded {
+			continue
+		}
+
+		shardedTables = append(shardedTables, table.TableName)
+
+		if references.StatementType == jet.InsertStatementType {
+			continue // rows are routed to shards by inserted sharding column values
+		}
+
+		tableName := table.TableName
+		if table.Alias != "" {
+			tableName = table.Alias
+		}
+
+		if !isFilteredBy(references.ConjunctFilters, table.Statement, tableName, shardingColumn) {
+			warnings = append(warnings, fmt.Sprintf("cross-shard query: table %s is not filtered by sharding column %s",
+				table.TableName, shardingColumn))
+		}
+	}
+
+	if references.SubQueries > 0 && len(shardedTables) > 0 {
+		warnings = append(warnings, fmt.Sprintf("sub-queries over sharded tables (%s) might not be supported by Vitess",
+			strings.Join(shardedTables, ", ")))
+	}
+
+	if len(v.ShardingColumns) > 0 && callsFunction(references.Functions, "LAST_INSERT_ID") {
+		warnings = append(warnings, "LAST_INSERT_ID is not supported for sharded keyspaces, use Vitess sequences instead")
+	}
+
+	return warnings
+}
+
+// LoggerFunc returns statement logger function which reports Vitess warnings of every executed statement to
+// warningFunc. Register it with SetLogger to surface Vitess incompatibilities at runtime.
+func (v Vitess) LoggerFunc(warningFunc func(ctx context.Context, statement PrintableStatement, warnings []string)) func(ctx context.Context, statement PrintableStatement) {
+	return func(ctx context.Context, statement PrintableStatement) {
+		if warnings := v.Warnings(statement); len(warnings) > 0 {
+			warningFunc(ctx, statement, warnings)
+		}
+	}
+}
+
+// isFilteredBy returns true if table column is filtered in the same statement (or sub-query) table is referenced in
+func isFilteredBy(filters []jet.ConjunctFilter, statement int, tableName, columnName string) bool {
+	for _, filter := range filters {
+		if filter.Statement == statement && filter.Column.TableName == tableName && filter.Column.ColumnName == columnName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func callsFunction(functions []string, name string) bool {
+	for _, function := range functions {
+		if strings.EqualFold(function, name) {
+			return true
+		}
+	}
+
+	return false
+}
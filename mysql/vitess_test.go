@@ -0,0 +1,109 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var vitess = Vitess{
+	ShardingColumns: map[string]string{
+		"table1": "col1",
+		"table2": "col3",
+	},
+}
+
+func TestVitessWarnings_ShardSafe(t *testing.T) {
+	require.Empty(t, vitess.Warnings(
+		SELECT(table1ColInt).FROM(table1).WHERE(table1Col1.EQ(Int(1))),
+	))
+	require.Empty(t, vitess.Warnings(
+		table2.UPDATE(table2ColInt).SET(Int(2)).WHERE(table2Col3.IN(Int(1), Int(2))),
+	))
+	require.Empty(t, vitess.Warnings(
+		table1.INSERT(table1Col1, table1ColInt).VALUES(1, 2),
+	))
+	require.Empty(t, vitess.Warnings(
+		SELECT(table3ColInt).FROM(table3),
+	))
+}
+
+func TestVitessWarnings_CrossShard(t *testing.T) {
+	require.Equal(t, []string{
+		"cross-shard query: table table1 is not filtered by sharding column col1",
+		"cross-shard query: table table2 is not filtered by sharding column col3",
+	}, vitess.Warnings(
+		SELECT(table1ColInt, table2ColInt).
+			FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+			WHERE(table1ColInt.EQ(Int(1))),
+	))
+}
+
+func TestVitessWarnings_NonRoutingPredicates(t *testing.T) {
+	crossShard := []string{"cross-shard query: table table1 is not filtered by sharding column col1"}
+
+	require.Equal(t, crossShard, vitess.Warnings(
+		SELECT(table1ColInt).FROM(table1).WHERE(table1Col1.EQ(Int(1)).OR(table1ColInt.EQ(Int(2)))),
+	))
+	require.Equal(t, crossShard, vitess.Warnings(
+		SELECT(table1ColInt).FROM(table1).WHERE(NOT(table1Col1.EQ(Int(1)))),
+	))
+	require.Equal(t, crossShard, vitess.Warnings(
+		SELECT(table1ColInt).FROM(table1).WHERE(table1Col1.GT(Int(1))),
+	))
+	require.Equal(t, crossShard, vitess.Warnings(
+		SELECT(table1ColInt).FROM(table1).WHERE(table1Col1.EQ(table1ColInt)),
+	))
+	require.Empty(t, vitess.Warnings(
+		SELECT(table1ColInt).FROM(table1).WHERE(AND(table1ColBool.IS_TRUE(), table1Col1.IN(Int(1), Int(2)))),
+	))
+}
+
+func TestVitessWarnings_LastInsertID(t *testing.T) {
+	require.Equal(t, []string{
+		"LAST_INSERT_ID is not supported for sharded keyspaces, use Vitess sequences instead",
+	}, vitess.Warnings(
+		SELECT(LAST_INSERT_ID()).FROM(table3),
+	))
+	require.Empty(t, vitess.Warnings(
+		SELECT(table3ColInt).FROM(table3).WHERE(table3StrCol.EQ(String("LAST_INSERT_ID()"))),
+	))
+}
+
+func TestVitessWarnings_SubQuery(t *testing.T) {
+	require.Equal(t, []string{
+		"sub-queries over sharded tables (table1, table2) might not be supported by Vitess",
+	}, vitess.Warnings(
+		SELECT(table1ColInt).
+			FROM(table1).
+			WHERE(table1Col1.EQ(Int(1)).AND(table1ColInt.IN(
+				SELECT(table2ColInt).FROM(table2).WHERE(table2Col3.EQ(Int(2))),
+			))),
+	))
+}
+
+func TestVitessWarnings_SubQueryFilterScope(t *testing.T) {
+	require.Equal(t, []string{
+		"cross-shard query: table table1 is not filtered by sharding column col1",
+		"sub-queries over sharded tables (table1, table1) might not be supported by Vitess",
+	}, vitess.Warnings(
+		SELECT(table1ColInt).
+			FROM(table1).
+			WHERE(table1ColInt.IN(
+				SELECT(table1ColInt).FROM(table1).WHERE(table1Col1.EQ(Int(1))),
+			)),
+	))
+}
+
+func TestVitessLoggerFunc(t *testing.T) {
+	var warnings []string
+
+	logger := vitess.LoggerFunc(func(ctx context.Context, statement PrintableStatement, w []string) {
+		warnings = w
+	})
+
+	logger(context.Background(), table1.DELETE().WHERE(table1ColBool.EQ(Bool(true))))
+
+	require.Equal(t, []string{"cross-shard query: table table1 is not filtered by sharding column col1"}, warnings)
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// APPROX_COUNT_DISTINCT is aggregate function. Returns approximate number of distinct expression values using
+// HyperLogLog algorithm. Requires postgresql-hll extension.
+func APPROX_COUNT_DISTINCT(expression Expression) IntegerExpression {
+	hllAgg := jet.NewFunc("hll_add_agg", []Expression{jet.NewFunc("hll_hash_any", []Expression{expression}, nil)}, nil)
+
+	return CAST(jet.NewFunc("hll_cardinality", []Expression{hllAgg}, nil)).AS_BIGINT()
+}
+
+// TABLESAMPLE_SYSTEM returns table sample of approximately samplePercentage percent of table rows, using
+// block level sampling. It is fast, but sample can be less random, because all the rows of the sampled block are returned.
+func TABLESAMPLE_SYSTEM(table ReadableTable, samplePercentage float64) ReadableTable {
+	return newTableSample(table, "SYSTEM", samplePercentage)
+}
+
+// TABLESAMPLE_BERNOULLI returns table sample of approximately samplePercentage percent of table rows, using
+// row level sampling. It is slower than TABLESAMPLE_SYSTEM, because the whole table is scanned.
+func TABLESAMPLE_BERNOULLI(table ReadableTable, samplePercentage float64) ReadableTable {
+	return newTableSample(table, "BERNOULLI", samplePercentage)
+}
+
+// ESTIMATED_COUNT returns select statement estimating the number of table rows by counting the rows of
+// SYSTEM table sample of samplePercentage percent. Result column is aliased as "count".
+func ESTIMATED_COUNT(table ReadableTable, samplePercentage float64) SelectStatement {
+	if samplePercentage <= 0 || samplePercentage > 100 {
+		panic("jet: sample percentage has to be in range (0, 100]")
+	}
+
+	return SELECT(
+		CAST(FloatExp(COUNT(STAR)).MUL(Float(100 / samplePercentage))).AS_BIGINT().AS("count"),
+	).FROM(
+		TABLESAMPLE_SYSTEM(table, samplePercentage),
+	)
+}
+
+// FAST_COUNT returns estimated number of table rows from the planner statistics (pg_class.reltuples). It does not
+// scan the table, but the estimation is only as recent as the last VACUUM or ANALYZE of the table.
+func FAST_COUNT(table jet.Table) IntegerExpression {
+	tableName := quoteIdentifier(table.TableName())
+
+	if table.SchemaName() != "" {
+		tableName = quoteIdentifier(table.SchemaName()) + "." + tableName
+	}
+
+	return RawInt("SELECT GREATEST(reltuples, 0)::bigint FROM pg_catalog.pg_class WHERE oid = #table::regclass",
+		RawArgs{"#table": tableName})
+}
+
+type tableSample struct {
+	readableTableInterfaceImpl
+	jet.Serializer
+}
+
+func newTableSample(table ReadableTable, method string, samplePercentage float64) ReadableTable {
+	newTableSample := &tableSample{
+		Serializer: jet.NewSerializerClauseImpl(&tableSampleClause{
+			table:            table,
+			method:           method,
+			samplePercentage: samplePercentage,
+		}),
+	}
+
+	newTableSample.readableTableInterfaceImpl.parent = newTableSample
+
+	return newTableSample
+}
+
+type tableSampleClause struct {
+	table            ReadableTable
+	method           string
+	samplePercentage float64
+}
+
+func (t *tableSampleClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	jet.Serialize(t.table, statementType, out, options...)
+	out.WriteString("TABLESAMPLE " + t.method + " (")
+	jet.Serialize(Float(t.samplePercentage), statementType, out)
+	out.WriteString(")")
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestAPPROX_COUNT_DISTINCT(t *testing.T) {
+	assertSerialize(t, APPROX_COUNT_DISTINCT(table1ColInt),
+		"hll_cardinality(hll_add_agg(hll_hash_any(table1.col_int)))::bigint")
+}
+
+func TestTABLESAMPLE(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt).FROM(TABLESAMPLE_SYSTEM(table1, 2.5)), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1 TABLESAMPLE SYSTEM ($1);
+`, 2.5)
+	assertStatementSql(t, SELECT(table1ColInt).
+		FROM(TABLESAMPLE_BERNOULLI(table1, 5).INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1 TABLESAMPLE BERNOULLI ($1)
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int);
+`, float64(5))
+}
+
+func TestESTIMATED_COUNT(t *testing.T) {
+	assertStatementSql(t, ESTIMATED_COUNT(table1, 2), `
+SELECT (COUNT(*) * $1)::bigint AS "count"
+FROM db.table1 TABLESAMPLE SYSTEM ($2);
+`, float64(50), float64(2))
+
+	assertPanicErr(t, func() { ESTIMATED_COUNT(table1, 0) }, "jet: sample percentage has to be in range (0, 100]")
+}
+
+func TestFAST_COUNT(t *testing.T) {
+	assertSerialize(t, FAST_COUNT(table1),
+		`(SELECT GREATEST(reltuples, 0)::bigint FROM pg_catalog.pg_class WHERE oid = $1::regclass)`, `"db"."table1"`)
+	assertSerialize(t, FAST_COUNT(NewTable("", `my "table"`, "")),
+		`(SELECT GREATEST(reltuples, 0)::bigint FROM pg_catalog.pg_class WHERE oid = $1::regclass)`, `"my ""table"""`)
+}
@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// StringArrayExpression is representation of text (and character varying) array expressions
+type StringArrayExpression interface {
+	jet.Expression
+
+	isStringArray()
+
+	EQ(rhs StringArrayExpression) BoolExpression
+	NOT_EQ(rhs StringArrayExpression) BoolExpression
+
+	// CONTAINS returns true if this array contains all the elements of rhs array (@> operator)
+	CONTAINS(rhs StringArrayExpression) BoolExpression
+	// CONTAINED_BY returns true if all the elements of this array are contained in rhs array (<@ operator)
+	CONTAINED_BY(rhs StringArrayExpression) BoolExpression
+	// OVERLAP returns true if arrays have any elements in common (&& operator)
+	OVERLAP(rhs StringArrayExpression) BoolExpression
+	// ANY_EQ returns true if element is equal to any of the array elements (element = ANY(array))
+	ANY_EQ(element StringExpression) BoolExpression
+
+	// CONCAT concatenates two arrays (|| operator)
+	CONCAT(rhs StringArrayExpression) StringArrayExpression
+	// APPEND appends element to the end of the array (|| operator)
+	APPEND(element StringExpression) StringArrayExpression
+	// AT returns array element at index. Arrays are indexed from 1.
+	AT(index IntegerExpression) StringExpression
+}
+
+type stringArrayInterfaceImpl struct {
+	parent StringArrayExpression
+}
+
+func (a *stringArrayInterfaceImpl) isStringArray() {}
+
+func (a *stringArrayInterfaceImpl) EQ(rhs StringArrayExpression) BoolExpression {
+	return jet.Eq(a.parent, rhs)
+}
+
+func (a *stringArrayInterfaceImpl) NOT_EQ(rhs StringArrayExpression) BoolExpression {
+	return jet.NotEq(a.parent, rhs)
+}
+
+func (a *stringArrayInterfaceImpl) CONTAINS(rhs StringArrayExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "@>"))
+}
+
+func (a *stringArrayInterfaceImpl) CONTAINED_BY(rhs StringArrayExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "<@"))
+}
+
+func (a *stringArrayInterfaceImpl) OVERLAP(rhs StringArrayExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "&&"))
+}
+
+func (a *stringArrayInterfaceImpl) ANY_EQ(element StringExpression) BoolExpression {
+	return jet.Eq(element, jet.Func("ANY", a.parent))
+}
+
+func (a *stringArrayInterfaceImpl) CONCAT(rhs StringArrayExpression) StringArrayExpression {
+	return StringArrayExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "||"))
+}
+
+func (a *stringArrayInterfaceImpl) APPEND(element StringExpression) StringArrayExpression {
+	return StringArrayExp(jet.NewBinaryOperatorExpression(a.parent, explicitLiteralCast(element), "||"))
+}
+
+func (a *stringArrayInterfaceImpl) AT(index IntegerExpression) StringExpression {
+	return StringExp(jet.NewSubscriptExpression(a.parent, index))
+}
+
+//---------------------------------------------------//
+
+// IntegerArrayExpression is representation of smallint, integer and bigint array expressions
+type IntegerArrayExpression interface {
+	jet.Expression
+
+	isIntegerArray()
+
+	EQ(rhs IntegerArrayExpression) BoolExpression
+	NOT_EQ(rhs IntegerArrayExpression) BoolExpression
+
+	// CONTAINS returns true if this array contains all the elements of rhs array (@> operator)
+	CONTAINS(rhs IntegerArrayExpression) BoolExpression
+	// CONTAINED_BY returns true if all the elements of this array are contained in rhs array (<@ operator)
+	CONTAINED_BY(rhs IntegerArrayExpression) BoolExpression
+	// OVERLAP returns true if arrays have any elements in common (&& operator)
+	OVERLAP(rhs IntegerArrayExpression) BoolExpression
+	// ANY_EQ returns true if element is equal to any of the array elements (element = ANY(array))
+	ANY_EQ(element IntegerExpression) BoolExpression
+
+	// CONCAT concatenates two arrays (|| operator)
+	CONCAT(rhs IntegerArrayExpression) IntegerArrayExpression
+	// APPEND appends element to the end of the array (|| operator)
+	APPEND(element IntegerExpression) IntegerArrayExpression
+	// AT returns array element at index. Arrays are indexed from 1.
+	AT(index IntegerExpression) IntegerExpression
+}
+
+type integerArrayInterfaceImpl struct {
+	parent IntegerArrayExpression
+}
+
+func (a *integerArrayInterfaceImpl) isIntegerArray() {}
+
+func (a *integerArrayInterfaceImpl) EQ(rhs IntegerArrayExpression) BoolExpression {
+	return jet.Eq(a.parent, rhs)
+}
+
+func (a *integerArrayInterfaceImpl) NOT_EQ(rhs IntegerArrayExpression) BoolExpression {
+	return jet.NotEq(a.parent, rhs)
+}
+
+func (a *integerArrayInterfaceImpl) CONTAINS(rhs IntegerArrayExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "@>"))
+}
+
+func (a *integerArrayInterfaceImpl) CONTAINED_BY(rhs IntegerArrayExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "<@"))
+}
+
+func (a *integerArrayInterfaceImpl) OVERLAP(rhs IntegerArrayExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "&&"))
+}
+
+func (a *integerArrayInterfaceImpl) ANY_EQ(element IntegerExpression) BoolExpression {
+	return jet.Eq(element, jet.Func("ANY", a.parent))
+}
+
+func (a *integerArrayInterfaceImpl) CONCAT(rhs IntegerArrayExpression) IntegerArrayExpression {
+	return IntegerArrayExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "||"))
+}
+
+func (a *integerArrayInterfaceImpl) APPEND(element IntegerExpression) IntegerArrayExpression {
+	return IntegerArrayExp(jet.NewBinaryOperatorExpression(a.parent, explicitLiteralCast(element), "||"))
+}
+
+func (a *integerArrayInterfaceImpl) AT(index IntegerExpression) IntegerExpression {
+	return IntExp(jet.NewSubscriptExpression(a.parent, index))
+}
+
+//---------------------------------------------------//
+
+// StringArray creates new text array literal expression
+func StringArray(elements ...string) StringArrayExpression {
+	return textArray(elements)
+}
+
+// IntegerArray creates new bigint array literal expression
+func IntegerArray(elements ...int64) IntegerArrayExpression {
+	var arrayText strings.Builder
+
+	arrayText.WriteByte('{')
+
+	for i, element := range elements {
+		if i > 0 {
+			arrayText.WriteByte(',')
+		}
+		arrayText.WriteString(strconv.FormatInt(element, 10))
+	}
+
+	arrayText.WriteByte('}')
+
+	return CAST(String(arrayText.String())).AS_BIGINT_ARRAY()
+}
+
+var arrayElementEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// textArray creates text[] literal from the list of strings. Array is passed as a single parametrized argument
+// in postgres array text representation, so it is supported by all the postgres drivers.
+func textArray(elements []string) StringArrayExpression {
+	var arrayText strings.Builder
+
+	arrayText.WriteByte('{')
+
+	for i, element := range elements {
+		if i > 0 {
+			arrayText.WriteByte(',')
+		}
+
+		arrayText.WriteByte('"')
+		arrayText.WriteString(arrayElementEscaper.Replace(element))
+		arrayText.WriteByte('"')
+	}
+
+	arrayText.WriteByte('}')
+
+	return CAST(String(arrayText.String())).AS_TEXT_ARRAY()
+}
+
+// UNNEST expands array to a set of rows, one row per array element
+func UNNEST(array Expression) Expression {
+	return jet.Func("UNNEST", array)
+}
+
+// CARDINALITY returns total number of elements in the array, or 0 if the array is empty
+func CARDINALITY(array Expression) IntegerExpression {
+	return IntExp(jet.Func("CARDINALITY", array))
+}
+
+// ARRAY_LENGTH returns length of the requested array dimension
+func ARRAY_LENGTH(array Expression, dimension IntegerExpression) IntegerExpression {
+	return IntExp(jet.Func("ARRAY_LENGTH", array, dimension))
+}
+
+//---------------------------------------------------//
+
+type stringArrayWrapper struct {
+	stringArrayInterfaceImpl
+	Expression
+}
+
+// StringArrayExp is text array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as text array expression.
+// Does not add sql cast to generated sql builder output.
+func StringArrayExp(expression Expression) StringArrayExpression {
+	arrayWrap := &stringArrayWrapper{Expression: expression}
+	arrayWrap.stringArrayInterfaceImpl.parent = arrayWrap
+	return arrayWrap
+}
+
+type integerArrayWrapper struct {
+	integerArrayInterfaceImpl
+	Expression
+}
+
+// IntegerArrayExp is integer array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as integer array expression.
+// Does not add sql cast to generated sql builder output.
+func IntegerArrayExp(expression Expression) IntegerArrayExpression {
+	arrayWrap := &integerArrayWrapper{Expression: expression}
+	arrayWrap.integerArrayInterfaceImpl.parent = arrayWrap
+	return arrayWrap
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"testing"
+)
+
+var postTags = StringArrayColumn("tags")
+var postScores = IntegerArrayColumn("scores")
+var posts = NewTable("db", "posts", "", postTags, postScores)
+
+func TestStringArrayExpression(t *testing.T) {
+	assertSerialize(t, StringArray("a", `b"c`), "$1::text[]", `{"a","b\"c"}`)
+	assertSerialize(t, postTags.EQ(StringArray()), "(posts.tags = $1::text[])", `{}`)
+	assertSerialize(t, postTags.NOT_EQ(StringArray("a")), "(posts.tags != $1::text[])", `{"a"}`)
+	assertSerialize(t, postTags.CONTAINS(StringArray("go")), "(posts.tags @> $1::text[])", `{"go"}`)
+	assertSerialize(t, postTags.CONTAINED_BY(StringArray("go")), "(posts.tags <@ $1::text[])", `{"go"}`)
+	assertSerialize(t, postTags.OVERLAP(StringArray("go")), "(posts.tags && $1::text[])", `{"go"}`)
+	assertSerialize(t, postTags.ANY_EQ(String("go")), "($1 = ANY(posts.tags))", "go")
+	assertSerialize(t, postTags.CONCAT(StringArray("go")), "(posts.tags || $1::text[])", `{"go"}`)
+	assertSerialize(t, postTags.APPEND(String("go")), "(posts.tags || $1::text)", "go")
+	assertSerialize(t, postTags.AT(Int(1)).EQ(String("go")), "((posts.tags)[$1] = $2)", int64(1), "go")
+}
+
+func TestIntegerArrayExpression(t *testing.T) {
+	assertSerialize(t, IntegerArray(1, -2), "$1::bigint[]", "{1,-2}")
+	assertSerialize(t, postScores.EQ(IntegerArray()), "(posts.scores = $1::bigint[])", "{}")
+	assertSerialize(t, postScores.CONTAINS(IntegerArray(1)), "(posts.scores @> $1::bigint[])", "{1}")
+	assertSerialize(t, postScores.OVERLAP(IntegerArray(1)), "(posts.scores && $1::bigint[])", "{1}")
+	assertSerialize(t, postScores.ANY_EQ(Int(10)), "($1 = ANY(posts.scores))", int64(10))
+	assertSerialize(t, postScores.APPEND(Int(10)), "(posts.scores || $1::integer)", int64(10))
+	assertSerialize(t, postScores.AT(Int(2)).ADD(Int(1)), "((posts.scores)[$1] + $2)", int64(2), int64(1))
+	assertSerialize(t, CAST(String("{1}")).AS_INTEGER_ARRAY(), "$1::integer[]", "{1}")
+}
+
+func TestArrayFunctions(t *testing.T) {
+	assertSerialize(t, UNNEST(postTags), "UNNEST(posts.tags)")
+	assertSerialize(t, CARDINALITY(postScores), "CARDINALITY(posts.scores)")
+	assertSerialize(t, ARRAY_LENGTH(postScores, Int(1)), "ARRAY_LENGTH(posts.scores, $1)", int64(1))
+}
+
+func TestArrayColumnUpdate(t *testing.T) {
+	assertStatementSql(t, posts.UPDATE().SET(postTags.SET(postTags.APPEND(String("go")))).
+		WHERE(postScores.ANY_EQ(Int(1))), `
+UPDATE db.posts
+SET tags = (posts.tags || $1::text)
+WHERE $2 = ANY(posts.scores);
+`, "go", int64(1))
+}
@@ -43,6 +43,18 @@ type cast interface {
 	AS_TIMESTAMPZ() TimestampzExpression
 	// Cast expression AS interval type
 	AS_INTERVAL() IntervalExpression
+	// Cast expression AS vector type (pgvector extension)
+	AS_VECTOR() VectorExpression
+	// Cast expression AS ltree type (ltree extension)
+	AS_LTREE() LtreeExpression
+	// Cast expression AS jsonb type
+	AS_JSONB() JSONBExpression
+	// Cast expression AS text[] type
+	AS_TEXT_ARRAY() StringArrayExpression
+	// Cast expression AS integer[] type
+	AS_INTEGER_ARRAY() IntegerArrayExpression
+	// Cast expression AS bigint[] type
+	AS_BIGINT_ARRAY() IntegerArrayExpression
 }
 
 type castImpl struct {
@@ -159,3 +171,33 @@ func (b *castImpl) AS_TIMESTAMPZ() TimestampzExpression {
 func (b *castImpl) AS_INTERVAL() IntervalExpression {
 	return IntervalExp(b.AS("interval"))
 }
+
+// Cast expression AS vector type
+func (b *castImpl) AS_VECTOR() VectorExpression {
+	return VectorExp(b.AS("vector"))
+}
+
+// Cast expression AS ltree type
+func (b *castImpl) AS_LTREE() LtreeExpression {
+	return LtreeExp(b.AS("ltree"))
+}
+
+// Cast expression AS jsonb type
+func (b *castImpl) AS_JSONB() JSONBExpression {
+	return JSONBExp(b.AS("jsonb"))
+}
+
+// Cast expression AS text[] type
+func (b *castImpl) AS_TEXT_ARRAY() StringArrayExpression {
+	return StringArrayExp(b.AS("text[]"))
+}
+
+// Cast expression AS integer[] type
+func (b *castImpl) AS_INTEGER_ARRAY() IntegerArrayExpression {
+	return IntegerArrayExp(b.AS("integer[]"))
+}
+
+// Cast expression AS bigint[] type
+func (b *castImpl) AS_BIGINT_ARRAY() IntegerArrayExpression {
+	return IntegerArrayExp(b.AS("bigint[]"))
+}
@@ -18,7 +18,7 @@ type conflictTarget interface {
 type onConflictClause struct {
 	insertStatement  InsertStatement
 	constraint       string
-	indexExpressions []jet.ColumnExpression
+	indexExpressions []jet.Expression
 	whereClause      jet.ClauseWhere
 	do               jet.Serializer
 }
@@ -48,11 +48,12 @@ func (o *onConflictClause) Serialize(statementType jet.StatementType, out *jet.S
 		return
 	}
 
+	out.RequireFeature(jet.FeatureOnConflict)
 	out.NewLine()
 	out.WriteString("ON CONFLICT")
 	if len(o.indexExpressions) > 0 {
 		out.WriteString("(")
-		jet.SerializeColumnExpressions(o.indexExpressions, statementType, out, jet.ShortName)
+		jet.SerializeIndexExpressions(o.indexExpressions, statementType, out)
 		out.WriteString(")")
 	}
 
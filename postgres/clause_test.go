@@ -1,6 +1,10 @@
 package postgres
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
 
 func TestOnConflict(t *testing.T) {
 
@@ -10,17 +14,17 @@ func TestOnConflict(t *testing.T) {
 	onConflict.DO_NOTHING()
 	assertClauseSerialize(t, onConflict, "")
 
-	onConflict = &onConflictClause{indexExpressions: ColumnList{table1ColBool}}
+	onConflict = &onConflictClause{indexExpressions: []jet.Expression{table1ColBool}}
 	onConflict.DO_NOTHING()
 	assertClauseSerialize(t, onConflict, `
 ON CONFLICT (col_bool) DO NOTHING`)
 
-	onConflict = &onConflictClause{indexExpressions: ColumnList{table1ColBool}}
+	onConflict = &onConflictClause{indexExpressions: []jet.Expression{table1ColBool}}
 	onConflict.ON_CONSTRAINT("table_pkey").DO_NOTHING()
 	assertClauseSerialize(t, onConflict, `
 ON CONFLICT (col_bool) ON CONSTRAINT table_pkey DO NOTHING`)
 
-	onConflict = &onConflictClause{indexExpressions: ColumnList{table1ColBool, table2ColFloat}}
+	onConflict = &onConflictClause{indexExpressions: []jet.Expression{table1ColBool, table2ColFloat}}
 	onConflict.WHERE(table2ColFloat.ADD(table1ColInt).GT(table1ColFloat)).
 		DO_UPDATE(
 			SET(table1ColBool.SET(Bool(true)),
@@ -95,3 +95,204 @@ func IntervalColumn(name string) ColumnInterval {
 	intervalColumn.intervalInterfaceImpl.parent = intervalColumn
 	return intervalColumn
 }
+
+//------------------------------------------------------//
+
+// ColumnVector is interface of pgvector extension vector columns.
+type ColumnVector interface {
+	VectorExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnVector
+}
+
+type vectorColumnImpl struct {
+	jet.ColumnExpressionImpl
+	vectorInterfaceImpl
+}
+
+func (v *vectorColumnImpl) From(subQuery SelectTable) ColumnVector {
+	newVectorColumn := VectorColumn(v.Name())
+	jet.SetTableName(newVectorColumn, v.TableName())
+	jet.SetSubQuery(newVectorColumn, subQuery)
+
+	return newVectorColumn
+}
+
+// VectorColumn creates named vector column.
+func VectorColumn(name string) ColumnVector {
+	vectorColumn := &vectorColumnImpl{}
+	vectorColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", vectorColumn)
+	vectorColumn.vectorInterfaceImpl.parent = vectorColumn
+	return vectorColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnLtree is interface of ltree extension label tree columns.
+type ColumnLtree interface {
+	LtreeExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnLtree
+}
+
+type ltreeColumnImpl struct {
+	jet.ColumnExpressionImpl
+	ltreeInterfaceImpl
+}
+
+func (l *ltreeColumnImpl) From(subQuery SelectTable) ColumnLtree {
+	newLtreeColumn := LtreeColumn(l.Name())
+	jet.SetTableName(newLtreeColumn, l.TableName())
+	jet.SetSubQuery(newLtreeColumn, subQuery)
+
+	return newLtreeColumn
+}
+
+// LtreeColumn creates named ltree column.
+func LtreeColumn(name string) ColumnLtree {
+	ltreeColumn := &ltreeColumnImpl{}
+	ltreeColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", ltreeColumn)
+	ltreeColumn.ltreeInterfaceImpl.parent = ltreeColumn
+	return ltreeColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnRange is interface of range columns (int4range, int8range, numrange, tsrange, tstzrange and daterange).
+type ColumnRange interface {
+	RangeExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnRange
+}
+
+type rangeColumnImpl struct {
+	jet.ColumnExpressionImpl
+	rangeInterfaceImpl
+}
+
+func (r *rangeColumnImpl) From(subQuery SelectTable) ColumnRange {
+	newRangeColumn := RangeColumn(r.Name())
+	jet.SetTableName(newRangeColumn, r.TableName())
+	jet.SetSubQuery(newRangeColumn, subQuery)
+
+	return newRangeColumn
+}
+
+// RangeColumn creates named range column.
+func RangeColumn(name string) ColumnRange {
+	rangeColumn := &rangeColumnImpl{}
+	rangeColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", rangeColumn)
+	rangeColumn.rangeInterfaceImpl.parent = rangeColumn
+	return rangeColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnJSONB is interface of jsonb columns.
+type ColumnJSONB interface {
+	JSONBExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnJSONB
+	SET(jsonbExp JSONBExpression) ColumnAssigment
+}
+
+type jsonbColumnImpl struct {
+	jet.ColumnExpressionImpl
+	jsonbInterfaceImpl
+}
+
+func (j *jsonbColumnImpl) From(subQuery SelectTable) ColumnJSONB {
+	newJSONBColumn := JSONBColumn(j.Name())
+	jet.SetTableName(newJSONBColumn, j.TableName())
+	jet.SetSubQuery(newJSONBColumn, subQuery)
+
+	return newJSONBColumn
+}
+
+func (j *jsonbColumnImpl) SET(jsonbExp JSONBExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(j, jsonbExp)
+}
+
+// JSONBColumn creates named jsonb column.
+func JSONBColumn(name string) ColumnJSONB {
+	jsonbColumn := &jsonbColumnImpl{}
+	jsonbColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", jsonbColumn)
+	jsonbColumn.jsonbInterfaceImpl.parent = jsonbColumn
+	return jsonbColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnStringArray is interface of text (and character varying) array columns.
+type ColumnStringArray interface {
+	StringArrayExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnStringArray
+	SET(arrayExp StringArrayExpression) ColumnAssigment
+}
+
+type stringArrayColumnImpl struct {
+	jet.ColumnExpressionImpl
+	stringArrayInterfaceImpl
+}
+
+func (a *stringArrayColumnImpl) From(subQuery SelectTable) ColumnStringArray {
+	newStringArrayColumn := StringArrayColumn(a.Name())
+	jet.SetTableName(newStringArrayColumn, a.TableName())
+	jet.SetSubQuery(newStringArrayColumn, subQuery)
+
+	return newStringArrayColumn
+}
+
+func (a *stringArrayColumnImpl) SET(arrayExp StringArrayExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(a, arrayExp)
+}
+
+// StringArrayColumn creates named text array column.
+func StringArrayColumn(name string) ColumnStringArray {
+	arrayColumn := &stringArrayColumnImpl{}
+	arrayColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", arrayColumn)
+	arrayColumn.stringArrayInterfaceImpl.parent = arrayColumn
+	return arrayColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnIntegerArray is interface of smallint, integer and bigint array columns.
+type ColumnIntegerArray interface {
+	IntegerArrayExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnIntegerArray
+	SET(arrayExp IntegerArrayExpression) ColumnAssigment
+}
+
+type integerArrayColumnImpl struct {
+	jet.ColumnExpressionImpl
+	integerArrayInterfaceImpl
+}
+
+func (a *integerArrayColumnImpl) From(subQuery SelectTable) ColumnIntegerArray {
+	newIntegerArrayColumn := IntegerArrayColumn(a.Name())
+	jet.SetTableName(newIntegerArrayColumn, a.TableName())
+	jet.SetSubQuery(newIntegerArrayColumn, subQuery)
+
+	return newIntegerArrayColumn
+}
+
+func (a *integerArrayColumnImpl) SET(arrayExp IntegerArrayExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(a, arrayExp)
+}
+
+// IntegerArrayColumn creates named integer array column.
+func IntegerArrayColumn(name string) ColumnIntegerArray {
+	arrayColumn := &integerArrayColumnImpl{}
+	arrayColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", arrayColumn)
+	arrayColumn.integerArrayInterfaceImpl.parent = arrayColumn
+	return arrayColumn
+}
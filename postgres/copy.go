@@ -0,0 +1,443 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/internal/utils"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// CopyFromSource is a source of rows copied into a table. It has the same method set as pgx.CopyFromSource, so
+// it can be passed to pgx CopyFrom method directly.
+type CopyFromSource interface {
+	// Next returns true if there is another row and makes the next row data available to Values()
+	Next() bool
+	// Values returns the values for the current row
+	Values() ([]interface{}, error)
+	// Err returns any error that has been encountered by the CopyFromSource
+	Err() error
+}
+
+// CopyFromFunc copies rows into table using driver specific COPY protocol implementation. For instance, with pgx:
+//
+//	func(ctx context.Context, tableName, columnNames []string, rows postgres.CopyFromSource) (int64, error) {
+//		return pgxConn.CopyFrom(ctx, pgx.Identifier(tableName), columnNames, rows)
+//	}
+type CopyFromFunc func(ctx context.Context, tableName, columnNames []string, rows CopyFromSource) (int64, error)
+
+// CopyFromStatement copies rows into a table using COPY FROM STDIN protocol, which is significantly faster than
+// INSERT statements for bulk loads.
+type CopyFromStatement interface {
+	// MODELS sets rows to copy from a slice of model structs. Struct fields are matched to columns by name,
+	// the same way as with INSERT MODELS.
+	MODELS(data interface{}) CopyFromStatement
+	// VALUES adds a row of values to copy
+	VALUES(value interface{}, values ...interface{}) CopyFromStatement
+	// CSV sets rows to copy from CSV reader, streamed record by record. If header is true, the first record is skipped.
+	// Empty CSV fields are copied as NULL.
+	CSV(reader io.Reader, header bool) CopyFromStatement
+	// USING sets driver specific copy function. Without it, rows are copied with lib/pq COPY protocol
+	// over database/sql prepared statement.
+	USING(copyFunc CopyFromFunc) CopyFromStatement
+
+	// Sql returns COPY FROM STDIN query
+	Sql() string
+	// ExecContext copies the rows and returns the number of rows copied. If db is *sql.DB (or other db connection
+	// able to begin transaction), rows are copied in a new transaction.
+	ExecContext(ctx context.Context, db qrm.DB) (rowsCopied int64, err error)
+}
+
+// COPY_FROM creates new COPY FROM STDIN statement, copying rows into table columns. If columns are not set, all the
+// table columns are copied.
+func COPY_FROM(table Table, columns ...jet.Column) CopyFromStatement {
+	insert := jet.ClauseInsert{Table: table, Columns: columns}
+
+	return &copyFromStatementImpl{
+		table:   table,
+		columns: insert.GetColumns(),
+	}
+}
+
+type copyFromStatementImpl struct {
+	table    Table
+	columns  []jet.Column
+	source   CopyFromSource
+	rows     [][]interface{}
+	copyFunc CopyFromFunc
+}
+
+func (c *copyFromStatementImpl) MODELS(data interface{}) CopyFromStatement {
+	sliceValue := reflect.Indirect(reflect.ValueOf(data))
+	utils.ValueMustBe(sliceValue, reflect.Slice, "jet: data has to be a slice.")
+
+	c.source = &modelsSource{columns: c.columns, models: sliceValue, index: -1}
+	return c
+}
+
+func (c *copyFromStatementImpl) VALUES(value interface{}, values ...interface{}) CopyFromStatement {
+	c.rows = append(c.rows, append([]interface{}{value}, values...))
+	c.source = &rowsSource{rows: c.rows, index: -1}
+	return c
+}
+
+func (c *copyFromStatementImpl) CSV(reader io.Reader, header bool) CopyFromStatement {
+	c.source = &csvSource{reader: csv.NewReader(reader), skipHeader: header}
+	return c
+}
+
+func (c *copyFromStatementImpl) USING(copyFunc CopyFromFunc) CopyFromStatement {
+	c.copyFunc = copyFunc
+	return c
+}
+
+func (c *copyFromStatementImpl) tableName() []string {
+	if c.table.SchemaName() == "" {
+		return []string{c.table.TableName()}
+	}
+
+	return []string{c.table.SchemaName(), c.table.TableName()}
+}
+
+func (c *copyFromStatementImpl) columnNames() []string {
+	var names []string
+
+	for _, column := range c.columns {
+		names = append(names, column.Name())
+	}
+
+	return names
+}
+
+func (c *copyFromStatementImpl) Sql() string {
+	var tableName, columnNames []string
+
+	for _, name := range c.tableName() {
+		tableName = append(tableName, quoteIdentifier(name))
+	}
+
+	for _, name := range c.columnNames() {
+		columnNames = append(columnNames, quoteIdentifier(name))
+	}
+
+	return fmt.Sprintf("COPY %s (%s) FROM STDIN", strings.Join(tableName, "."), strings.Join(columnNames, ", "))
+}
+
+// quoteIdentifier quotes identifier with the dialect identifier quote character, doubling embedded quote characters
+func quoteIdentifier(name string) string {
+	quoteChar := string(Dialect.IdentifierQuoteChar())
+
+	return quoteChar + strings.Replace(name, quoteChar, quoteChar+quoteChar, -1) + quoteChar
+}
+
+type stmtPreparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+func (c *copyFromStatementImpl) ExecContext(ctx context.Context, db qrm.DB) (int64, error) {
+	if c.source == nil {
+		return 0, errors.New("jet: COPY FROM rows not set, use MODELS, VALUES or CSV")
+	}
+
+	if c.copyFunc != nil {
+		return c.copyFunc(ctx, c.tableName(), c.columnNames(), c.source)
+	}
+
+	if !jet.CanBeginTx(db) {
+		return c.copyIn(ctx, db)
+	}
+
+	tx, _, err := jet.BeginTx(ctx, db, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	rowsCopied, err := c.copyIn(ctx, tx)
+
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	return rowsCopied, tx.Commit()
+}
+
+// copyIn copies rows with lib/pq COPY protocol: each prepared statement execution sends one row, and the final
+// execution without arguments completes the copy
+func (c *copyFromStatementImpl) copyIn(ctx context.Context, db interface{}) (int64, error) {
+	preparer, ok := db.(stmtPreparer)
+
+	if !ok {
+		return 0, errors.New("jet: COPY FROM requires db connection or transaction which can prepare statements")
+	}
+
+	stmt, err := preparer.PrepareContext(ctx, c.Sql())
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer stmt.Close()
+
+	var rowsCopied int64
+
+	for c.source.Next() {
+		values, err := c.source.Values()
+
+		if err != nil {
+			return 0, err
+		}
+
+		if _, err := stmt.ExecContext(ctx, values...); err != nil {
+			return 0, err
+		}
+
+		rowsCopied++
+	}
+
+	if err := c.source.Err(); err != nil {
+		return 0, err
+	}
+
+	// lib/pq does not report the number of copied rows, so rows are counted as they are sent
+	if _, err := stmt.ExecContext(ctx); err != nil {
+		return 0, err
+	}
+
+	return rowsCopied, nil
+}
+
+type modelsSource struct {
+	columns []jet.Column
+	models  reflect.Value
+	index   int
+}
+
+func (m *modelsSource) Next() bool {
+	m.index++
+	return m.index < m.models.Len()
+}
+
+func (m *modelsSource) Values() ([]interface{}, error) {
+	return jet.RowValuesFromModel(m.columns, m.models.Index(m.index).Interface()), nil
+}
+
+func (m *modelsSource) Err() error {
+	return nil
+}
+
+type rowsSource struct {
+	rows  [][]interface{}
+	index int
+}
+
+func (r *rowsSource) Next() bool {
+	r.index++
+	return r.index < len(r.rows)
+}
+
+func (r *rowsSource) Values() ([]interface{}, error) {
+	return r.rows[r.index], nil
+}
+
+func (r *rowsSource) Err() error {
+	return nil
+}
+
+type csvSource struct {
+	reader     *csv.Reader
+	skipHeader bool
+	record     []string
+	err        error
+}
+
+func (c *csvSource) Next() bool {
+	if c.skipHeader {
+		c.skipHeader = false
+
+		if _, err := c.reader.Read(); err != nil {
+			c.setErr(err)
+			return false
+		}
+	}
+
+	record, err := c.reader.Read()
+
+	if err != nil {
+		c.setErr(err)
+		return false
+	}
+
+	c.record = record
+
+	return true
+}
+
+func (c *csvSource) setErr(err error) {
+	if err != io.EOF {
+		c.err = err
+	}
+}
+
+func (c *csvSource) Values() ([]interface{}, error) {
+	values := make([]interface{}, len(c.record))
+
+	for i, field := range c.record {
+		if field != "" {
+			values[i] = field
+		}
+	}
+
+	return values, nil
+}
+
+func (c *csvSource) Err() error {
+	return c.err
+}
+
+//---------------------------------------------------//
+
+// CopyToFunc copies query result into writer using driver specific COPY TO STDOUT protocol implementation.
+// For instance, with pgx:
+//
+//	func(ctx context.Context, w io.Writer, query string) (int64, error) {
+//		tag, err := pgxConn.PgConn().CopyTo(ctx, w, query)
+//		return tag.RowsAffected(), err
+//	}
+type CopyToFunc func(ctx context.Context, w io.Writer, query string) (int64, error)
+
+// CopyToStatement writes statement result set into writer in CSV format with header
+type CopyToStatement interface {
+	// USING sets driver specific copy function, executing COPY TO STDOUT query. COPY does not support query
+	// parameters, so statement copied with USING can not have arguments (use Raw expressions for constant values).
+	// Without USING, no COPY is executed: database/sql drivers do not support COPY TO STDOUT, so the statement is
+	// executed as a regular parametrized query, and the rows are encoded into CSV by jet.
+	USING(copyFunc CopyToFunc) CopyToStatement
+
+	// Sql returns COPY TO STDOUT query
+	Sql() string
+	// ExecContext writes the result set into writer and returns the number of rows written
+	ExecContext(ctx context.Context, db qrm.Queryable, w io.Writer) (rowsCopied int64, err error)
+}
+
+// COPY_TO creates new COPY TO STDOUT statement, writing statement result set in CSV format with header.
+func COPY_TO(statement Statement) CopyToStatement {
+	return &copyToStatementImpl{statement: statement}
+}
+
+type copyToStatementImpl struct {
+	statement Statement
+	copyFunc  CopyToFunc
+}
+
+func (c *copyToStatementImpl) USING(copyFunc CopyToFunc) CopyToStatement {
+	c.copyFunc = copyFunc
+	return c
+}
+
+func (c *copyToStatementImpl) Sql() string {
+	query, _ := c.statement.Sql()
+
+	return copyToQuery(query)
+}
+
+func copyToQuery(query string) string {
+	query = strings.TrimSuffix(strings.TrimSpace(query), ";")
+
+	return fmt.Sprintf("COPY (%s) TO STDOUT WITH (FORMAT csv, HEADER)", query)
+}
+
+func (c *copyToStatementImpl) ExecContext(ctx context.Context, db qrm.Queryable, w io.Writer) (int64, error) {
+	if c.copyFunc != nil {
+		query, args := c.statement.Sql()
+
+		if len(args) > 0 {
+			return 0, fmt.Errorf("jet: COPY TO statement can not have arguments, COPY does not support query parameters, "+
+				"got %d argument(s)", len(args))
+		}
+
+		return c.copyFunc(ctx, w, copyToQuery(query))
+	}
+
+	query, args := c.statement.Sql()
+
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+
+	if err != nil {
+		return 0, err
+	}
+
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(columns); err != nil {
+		return 0, err
+	}
+
+	values := make([]interface{}, len(columns))
+	record := make([]string, len(columns))
+
+	for i := range values {
+		values[i] = new(interface{})
+	}
+
+	var rowsCopied int64
+
+	for rows.Next() {
+		if err := rows.Scan(values...); err != nil {
+			return rowsCopied, err
+		}
+
+		for i, value := range values {
+			record[i] = csvField(*(value.(*interface{})))
+		}
+
+		if err := writer.Write(record); err != nil {
+			return rowsCopied, err
+		}
+
+		rowsCopied++
+	}
+
+	if err := rows.Err(); err != nil {
+		return rowsCopied, err
+	}
+
+	writer.Flush()
+
+	return rowsCopied, writer.Error()
+}
+
+func csvField(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case bool:
+		if v {
+			return "t"
+		}
+		return "f"
+	default:
+		return fmt.Sprint(v)
+	}
+}
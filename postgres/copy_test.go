@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// copyDriver records COPY protocol executions, and returns the same rows for every query
+type copyDriver struct {
+	prepared  []string
+	executed  [][]driver.Value
+	committed bool
+	columns   []string
+	rows      [][]driver.Value
+}
+
+func (d *copyDriver) Open(name string) (driver.Conn, error) { return &copyConn{driver: d}, nil }
+
+type copyConn struct{ driver *copyDriver }
+
+func (c *copyConn) Prepare(query string) (driver.Stmt, error) {
+	c.driver.prepared = append(c.driver.prepared, query)
+	return &copyStmt{driver: c.driver}, nil
+}
+func (c *copyConn) Close() error              { return nil }
+func (c *copyConn) Begin() (driver.Tx, error) { return c, nil }
+func (c *copyConn) Commit() error             { c.driver.committed = true; return nil }
+func (c *copyConn) Rollback() error           { return nil }
+
+type copyStmt struct{ driver *copyDriver }
+
+func (s *copyStmt) Close() error  { return nil }
+func (s *copyStmt) NumInput() int { return -1 }
+func (s *copyStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.driver.executed = append(s.driver.executed, args)
+	return driver.RowsAffected(0), nil
+}
+func (s *copyStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &copyRows{driver: s.driver}, nil
+}
+
+type copyRows struct {
+	driver *copyDriver
+	index  int
+}
+
+func (r *copyRows) Columns() []string { return r.driver.columns }
+func (r *copyRows) Close() error      { return nil }
+func (r *copyRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.driver.rows) {
+		return io.EOF
+	}
+	copy(dest, r.driver.rows[r.index])
+	r.index++
+	return nil
+}
+
+func openCopyDB(drv *copyDriver) *sql.DB {
+	return sql.OpenDB(copyConnector{drv})
+}
+
+type copyConnector struct{ driver *copyDriver }
+
+func (c copyConnector) Connect(context.Context) (driver.Conn, error) { return c.driver.Open("") }
+func (c copyConnector) Driver() driver.Driver                        { return c.driver }
+
+func TestCopyFromSql(t *testing.T) {
+	require.Equal(t, `COPY "db"."table1" ("col1", "col_float") FROM STDIN`, COPY_FROM(table1, table1Col1, table1ColFloat).Sql())
+	require.Equal(t, `COPY "db"."table1" ("col1", "col_int", "col_float", "col_time", "col_timez", "col_bool", "col_date", `+
+		`"col_timestamp", "col_timestampz", "col_interval") FROM STDIN`, COPY_FROM(table1).Sql())
+}
+
+func TestCopyFromModels(t *testing.T) {
+	type Table1 struct {
+		Col1     int64
+		ColFloat *float64
+	}
+
+	drv := &copyDriver{}
+	db := openCopyDB(drv)
+	defer db.Close()
+
+	float := 1.5
+
+	rowsCopied, err := COPY_FROM(table1, table1Col1, table1ColFloat).
+		MODELS([]Table1{{Col1: 1, ColFloat: &float}, {Col1: 2}}).
+		ExecContext(context.Background(), db)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rowsCopied)
+	require.True(t, drv.committed)
+	require.Equal(t, []string{`COPY "db"."table1" ("col1", "col_float") FROM STDIN`}, drv.prepared)
+	require.Equal(t, [][]driver.Value{{int64(1), 1.5}, {int64(2), nil}, {}}, drv.executed)
+}
+
+func TestCopyFromCSV(t *testing.T) {
+	drv := &copyDriver{}
+	db := openCopyDB(drv)
+	defer db.Close()
+
+	rowsCopied, err := COPY_FROM(table1, table1Col1, table1ColFloat).
+		CSV(strings.NewReader("col1,col_float\n1,2.5\n2,\n"), true).
+		ExecContext(context.Background(), db)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rowsCopied)
+	require.Equal(t, [][]driver.Value{{"1", "2.5"}, {"2", nil}, {}}, drv.executed)
+
+	_, err = COPY_FROM(table1, table1Col1).
+		CSV(strings.NewReader("1\n\"2"), false).
+		ExecContext(context.Background(), db)
+	require.Error(t, err)
+}
+
+func TestCopyFromUsing(t *testing.T) {
+	var copied [][]interface{}
+
+	rowsCopied, err := COPY_FROM(table1, table1Col1).
+		VALUES(1).
+		VALUES(2).
+		USING(func(ctx context.Context, tableName, columnNames []string, rows CopyFromSource) (int64, error) {
+			require.Equal(t, []string{"db", "table1"}, tableName)
+			require.Equal(t, []string{"col1"}, columnNames)
+
+			for rows.Next() {
+				values, err := rows.Values()
+				require.NoError(t, err)
+				copied = append(copied, values)
+			}
+
+			return int64(len(copied)), rows.Err()
+		}).
+		ExecContext(context.Background(), nil)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rowsCopied)
+	require.Equal(t, [][]interface{}{{1}, {2}}, copied)
+
+	_, err = COPY_FROM(table1).ExecContext(context.Background(), nil)
+	require.EqualError(t, err, "jet: COPY FROM rows not set, use MODELS, VALUES or CSV")
+}
+
+func TestCopyTo(t *testing.T) {
+	stmt := SELECT(table1Col1, table1ColBool).FROM(table1).WHERE(table1Col1.GT(Int(1)))
+
+	require.Equal(t, `COPY (SELECT table1.col1 AS "table1.col1",
+     table1.col_bool AS "table1.col_bool"
+FROM db.table1
+WHERE table1.col1 > $1) TO STDOUT WITH (FORMAT csv, HEADER)`, COPY_TO(stmt).Sql())
+
+	drv := &copyDriver{
+		columns: []string{"table1.col1", "table1.col_bool"},
+		rows:    [][]driver.Value{{int64(2), true}, {int64(3), nil}},
+	}
+	db := openCopyDB(drv)
+	defer db.Close()
+
+	var out bytes.Buffer
+
+	rowsCopied, err := COPY_TO(stmt).ExecContext(context.Background(), db, &out)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rowsCopied)
+	require.Equal(t, "table1.col1,table1.col_bool\n2,t\n3,\n", out.String())
+}
+
+func TestCopyToUsing(t *testing.T) {
+	var copiedQuery string
+
+	copyFunc := func(ctx context.Context, w io.Writer, query string) (int64, error) {
+		copiedQuery = query
+		return 0, nil
+	}
+
+	stmt := SELECT(table1Col1).FROM(table1).WHERE(table1Col1.IS_NOT_NULL())
+
+	_, err := COPY_TO(stmt).USING(copyFunc).ExecContext(context.Background(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, `COPY (SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+WHERE table1.col1 IS NOT NULL) TO STDOUT WITH (FORMAT csv, HEADER)`, copiedQuery)
+
+	stmt = SELECT(table1Col1).FROM(table1).WHERE(table1Col1.GT(Int(1)))
+
+	_, err = COPY_TO(stmt).USING(copyFunc).ExecContext(context.Background(), nil, nil)
+	require.EqualError(t, err, "jet: COPY TO statement can not have arguments, COPY does not support query parameters, "+
+		"got 1 argument(s)")
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// CTID_LIMIT returns condition matching at most limit table rows satisfying condition, picked in orderBy order.
+// PostgreSQL does not support LIMIT in DELETE and UPDATE statements, so the rows are matched by physical row
+// location (ctid). Useful for incremental cleanup jobs, which delete or update large number of rows in small batches:
+//
+//	Logs.DELETE().
+//		WHERE(CTID_LIMIT(Logs, Logs.CreatedAt.LT(cutoff), 1000, Logs.CreatedAt.ASC()))
+//
+// ctid of a row changes when the row is updated, so the condition should be used in the same statement it is created for.
+func CTID_LIMIT(table Table, condition BoolExpression, limit int64, orderBy ...OrderByClause) BoolExpression {
+	if limit < 0 {
+		panic("jet: CTID_LIMIT limit can not be negative")
+	}
+
+	tableName := table.Alias()
+
+	if tableName == "" {
+		tableName = table.TableName()
+	}
+
+	ctid := newCtidColumn(tableName)
+
+	return ctid.IN(
+		table.SELECT(ctid).
+			WHERE(condition).
+			ORDER_BY(orderBy...).
+			LIMIT(limit),
+	)
+}
+
+type ctidColumn struct {
+	jet.ColumnExpressionImpl
+}
+
+func newCtidColumn(tableName string) *ctidColumn {
+	ctid := &ctidColumn{}
+	ctid.ColumnExpressionImpl = jet.NewColumnImpl("ctid", tableName, ctid)
+
+	return ctid
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestCTID_LIMIT(t *testing.T) {
+	assertStatementSql(t, table1.DELETE().WHERE(CTID_LIMIT(table1, table1Col1.GT(Int(10)), 100, table1Col1.ASC())), `
+DELETE FROM db.table1
+WHERE table1.ctid IN (
+           SELECT table1.ctid AS "table1.ctid"
+           FROM db.table1
+           WHERE table1.col1 > $1
+           ORDER BY table1.col1 ASC
+           LIMIT $2
+      );
+`, int64(10), int64(100))
+
+	assertStatementSql(t, table1.UPDATE(table1ColInt).SET(Int(1)).WHERE(CTID_LIMIT(table1, table1ColInt.IS_NULL(), 10)), `
+UPDATE db.table1
+SET col_int = $1
+WHERE table1.ctid IN (
+           SELECT table1.ctid AS "table1.ctid"
+           FROM db.table1
+           WHERE table1.col_int IS NULL
+           LIMIT $2
+      );
+`, int64(1), int64(10))
+}
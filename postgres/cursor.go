@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// DeclareCursorStatement is interface for DECLARE CURSOR statement
+type DeclareCursorStatement interface {
+	Statement
+
+	SCROLL() DeclareCursorStatement
+	NO_SCROLL() DeclareCursorStatement
+	WITH_HOLD() DeclareCursorStatement
+}
+
+// DECLARE_CURSOR creates DECLARE CURSOR statement, which opens server-side cursor name over query rows. Unless
+// declared WITH HOLD, cursor can be used only within the transaction it is declared in, and it is closed at
+// transaction end.
+func DECLARE_CURSOR(name string, query jet.SerializerStatement) DeclareCursorStatement {
+	newDeclare := &declareCursorStatementImpl{}
+	newDeclare.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DeclareCursorStatementType, newDeclare,
+		&newDeclare.Declare)
+
+	newDeclare.Declare.Name = name
+	newDeclare.Declare.Query = query
+
+	return newDeclare
+}
+
+type declareCursorStatementImpl struct {
+	jet.SerializerStatement
+
+	Declare clauseDeclareCursor
+}
+
+func (d *declareCursorStatementImpl) SCROLL() DeclareCursorStatement {
+	d.Declare.Scroll = "SCROLL"
+	return d
+}
+
+func (d *declareCursorStatementImpl) NO_SCROLL() DeclareCursorStatement {
+	d.Declare.Scroll = "NO SCROLL"
+	return d
+}
+
+func (d *declareCursorStatementImpl) WITH_HOLD() DeclareCursorStatement {
+	d.Declare.WithHold = true
+	return d
+}
+
+// FETCH_FORWARD creates FETCH statement, which returns the next count rows of the cursor name
+func FETCH_FORWARD(count int64, name string) Statement {
+	return newClauseStatement(jet.FetchStatementType, &clauseFetch{Count: count, Name: name})
+}
+
+// CLOSE creates CLOSE statement, which closes the cursor name
+func CLOSE(name string) Statement {
+	return newClauseStatement(jet.CloseCursorStatementType, &clauseCloseCursor{Name: name})
+}
+
+type clauseDeclareCursor struct {
+	Name     string
+	Query    jet.SerializerStatement
+	Scroll   string
+	WithHold bool
+}
+
+func (c *clauseDeclareCursor) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if c.Query == nil {
+		panic("jet: cursor query is nil")
+	}
+
+	out.NewLine()
+	out.WriteString("DECLARE")
+	writeCursorName(out, c.Name)
+
+	if c.Scroll != "" {
+		out.WriteString(c.Scroll)
+	}
+
+	out.WriteString("CURSOR")
+
+	if c.WithHold {
+		out.WriteString("WITH HOLD")
+	}
+
+	out.WriteString("FOR")
+	jet.Serialize(c.Query, statementType, out, jet.NoWrap)
+}
+
+type clauseFetch struct {
+	Count int64
+	Name  string
+}
+
+func (c *clauseFetch) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if c.Count <= 0 {
+		panic("jet: cursor fetch count has to be greater than 0")
+	}
+
+	out.NewLine()
+	out.WriteString("FETCH FORWARD " + strconv.FormatInt(c.Count, 10) + " FROM")
+	writeCursorName(out, c.Name)
+}
+
+type clauseCloseCursor struct {
+	Name string
+}
+
+func (c *clauseCloseCursor) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	out.NewLine()
+	out.WriteString("CLOSE")
+	writeCursorName(out, c.Name)
+}
+
+func writeCursorName(out *jet.SQLBuilder, name string) {
+	if name == "" {
+		panic("jet: cursor name is empty")
+	}
+
+	out.WriteIdentifier(name)
+}
+
+// QueryCursor declares cursor name over query, and fetches query rows batchSize rows at a time into destination,
+// calling fn after each batch. Destination has to be a pointer to slice, and it is emptied before each batch is
+// fetched, so only one batch of rows is kept in memory. Iteration stops on the first fn error, which is returned.
+// Cursor is closed before return. Because cursor is valid only within transaction, db has to be a transaction
+// (*sql.Tx).
+func QueryCursor(ctx context.Context, db qrm.DB, name string, query jet.SerializerStatement, batchSize int64,
+	destination interface{}, fn func() error) (err error) {
+
+	destValue := reflect.ValueOf(destination)
+
+	if destValue.Kind() != reflect.Ptr || destValue.Elem().Kind() != reflect.Slice {
+		panic("jet: cursor destination has to be a pointer to slice")
+	}
+
+	if _, err = DECLARE_CURSOR(name, query).ExecContext(ctx, db); err != nil {
+		return err
+	}
+
+	defer func() {
+		if _, closeErr := CLOSE(name).ExecContext(ctx, db); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	sliceValue := destValue.Elem()
+	fetch := FETCH_FORWARD(batchSize, name)
+
+	for {
+		sliceValue.Set(reflect.Zero(sliceValue.Type()))
+
+		if err = fetch.QueryContext(ctx, db, destination); err != nil {
+			return err
+		}
+
+		if sliceValue.Len() == 0 {
+			return nil
+		}
+
+		if err = fn(); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestDECLARE_CURSOR(t *testing.T) {
+	query := SELECT(table1Col1).
+		FROM(table1).
+		WHERE(table1Col1.GT(Int(10)))
+
+	assertStatementSql(t, DECLARE_CURSOR("table1_cursor", query), `
+DECLARE table1_cursor CURSOR FOR
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+WHERE table1.col1 > $1;
+`, int64(10))
+
+	assertStatementSql(t, DECLARE_CURSOR("Table1Cursor", query).NO_SCROLL().WITH_HOLD(), `
+DECLARE "Table1Cursor" NO SCROLL CURSOR WITH HOLD FOR
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+WHERE table1.col1 > $1;
+`, int64(10))
+
+	assertStatementSql(t, DECLARE_CURSOR("table1_cursor", query).SCROLL(), `
+DECLARE table1_cursor SCROLL CURSOR FOR
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+WHERE table1.col1 > $1;
+`, int64(10))
+}
+
+func TestFETCH_FORWARD(t *testing.T) {
+	assertStatementSql(t, FETCH_FORWARD(100, "table1_cursor"), `
+FETCH FORWARD 100 FROM table1_cursor;
+`)
+	assertPanicErr(t, func() { FETCH_FORWARD(0, "table1_cursor").Sql() }, "jet: cursor fetch count has to be greater than 0")
+}
+
+func TestCLOSE(t *testing.T) {
+	assertStatementSql(t, CLOSE("table1_cursor"), `
+CLOSE table1_cursor;
+`)
+	assertPanicErr(t, func() { CLOSE("").Sql() }, "jet: cursor name is empty")
+}
+
+func TestQueryCursorInvalidDestination(t *testing.T) {
+	assertPanicErr(t, func() {
+		_ = QueryCursor(nil, nil, "table1_cursor", SELECT(table1Col1).FROM(table1), 10, &struct{}{}, nil)
+	}, "jet: cursor destination has to be a pointer to slice")
+}
@@ -9,6 +9,9 @@ type DeleteStatement interface {
 	USING(tables ...ReadableTable) DeleteStatement
 	WHERE(expression BoolExpression) DeleteStatement
 	RETURNING(projections ...jet.Projection) DeleteStatement
+
+	// ToPrepared creates PREPARE/EXECUTE/DEALLOCATE statements for server-side prepared statement name
+	ToPrepared(name string) PreparedStatement
 }
 
 type deleteStatementImpl struct {
@@ -50,3 +53,7 @@ func (d *deleteStatementImpl) RETURNING(projections ...jet.Projection) DeleteSta
 	d.Returning.ProjectionList = projections
 	return d
 }
+
+func (d *deleteStatementImpl) ToPrepared(name string) PreparedStatement {
+	return PREPARE(name, d)
+}
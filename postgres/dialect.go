@@ -6,6 +6,10 @@ import (
 )
 
 // Dialect is implementation of postgres dialect for SQL Builder serialisation.
+// Dialect targets the latest database version. To target older database version, Dialect can be replaced with
+// versioned dialect at program start, before any statement is created:
+//
+//	postgres.Dialect = postgres.Dialect.WithVersion(12)
 var Dialect = newDialect()
 
 func newDialect() jet.Dialect {
@@ -24,7 +28,16 @@ func newDialect() jet.Dialect {
 		ArgumentPlaceholder: func(ord int) string {
 			return "$" + strconv.Itoa(ord)
 		},
-		ReservedWords: reservedWords,
+		ReservedWords:  reservedWords,
+		ArrayArguments: true,
+		FeatureVersions: map[jet.Feature]jet.Version{
+			jet.FeatureLateral:              {Major: 9, Minor: 3},
+			jet.FeatureFilterClause:         {Major: 9, Minor: 4},
+			jet.FeatureOnConflict:           {Major: 9, Minor: 5},
+			jet.FeatureSkipLocked:           {Major: 9, Minor: 5},
+			jet.FeatureWindowFrameGroups:    {Major: 11},
+			jet.FeatureWindowFrameExclusion: {Major: 11},
+		},
 	}
 
 	return jet.NewDialect(dialectParams)
@@ -117,17 +130,23 @@ var reservedWords = []string{
 	"AS",
 	"ASC",
 	"ASYMMETRIC",
+	"AUTHORIZATION",
+	"BINARY",
 	"BOTH",
 	"CASE",
 	"CAST",
 	"CHECK",
 	"COLLATE",
+	"COLLATION",
 	"COLUMN",
+	"CONCURRENTLY",
 	"CONSTRAINT",
 	"CREATE",
+	"CROSS",
 	"CURRENT_CATALOG",
 	"CURRENT_DATE",
 	"CURRENT_ROLE",
+	"CURRENT_SCHEMA",
 	"CURRENT_TIME",
 	"CURRENT_TIMESTAMP",
 	"CURRENT_USER",
@@ -143,35 +162,51 @@ var reservedWords = []string{
 	"FETCH",
 	"FOR",
 	"FOREIGN",
+	"FREEZE",
 	"FROM",
+	"FULL",
 	"GRANT",
 	"GROUP",
 	"HAVING",
+	"ILIKE",
 	"IN",
 	"INITIALLY",
+	"INNER",
 	"INTERSECT",
 	"INTO",
+	"IS",
+	"ISNULL",
+	"JOIN",
 	"LATERAL",
 	"LEADING",
+	"LEFT",
+	"LIKE",
 	"LIMIT",
 	"LOCALTIME",
 	"LOCALTIMESTAMP",
+	"NATURAL",
 	"NOT",
+	"NOTNULL",
 	"NULL",
 	"OFFSET",
 	"ON",
 	"ONLY",
 	"OR",
 	"ORDER",
+	"OUTER",
+	"OVERLAPS",
 	"PLACING",
 	"PRIMARY",
 	"REFERENCES",
 	"RETURNING",
+	"RIGHT",
 	"SELECT",
 	"SESSION_USER",
+	"SIMILAR",
 	"SOME",
 	"SYMMETRIC",
 	"TABLE",
+	"TABLESAMPLE",
 	"THEN",
 	"TO",
 	"TRAILING",
@@ -181,6 +216,7 @@ var reservedWords = []string{
 	"USER",
 	"USING",
 	"VARIADIC",
+	"VERBOSE",
 	"WHEN",
 	"WHERE",
 	"WINDOW",
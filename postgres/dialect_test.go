@@ -1,6 +1,11 @@
 package postgres
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/stretchr/testify/require"
+)
 
 func TestString_REGEXP_LIKE_operator(t *testing.T) {
 	assertSerialize(t, table3StrCol.REGEXP_LIKE(table2ColStr), "(table3.col2 ~* table2.col_str)")
@@ -86,3 +91,55 @@ func TestReservedWordEscaped(t *testing.T) {
 	assertSerialize(t, table1ColVariadic, `table1."VARIADIC"`)
 	assertSerialize(t, table1ColProcedure, `table1.procedure`)
 }
+
+func TestIdentifierQuotingOverride(t *testing.T) {
+	var colOrder = IntegerColumn("order")
+	var colLeft = IntegerColumn("left")
+	var colUnicode = StringColumn("naïve")
+	var colName = StringColumn("name")
+
+	events := NewTable("db", "Events", "", colOrder, colLeft, colUnicode, colName)
+
+	assertStatementSql(t, SELECT(colOrder, colLeft, colUnicode, colName).FROM(events).ORDER_BY(colOrder), `
+SELECT "Events"."order" AS "Events.order",
+     "Events"."left" AS "Events.left",
+     "Events"."naïve" AS "Events.naïve",
+     "Events".name AS "Events.name"
+FROM db."Events"
+ORDER BY "Events"."order";
+`)
+
+	SetIdentifierQuoting("name", true)
+	SetIdentifierQuoting("Events", false)
+	defer ResetIdentifierQuoting("name")
+	defer ResetIdentifierQuoting("Events")
+
+	assertSerialize(t, colName, `Events."name"`)
+	assertSerialize(t, colOrder, `Events."order"`)
+
+	ResetIdentifierQuoting("name")
+	assertSerialize(t, colName, `Events.name`)
+}
+
+func TestDialectVersion(t *testing.T) {
+	require.True(t, Dialect.Version().IsZero())
+	require.Equal(t, jet.Version{Major: 9, Minor: 3}, Dialect.WithVersion(9, 3).Version())
+	require.True(t, Dialect.WithVersion(9, 4).Supports(jet.FeatureFilterClause))
+	require.False(t, Dialect.WithVersion(9, 3).Supports(jet.FeatureFilterClause))
+	require.False(t, Dialect.WithVersion(10).Supports(jet.FeatureWindowFrameGroups))
+
+	defer func(dialect jet.Dialect) { Dialect = dialect }(Dialect)
+	Dialect = Dialect.WithVersion(9, 3)
+
+	assertSerialize(t, AGG("sum", table1ColInt).FILTER(table1ColBool),
+		"sum((CASE WHEN table1.col_bool THEN table1.col_int END))")
+	assertSerialize(t, AGG("count", STAR).FILTER(table1ColInt.GT(Int(2))),
+		"count((CASE WHEN table1.col_int > $1 THEN 1 END))", int64(2))
+
+	assertStatementSqlErr(t,
+		SELECT(COUNT(STAR).OVER(ORDER_BY(table1ColInt).GROUPS(PRECEDING(1), CURRENT_ROW))).FROM(table1),
+		"jet: GROUPS window frame not supported by PostgreSQL 9.3")
+	assertStatementSqlErr(t,
+		table1.INSERT(table1ColInt).VALUES(1).ON_CONFLICT(table1ColInt).DO_NOTHING(),
+		"jet: ON CONFLICT clause not supported by PostgreSQL 9.3")
+}
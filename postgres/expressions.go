@@ -105,3 +105,14 @@ var Func = jet.Func
 
 // NewEnumValue creates new named enum value
 var NewEnumValue = jet.NewEnumValue
+
+// ParseFilter parses filter expression into a bool expression over the list of allowed columns. Filter field names
+// are matched with column names ignoring case and underscores, and filter values are validated against column types,
+// so the filter can be safely accepted from API clients. For example: `age>=18 AND (name~"jo%" OR nickname=null)`.
+// Supported operators are =, !=, >, >=, <, <=, ~ (LIKE) and !~ (NOT LIKE), combined with AND, OR and NOT.
+var ParseFilter = jet.ParseFilter
+
+// ParseOrderBy converts comma separated list of sort fields (for instance "-created_at,name") into a list of
+// order by clauses. Field prefixed with '-' is sorted in descending order. Fields are validated against the list of
+// allowed columns, so sort parameter can be safely accepted from API clients.
+var ParseOrderBy = jet.ParseOrderBy
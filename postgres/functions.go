@@ -11,6 +11,16 @@ var (
 	OR = jet.OR
 )
 
+// ConditionChain accumulates optional conditions, and combines them with AND or OR operator
+type ConditionChain = jet.ConditionChain
+
+var (
+	// AllOf creates new condition chain, which combines conditions with AND operator
+	AllOf = jet.AllOf
+	// AnyOf creates new condition chain, which combines conditions with OR operator
+	AnyOf = jet.AnyOf
+)
+
 // ROW is construct one table row from list of expressions.
 var ROW = jet.ROW
 
@@ -163,6 +173,18 @@ var LOWER = jet.LOWER
 // UPPER returns string expression in upper case
 var UPPER = jet.UPPER
 
+// UNACCENT removes accents (diacritic signs) from string expression. Requires unaccent extension.
+func UNACCENT(str StringExpression) StringExpression {
+	return jet.NewStringFunc("UNACCENT", str)
+}
+
+// EQ_IGNORE_CASE_AND_ACCENTS compares lhs and rhs ignoring case and accents, for instance 'Cafe' matches 'café'.
+// Requires unaccent extension. Columns of citext type are already compared case-insensitively, so for them
+// EQ(UNACCENT(lhs), UNACCENT(rhs)) is sufficient.
+func EQ_IGNORE_CASE_AND_ACCENTS(lhs, rhs StringExpression) BoolExpression {
+	return LOWER(UNACCENT(lhs)).EQ(LOWER(UNACCENT(rhs)))
+}
+
 // BTRIM removes the longest string consisting only of characters
 // in characters (a space by default) from the start and end of string
 var BTRIM = jet.BTRIM
@@ -241,6 +263,43 @@ var RPAD = jet.RPAD
 // MD5 calculates the MD5 hash of string, returning the result in hexadecimal
 var MD5 = jet.MD5
 
+// SHA256 calculates the SHA-256 hash of UTF8 encoded text data, returning the result as bytea
+func SHA256(data StringExpression) StringExpression {
+	return CAST(jet.NewStringFunc("SHA256", utf8Bytes(data))).AS_BYTEA()
+}
+
+// SHA512 calculates the SHA-512 hash of UTF8 encoded text data, returning the result as bytea
+func SHA512(data StringExpression) StringExpression {
+	return CAST(jet.NewStringFunc("SHA512", utf8Bytes(data))).AS_BYTEA()
+}
+
+// utf8Bytes converts text to bytea, hash functions accept only binary strings
+func utf8Bytes(text StringExpression) StringExpression {
+	return CONVERT_TO(text, StringExp(FixedLiteral("UTF8")))
+}
+
+// DIGEST computes a binary hash of the data using algorithm (md5, sha1, sha224, sha256, sha384 or sha512).
+// Requires pgcrypto extension.
+func DIGEST(data, algorithm StringExpression) StringExpression {
+	return jet.NewStringFunc("digest", data, algorithm)
+}
+
+// CRYPT calculates a crypt(3)-style hash of password. When storing a new password, GEN_SALT is used to generate
+// a new salt. When checking a password, the stored hash value is used as salt. Requires pgcrypto extension.
+func CRYPT(password, salt StringExpression) StringExpression {
+	return jet.NewStringFunc("crypt", password, salt)
+}
+
+// GEN_SALT generates a new random salt string for use in CRYPT, for algorithm (des, xdes, md5 or bf) and optional
+// iteration count. Requires pgcrypto extension.
+func GEN_SALT(algorithm StringExpression, iterCount ...IntegerExpression) StringExpression {
+	if len(iterCount) > 0 {
+		return jet.NewStringFunc("gen_salt", algorithm, iterCount[0])
+	}
+
+	return jet.NewStringFunc("gen_salt", algorithm)
+}
+
 // REPEAT repeats string the specified number of times
 var REPEAT = jet.REPEAT
 
@@ -361,9 +420,52 @@ func PERCENTILE_DISC(fraction FloatExpression) *jet.OrderSetAggregateFunc {
 	return jet.PERCENTILE_DISC(castFloatLiteral(fraction))
 }
 
+// AGG creates new call of aggregate function name with arguments, with support for DISTINCT, ORDER BY and FILTER
+// aggregate clauses. Useful for user defined aggregates and aggregates provided by extensions, for instance:
+//
+//	StringExp(AGG("string_agg", Film.Title, String(", ")).DISTINCT().ORDER_BY(Film.Title.ASC()).FILTER(Film.Length.GT(Int(100))))
+var AGG = jet.AGG
+
 func castFloatLiteral(fraction FloatExpression) FloatExpression {
 	if _, ok := fraction.(jet.LiteralExpression); ok {
 		return CAST(fraction).AS_DOUBLE() // to make postgres aware of the type
 	}
 	return fraction
 }
+
+//----------------------- JSON functions ------------------------//
+
+// ROW_TO_JSON returns table row as a json object, with table column names as keys.
+// Table has to be part of the statement FROM clause.
+func ROW_TO_JSON(table jet.Table) StringExpression {
+	return jet.NewStringFunc("ROW_TO_JSON", jet.TableRow(table))
+}
+
+// JSON_BUILD_OBJECT builds a json object out of a variadic list of alternating keys and values.
+// For example: JSON_BUILD_OBJECT(String("id"), Actor.ActorID, String("name"), Actor.FirstName)
+func JSON_BUILD_OBJECT(keyValuePairs ...Expression) StringExpression {
+	if len(keyValuePairs)%2 != 0 {
+		panic("jet: JSON_BUILD_OBJECT requires even number of arguments (key-value pairs)")
+	}
+
+	return jet.NewStringFunc("JSON_BUILD_OBJECT", explicitLiteralCasts(keyValuePairs...)...)
+}
+
+// JSON_AGG is aggregate function. Returns all input values, including nulls, aggregated as a json array.
+// Combined with ROW_TO_JSON or JSON_BUILD_OBJECT in a sub-query, it can be used to load nested objects in a single
+// query, without join row explosion.
+func JSON_AGG(expression Expression) StringExpression {
+	return jet.NewStringFunc("JSON_AGG", expression)
+}
+
+// ------------------ Sequence functions ------------------//
+
+// LASTVAL returns the value most recently obtained by nextval for any sequence in the current session
+func LASTVAL() IntegerExpression {
+	return IntExp(jet.Func("LASTVAL"))
+}
+
+// CURRVAL returns the value most recently obtained by nextval for sequence in the current session
+func CURRVAL(sequence string) IntegerExpression {
+	return IntExp(jet.Func("CURRVAL", jet.FixedLiteral(sequence)))
+}
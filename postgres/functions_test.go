@@ -10,3 +10,81 @@ func TestROW(t *testing.T) {
      SELECT $2
 ), $3)`)
 }
+
+func TestROW_TO_JSON(t *testing.T) {
+	assertSerialize(t, ROW_TO_JSON(table1), `ROW_TO_JSON(table1)`)
+	assertSerialize(t, ROW_TO_JSON(NewTable("db", "table2", "t2")), `ROW_TO_JSON(t2)`)
+}
+
+func TestJSON_BUILD_OBJECT(t *testing.T) {
+	assertSerialize(t, JSON_BUILD_OBJECT(String("id"), table1Col1, String("flag"), table1ColBool),
+		`JSON_BUILD_OBJECT($1::text, table1.col1, $2::text, table1.col_bool)`, "id", "flag")
+	assertPanicErr(t, func() {
+		JSON_BUILD_OBJECT(String("id"))
+	}, "jet: JSON_BUILD_OBJECT requires even number of arguments (key-value pairs)")
+}
+
+func TestJSON_AGG(t *testing.T) {
+	assertStatementSql(t, SELECT(
+		table1Col1,
+		SELECT(JSON_AGG(ROW_TO_JSON(table2))).
+			FROM(table2).
+			WHERE(table2ColInt.EQ(table1ColInt)).AS("table1.table2s"),
+	).FROM(table1), `
+SELECT table1.col1 AS "table1.col1",
+     (
+          SELECT JSON_AGG(ROW_TO_JSON(table2))
+          FROM db.table2
+          WHERE table2.col_int = table1.col_int
+     ) AS "table1.table2s"
+FROM db.table1;
+`)
+}
+
+func TestHashFunctions(t *testing.T) {
+	assertSerialize(t, SHA256(table2ColStr), "SHA256(CONVERT_TO(table2.col_str, 'UTF8'))::bytea")
+	assertSerialize(t, ENCODE(SHA512(table2ColStr), String("hex")),
+		"ENCODE(SHA512(CONVERT_TO(table2.col_str, 'UTF8'))::bytea, $1)", "hex")
+	assertSerialize(t, DIGEST(table2ColStr, String("sha1")), "digest(table2.col_str, $1)", "sha1")
+}
+
+func TestLocaleAwareComparison(t *testing.T) {
+	assertSerialize(t, UNACCENT(table2ColStr), "UNACCENT(table2.col_str)")
+	assertSerialize(t, EQ_IGNORE_CASE_AND_ACCENTS(table2ColStr, String("Café")),
+		"(LOWER(UNACCENT(table2.col_str)) = LOWER(UNACCENT($1)))", "Café")
+	assertSerialize(t, table2ColStr.COLLATE("de-DE-x-icu").GT(String("Straße")),
+		`((table2.col_str COLLATE "de-DE-x-icu") > $1)`, "Straße")
+}
+
+func TestCRYPT(t *testing.T) {
+	assertSerialize(t, CRYPT(String("secret"), GEN_SALT(String("bf"), Int(8))),
+		"crypt($1, gen_salt($2, $3))", "secret", "bf", int64(8))
+	assertSerialize(t, table2ColStr.EQ(CRYPT(String("secret"), table2ColStr)),
+		"(table2.col_str = crypt($1, table2.col_str))", "secret")
+	assertSerialize(t, GEN_SALT(String("md5")), "gen_salt($1)", "md5")
+}
+
+func TestAGG(t *testing.T) {
+	assertSerialize(t, AGG("my_custom_agg", table1ColInt), "my_custom_agg(table1.col_int)")
+	assertSerialize(t, AGG("string_agg", table2ColStr, String(", ")).
+		DISTINCT().
+		ORDER_BY(table2ColStr.DESC()).
+		FILTER(table2ColInt.GT(Int(10))),
+		"string_agg(DISTINCT table2.col_str, $1 ORDER BY table2.col_str DESC) FILTER (WHERE table2.col_int > $2)",
+		", ", int64(10))
+	assertSerialize(t, StringExp(AGG("array_agg", table1ColInt).ORDER_BY(table1ColInt.ASC())).EQ(String("{1}")),
+		"(array_agg(table1.col_int ORDER BY table1.col_int ASC) = $1)", "{1}")
+	assertSerialize(t, AGG("my_custom_agg", table1ColInt).FILTER(table1ColBool).OVER(PARTITION_BY(table1Col1)),
+		"my_custom_agg(table1.col_int) FILTER (WHERE table1.col_bool) OVER (PARTITION BY table1.col1)")
+
+	assertStatementSql(t, SELECT(AGG("hll_add_agg", table1ColInt).AS("hll")).FROM(table1), `
+SELECT hll_add_agg(table1.col_int) AS "hll"
+FROM db.table1;
+`)
+	assertPanicErr(t, func() { AGG("") }, "jet: aggregate function name is empty")
+}
+
+func TestSequenceFunctions(t *testing.T) {
+	assertSerialize(t, LASTVAL(), "LASTVAL()")
+	assertSerialize(t, CURRVAL("public.actor_actor_id_seq").ADD(Int(1)), "(CURRVAL('public.actor_actor_id_seq') + $1)", int64(1))
+}
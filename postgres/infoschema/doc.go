@@ -0,0 +1,6 @@
+// Package infoschema contains sql builder and model types of the most commonly used information_schema views
+// (subpackage view), so that ops tooling can write type safe queries against information schema without running
+// the generator against every cluster. Views contain only a subset of columns defined by the SQL standard.
+//
+// Column fields conflicting with table methods have Column suffix, for instance view.Tables.TableNameColumn.
+package infoschema
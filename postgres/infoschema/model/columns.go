@@ -0,0 +1,21 @@
+package model
+
+type Columns struct {
+	TableCatalog           *string
+	TableSchema            *string
+	TableName              *string
+	ColumnName             *string
+	OrdinalPosition        *int32
+	ColumnDefault          *string
+	IsNullable             *string
+	DataType               *string
+	CharacterMaximumLength *int32
+	NumericPrecision       *int32
+	NumericScale           *int32
+	DatetimePrecision      *int32
+	UdtSchema              *string
+	UdtName                *string
+	IsIdentity             *string
+	IsGenerated            *string
+	IsUpdatable            *string
+}
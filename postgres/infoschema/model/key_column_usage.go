@@ -0,0 +1,13 @@
+package model
+
+type KeyColumnUsage struct {
+	ConstraintCatalog          *string
+	ConstraintSchema           *string
+	ConstraintName             *string
+	TableCatalog               *string
+	TableSchema                *string
+	TableName                  *string
+	ColumnName                 *string
+	OrdinalPosition            *int32
+	PositionInUniqueConstraint *int32
+}
@@ -0,0 +1,7 @@
+package model
+
+type Schemata struct {
+	CatalogName *string
+	SchemaName  *string
+	SchemaOwner *string
+}
@@ -0,0 +1,13 @@
+package model
+
+type TableConstraints struct {
+	ConstraintCatalog *string
+	ConstraintSchema  *string
+	ConstraintName    *string
+	TableCatalog      *string
+	TableSchema       *string
+	TableName         *string
+	ConstraintType    *string
+	IsDeferrable      *string
+	InitiallyDeferred *string
+}
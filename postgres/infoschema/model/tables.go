@@ -0,0 +1,9 @@
+package model
+
+type Tables struct {
+	TableCatalog     *string
+	TableSchema      *string
+	TableName        *string
+	TableType        *string
+	IsInsertableInto *string
+}
@@ -0,0 +1,9 @@
+package model
+
+type Views struct {
+	TableCatalog   *string
+	TableSchema    *string
+	TableName      *string
+	ViewDefinition *string
+	IsUpdatable    *string
+}
@@ -0,0 +1,106 @@
+package view
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Columns = newColumnsTable("information_schema", "columns", "")
+
+type columnsTable struct {
+	postgres.Table
+
+	//Columns
+	TableCatalog           postgres.ColumnString
+	TableSchema            postgres.ColumnString
+	TableNameColumn        postgres.ColumnString
+	ColumnName             postgres.ColumnString
+	OrdinalPosition        postgres.ColumnInteger
+	ColumnDefault          postgres.ColumnString
+	IsNullable             postgres.ColumnString
+	DataType               postgres.ColumnString
+	CharacterMaximumLength postgres.ColumnInteger
+	NumericPrecision       postgres.ColumnInteger
+	NumericScale           postgres.ColumnInteger
+	DatetimePrecision      postgres.ColumnInteger
+	UdtSchema              postgres.ColumnString
+	UdtName                postgres.ColumnString
+	IsIdentity             postgres.ColumnString
+	IsGenerated            postgres.ColumnString
+	IsUpdatable            postgres.ColumnString
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type ColumnsTable struct {
+	columnsTable
+
+	EXCLUDED columnsTable
+}
+
+// AS creates new ColumnsTable with assigned alias
+func (a ColumnsTable) AS(alias string) *ColumnsTable {
+	return newColumnsTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new ColumnsTable with assigned schema name
+func (a ColumnsTable) FromSchema(schemaName string) *ColumnsTable {
+	return newColumnsTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newColumnsTable(schemaName, tableName, alias string) *ColumnsTable {
+	return &ColumnsTable{
+		columnsTable: newColumnsTableImpl(schemaName, tableName, alias),
+		EXCLUDED:     newColumnsTableImpl("", "excluded", ""),
+	}
+}
+
+func newColumnsTableImpl(schemaName, tableName, alias string) columnsTable {
+	var (
+		TableCatalogColumn           = postgres.StringColumn("table_catalog")
+		TableSchemaColumn            = postgres.StringColumn("table_schema")
+		TableNameColumnColumn        = postgres.StringColumn("table_name")
+		ColumnNameColumn             = postgres.StringColumn("column_name")
+		OrdinalPositionColumn        = postgres.IntegerColumn("ordinal_position")
+		ColumnDefaultColumn          = postgres.StringColumn("column_default")
+		IsNullableColumn             = postgres.StringColumn("is_nullable")
+		DataTypeColumn               = postgres.StringColumn("data_type")
+		CharacterMaximumLengthColumn = postgres.IntegerColumn("character_maximum_length")
+		NumericPrecisionColumn       = postgres.IntegerColumn("numeric_precision")
+		NumericScaleColumn           = postgres.IntegerColumn("numeric_scale")
+		DatetimePrecisionColumn      = postgres.IntegerColumn("datetime_precision")
+		UdtSchemaColumn              = postgres.StringColumn("udt_schema")
+		UdtNameColumn                = postgres.StringColumn("udt_name")
+		IsIdentityColumn             = postgres.StringColumn("is_identity")
+		IsGeneratedColumn            = postgres.StringColumn("is_generated")
+		IsUpdatableColumn            = postgres.StringColumn("is_updatable")
+		allColumns                   = postgres.ColumnList{TableCatalogColumn, TableSchemaColumn, TableNameColumnColumn, ColumnNameColumn, OrdinalPositionColumn, ColumnDefaultColumn, IsNullableColumn, DataTypeColumn, CharacterMaximumLengthColumn, NumericPrecisionColumn, NumericScaleColumn, DatetimePrecisionColumn, UdtSchemaColumn, UdtNameColumn, IsIdentityColumn, IsGeneratedColumn, IsUpdatableColumn}
+		mutableColumns               = postgres.ColumnList{TableCatalogColumn, TableSchemaColumn, TableNameColumnColumn, ColumnNameColumn, OrdinalPositionColumn, ColumnDefaultColumn, IsNullableColumn, DataTypeColumn, CharacterMaximumLengthColumn, NumericPrecisionColumn, NumericScaleColumn, DatetimePrecisionColumn, UdtSchemaColumn, UdtNameColumn, IsIdentityColumn, IsGeneratedColumn, IsUpdatableColumn}
+	)
+
+	return columnsTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		TableCatalog:           TableCatalogColumn,
+		TableSchema:            TableSchemaColumn,
+		TableNameColumn:        TableNameColumnColumn,
+		ColumnName:             ColumnNameColumn,
+		OrdinalPosition:        OrdinalPositionColumn,
+		ColumnDefault:          ColumnDefaultColumn,
+		IsNullable:             IsNullableColumn,
+		DataType:               DataTypeColumn,
+		CharacterMaximumLength: CharacterMaximumLengthColumn,
+		NumericPrecision:       NumericPrecisionColumn,
+		NumericScale:           NumericScaleColumn,
+		DatetimePrecision:      DatetimePrecisionColumn,
+		UdtSchema:              UdtSchemaColumn,
+		UdtName:                UdtNameColumn,
+		IsIdentity:             IsIdentityColumn,
+		IsGenerated:            IsGeneratedColumn,
+		IsUpdatable:            IsUpdatableColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -0,0 +1,82 @@
+package view
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var KeyColumnUsage = newKeyColumnUsageTable("information_schema", "key_column_usage", "")
+
+type keyColumnUsageTable struct {
+	postgres.Table
+
+	//Columns
+	ConstraintCatalog          postgres.ColumnString
+	ConstraintSchema           postgres.ColumnString
+	ConstraintName             postgres.ColumnString
+	TableCatalog               postgres.ColumnString
+	TableSchema                postgres.ColumnString
+	TableNameColumn            postgres.ColumnString
+	ColumnName                 postgres.ColumnString
+	OrdinalPosition            postgres.ColumnInteger
+	PositionInUniqueConstraint postgres.ColumnInteger
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type KeyColumnUsageTable struct {
+	keyColumnUsageTable
+
+	EXCLUDED keyColumnUsageTable
+}
+
+// AS creates new KeyColumnUsageTable with assigned alias
+func (a KeyColumnUsageTable) AS(alias string) *KeyColumnUsageTable {
+	return newKeyColumnUsageTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new KeyColumnUsageTable with assigned schema name
+func (a KeyColumnUsageTable) FromSchema(schemaName string) *KeyColumnUsageTable {
+	return newKeyColumnUsageTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newKeyColumnUsageTable(schemaName, tableName, alias string) *KeyColumnUsageTable {
+	return &KeyColumnUsageTable{
+		keyColumnUsageTable: newKeyColumnUsageTableImpl(schemaName, tableName, alias),
+		EXCLUDED:            newKeyColumnUsageTableImpl("", "excluded", ""),
+	}
+}
+
+func newKeyColumnUsageTableImpl(schemaName, tableName, alias string) keyColumnUsageTable {
+	var (
+		ConstraintCatalogColumn          = postgres.StringColumn("constraint_catalog")
+		ConstraintSchemaColumn           = postgres.StringColumn("constraint_schema")
+		ConstraintNameColumn             = postgres.StringColumn("constraint_name")
+		TableCatalogColumn               = postgres.StringColumn("table_catalog")
+		TableSchemaColumn                = postgres.StringColumn("table_schema")
+		TableNameColumnColumn            = postgres.StringColumn("table_name")
+		ColumnNameColumn                 = postgres.StringColumn("column_name")
+		OrdinalPositionColumn            = postgres.IntegerColumn("ordinal_position")
+		PositionInUniqueConstraintColumn = postgres.IntegerColumn("position_in_unique_constraint")
+		allColumns                       = postgres.ColumnList{ConstraintCatalogColumn, ConstraintSchemaColumn, ConstraintNameColumn, TableCatalogColumn, TableSchemaColumn, TableNameColumnColumn, ColumnNameColumn, OrdinalPositionColumn, PositionInUniqueConstraintColumn}
+		mutableColumns                   = postgres.ColumnList{ConstraintCatalogColumn, ConstraintSchemaColumn, ConstraintNameColumn, TableCatalogColumn, TableSchemaColumn, TableNameColumnColumn, ColumnNameColumn, OrdinalPositionColumn, PositionInUniqueConstraintColumn}
+	)
+
+	return keyColumnUsageTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ConstraintCatalog:          ConstraintCatalogColumn,
+		ConstraintSchema:           ConstraintSchemaColumn,
+		ConstraintName:             ConstraintNameColumn,
+		TableCatalog:               TableCatalogColumn,
+		TableSchema:                TableSchemaColumn,
+		TableNameColumn:            TableNameColumnColumn,
+		ColumnName:                 ColumnNameColumn,
+		OrdinalPosition:            OrdinalPositionColumn,
+		PositionInUniqueConstraint: PositionInUniqueConstraintColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -0,0 +1,64 @@
+package view
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Schemata = newSchemataTable("information_schema", "schemata", "")
+
+type schemataTable struct {
+	postgres.Table
+
+	//Columns
+	CatalogName      postgres.ColumnString
+	SchemaNameColumn postgres.ColumnString
+	SchemaOwner      postgres.ColumnString
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type SchemataTable struct {
+	schemataTable
+
+	EXCLUDED schemataTable
+}
+
+// AS creates new SchemataTable with assigned alias
+func (a SchemataTable) AS(alias string) *SchemataTable {
+	return newSchemataTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new SchemataTable with assigned schema name
+func (a SchemataTable) FromSchema(schemaName string) *SchemataTable {
+	return newSchemataTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newSchemataTable(schemaName, tableName, alias string) *SchemataTable {
+	return &SchemataTable{
+		schemataTable: newSchemataTableImpl(schemaName, tableName, alias),
+		EXCLUDED:      newSchemataTableImpl("", "excluded", ""),
+	}
+}
+
+func newSchemataTableImpl(schemaName, tableName, alias string) schemataTable {
+	var (
+		CatalogNameColumn      = postgres.StringColumn("catalog_name")
+		SchemaNameColumnColumn = postgres.StringColumn("schema_name")
+		SchemaOwnerColumn      = postgres.StringColumn("schema_owner")
+		allColumns             = postgres.ColumnList{CatalogNameColumn, SchemaNameColumnColumn, SchemaOwnerColumn}
+		mutableColumns         = postgres.ColumnList{CatalogNameColumn, SchemaNameColumnColumn, SchemaOwnerColumn}
+	)
+
+	return schemataTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		CatalogName:      CatalogNameColumn,
+		SchemaNameColumn: SchemaNameColumnColumn,
+		SchemaOwner:      SchemaOwnerColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
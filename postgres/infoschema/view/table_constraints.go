@@ -0,0 +1,82 @@
+package view
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var TableConstraints = newTableConstraintsTable("information_schema", "table_constraints", "")
+
+type tableConstraintsTable struct {
+	postgres.Table
+
+	//Columns
+	ConstraintCatalog postgres.ColumnString
+	ConstraintSchema  postgres.ColumnString
+	ConstraintName    postgres.ColumnString
+	TableCatalog      postgres.ColumnString
+	TableSchema       postgres.ColumnString
+	TableNameColumn   postgres.ColumnString
+	ConstraintType    postgres.ColumnString
+	IsDeferrable      postgres.ColumnString
+	InitiallyDeferred postgres.ColumnString
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type TableConstraintsTable struct {
+	tableConstraintsTable
+
+	EXCLUDED tableConstraintsTable
+}
+
+// AS creates new TableConstraintsTable with assigned alias
+func (a TableConstraintsTable) AS(alias string) *TableConstraintsTable {
+	return newTableConstraintsTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new TableConstraintsTable with assigned schema name
+func (a TableConstraintsTable) FromSchema(schemaName string) *TableConstraintsTable {
+	return newTableConstraintsTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newTableConstraintsTable(schemaName, tableName, alias string) *TableConstraintsTable {
+	return &TableConstraintsTable{
+		tableConstraintsTable: newTableConstraintsTableImpl(schemaName, tableName, alias),
+		EXCLUDED:              newTableConstraintsTableImpl("", "excluded", ""),
+	}
+}
+
+func newTableConstraintsTableImpl(schemaName, tableName, alias string) tableConstraintsTable {
+	var (
+		ConstraintCatalogColumn = postgres.StringColumn("constraint_catalog")
+		ConstraintSchemaColumn  = postgres.StringColumn("constraint_schema")
+		ConstraintNameColumn    = postgres.StringColumn("constraint_name")
+		TableCatalogColumn      = postgres.StringColumn("table_catalog")
+		TableSchemaColumn       = postgres.StringColumn("table_schema")
+		TableNameColumnColumn   = postgres.StringColumn("table_name")
+		ConstraintTypeColumn    = postgres.StringColumn("constraint_type")
+		IsDeferrableColumn      = postgres.StringColumn("is_deferrable")
+		InitiallyDeferredColumn = postgres.StringColumn("initially_deferred")
+		allColumns              = postgres.ColumnList{ConstraintCatalogColumn, ConstraintSchemaColumn, ConstraintNameColumn, TableCatalogColumn, TableSchemaColumn, TableNameColumnColumn, ConstraintTypeColumn, IsDeferrableColumn, InitiallyDeferredColumn}
+		mutableColumns          = postgres.ColumnList{ConstraintCatalogColumn, ConstraintSchemaColumn, ConstraintNameColumn, TableCatalogColumn, TableSchemaColumn, TableNameColumnColumn, ConstraintTypeColumn, IsDeferrableColumn, InitiallyDeferredColumn}
+	)
+
+	return tableConstraintsTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ConstraintCatalog: ConstraintCatalogColumn,
+		ConstraintSchema:  ConstraintSchemaColumn,
+		ConstraintName:    ConstraintNameColumn,
+		TableCatalog:      TableCatalogColumn,
+		TableSchema:       TableSchemaColumn,
+		TableNameColumn:   TableNameColumnColumn,
+		ConstraintType:    ConstraintTypeColumn,
+		IsDeferrable:      IsDeferrableColumn,
+		InitiallyDeferred: InitiallyDeferredColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
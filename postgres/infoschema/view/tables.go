@@ -0,0 +1,70 @@
+package view
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Tables = newTablesTable("information_schema", "tables", "")
+
+type tablesTable struct {
+	postgres.Table
+
+	//Columns
+	TableCatalog     postgres.ColumnString
+	TableSchema      postgres.ColumnString
+	TableNameColumn  postgres.ColumnString
+	TableType        postgres.ColumnString
+	IsInsertableInto postgres.ColumnString
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type TablesTable struct {
+	tablesTable
+
+	EXCLUDED tablesTable
+}
+
+// AS creates new TablesTable with assigned alias
+func (a TablesTable) AS(alias string) *TablesTable {
+	return newTablesTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new TablesTable with assigned schema name
+func (a TablesTable) FromSchema(schemaName string) *TablesTable {
+	return newTablesTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newTablesTable(schemaName, tableName, alias string) *TablesTable {
+	return &TablesTable{
+		tablesTable: newTablesTableImpl(schemaName, tableName, alias),
+		EXCLUDED:    newTablesTableImpl("", "excluded", ""),
+	}
+}
+
+func newTablesTableImpl(schemaName, tableName, alias string) tablesTable {
+	var (
+		TableCatalogColumn     = postgres.StringColumn("table_catalog")
+		TableSchemaColumn      = postgres.StringColumn("table_schema")
+		TableNameColumnColumn  = postgres.StringColumn("table_name")
+		TableTypeColumn        = postgres.StringColumn("table_type")
+		IsInsertableIntoColumn = postgres.StringColumn("is_insertable_into")
+		allColumns             = postgres.ColumnList{TableCatalogColumn, TableSchemaColumn, TableNameColumnColumn, TableTypeColumn, IsInsertableIntoColumn}
+		mutableColumns         = postgres.ColumnList{TableCatalogColumn, TableSchemaColumn, TableNameColumnColumn, TableTypeColumn, IsInsertableIntoColumn}
+	)
+
+	return tablesTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		TableCatalog:     TableCatalogColumn,
+		TableSchema:      TableSchemaColumn,
+		TableNameColumn:  TableNameColumnColumn,
+		TableType:        TableTypeColumn,
+		IsInsertableInto: IsInsertableIntoColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
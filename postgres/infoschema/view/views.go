@@ -0,0 +1,70 @@
+package view
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Views = newViewsTable("information_schema", "views", "")
+
+type viewsTable struct {
+	postgres.Table
+
+	//Columns
+	TableCatalog    postgres.ColumnString
+	TableSchema     postgres.ColumnString
+	TableNameColumn postgres.ColumnString
+	ViewDefinition  postgres.ColumnString
+	IsUpdatable     postgres.ColumnString
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type ViewsTable struct {
+	viewsTable
+
+	EXCLUDED viewsTable
+}
+
+// AS creates new ViewsTable with assigned alias
+func (a ViewsTable) AS(alias string) *ViewsTable {
+	return newViewsTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new ViewsTable with assigned schema name
+func (a ViewsTable) FromSchema(schemaName string) *ViewsTable {
+	return newViewsTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newViewsTable(schemaName, tableName, alias string) *ViewsTable {
+	return &ViewsTable{
+		viewsTable: newViewsTableImpl(schemaName, tableName, alias),
+		EXCLUDED:   newViewsTableImpl("", "excluded", ""),
+	}
+}
+
+func newViewsTableImpl(schemaName, tableName, alias string) viewsTable {
+	var (
+		TableCatalogColumn    = postgres.StringColumn("table_catalog")
+		TableSchemaColumn     = postgres.StringColumn("table_schema")
+		TableNameColumnColumn = postgres.StringColumn("table_name")
+		ViewDefinitionColumn  = postgres.StringColumn("view_definition")
+		IsUpdatableColumn     = postgres.StringColumn("is_updatable")
+		allColumns            = postgres.ColumnList{TableCatalogColumn, TableSchemaColumn, TableNameColumnColumn, ViewDefinitionColumn, IsUpdatableColumn}
+		mutableColumns        = postgres.ColumnList{TableCatalogColumn, TableSchemaColumn, TableNameColumnColumn, ViewDefinitionColumn, IsUpdatableColumn}
+	)
+
+	return viewsTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		TableCatalog:    TableCatalogColumn,
+		TableSchema:     TableSchemaColumn,
+		TableNameColumn: TableNameColumnColumn,
+		ViewDefinition:  ViewDefinitionColumn,
+		IsUpdatable:     IsUpdatableColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -1,6 +1,16 @@
 package postgres
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// maxParameters is the maximum number of parameters PostgreSQL accepts in a single statement
+const maxParameters = 65535
 
 // InsertStatement is interface for SQL INSERT statements
 type InsertStatement interface {
@@ -12,11 +22,28 @@ type InsertStatement interface {
 	// If data is not struct or there is no field for every column selected, this method will panic.
 	MODEL(data interface{}) InsertStatement
 	MODELS(data interface{}) InsertStatement
+	// MODELS_CHUNKED inserts rows of values extracted from the slice of structures data, like MODELS. When executed,
+	// rows are split into chunks of at most chunkSize rows, each inserted with a separate statement, so that the
+	// statement parameter limit is not exceeded. If chunkSize is 0, or too big for the parameter limit, the maximum
+	// chunk size is used. If db is *sql.DB, chunks are inserted in a new transaction. Exec returns the total number
+	// of rows affected, and Query stores rows returned by all the chunks into destination slice.
+	MODELS_CHUNKED(data interface{}, chunkSize int) InsertStatement
 	QUERY(selectStatement SelectStatement) InsertStatement
 
 	ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict
+	// ON_CONFLICT_EXPRESSION sets conflict target to the list of index columns and expressions, matching unique
+	// expression index, for instance ON_CONFLICT_EXPRESSION(LOWER(User.Email))
+	ON_CONFLICT_EXPRESSION(indexExpressions ...Expression) onConflict
 
 	RETURNING(projections ...Projection) InsertStatement
+
+	// ExecAndReturnID executes single row INSERT statement with RETURNING idColumn clause (replacing RETURNING clause
+	// of the statement, if any), and returns generated idColumn value of the inserted row. If no row is inserted (for
+	// instance because of ON CONFLICT DO NOTHING), qrm.ErrNoRows is returned.
+	ExecAndReturnID(ctx context.Context, db qrm.Queryable, idColumn ColumnInteger) (int64, error)
+
+	// ToPrepared creates PREPARE/EXECUTE/DEALLOCATE statements for server-side prepared statement name
+	ToPrepared(name string) PreparedStatement
 }
 
 func newInsertStatement(table WritableTable, columns []jet.Column) InsertStatement {
@@ -41,6 +68,8 @@ type insertStatementImpl struct {
 	ValuesQuery jet.ClauseValuesQuery
 	Returning   jet.ClauseReturning
 	OnConflict  onConflictClause
+
+	chunks jet.InsertChunks
 }
 
 func (i *insertStatementImpl) VALUES(value interface{}, values ...interface{}) InsertStatement {
@@ -58,20 +87,111 @@ func (i *insertStatementImpl) MODELS(data interface{}) InsertStatement {
 	return i
 }
 
+func (i *insertStatementImpl) MODELS_CHUNKED(data interface{}, chunkSize int) InsertStatement {
+	i.MODELS(data)
+	i.chunks.Size = jet.ChunkSize(chunkSize, len(i.Insert.GetColumns()), maxParameters)
+	return i
+}
+
+func (i *insertStatementImpl) Exec(db qrm.Executable) (sql.Result, error) {
+	return i.ExecContext(context.Background(), db)
+}
+
+func (i *insertStatementImpl) ExecContext(ctx context.Context, db qrm.Executable) (sql.Result, error) {
+	return i.chunks.ExecInChunks(ctx, db, i.SerializerStatement, &i.ValuesQuery.ClauseValues)
+}
+
+func (i *insertStatementImpl) Query(db qrm.Queryable, destination interface{}) error {
+	return i.QueryContext(context.Background(), db, destination)
+}
+
+func (i *insertStatementImpl) QueryContext(ctx context.Context, db qrm.Queryable, destination interface{}) error {
+	return i.chunks.QueryInChunks(ctx, db, i.SerializerStatement, &i.ValuesQuery.ClauseValues, destination)
+}
+
 func (i *insertStatementImpl) RETURNING(projections ...jet.Projection) InsertStatement {
 	i.Returning.ProjectionList = projections
 	return i
 }
 
+func (i *insertStatementImpl) ExecAndReturnID(ctx context.Context, db qrm.Queryable, idColumn ColumnInteger) (int64, error) {
+	if len(i.ValuesQuery.Rows) > 1 {
+		return 0, errors.New("jet: ExecAndReturnID requires single row INSERT statement")
+	}
+
+	insert := i.copy()
+	insert.Returning.ProjectionList = ProjectionList{idColumn}
+
+	rows, err := insert.Rows(ctx, db)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+		return 0, qrm.ErrNoRows
+	}
+
+	var id int64
+
+	if err := rows.Rows.Scan(&id); err != nil {
+		return 0, err
+	}
+
+	if rows.Next() {
+		return 0, errors.New("jet: ExecAndReturnID requires single row INSERT statement")
+	}
+
+	return id, rows.Err()
+}
+
 func (i *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertStatement {
 	i.ValuesQuery.Query = selectStatement
 	return i
 }
 
 func (i *insertStatementImpl) ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict {
+	i.OnConflict = onConflictClause{
+		insertStatement:  i,
+		indexExpressions: jet.ColumnListToExpressionList(indexExpressions),
+	}
+	return &i.OnConflict
+}
+
+func (i *insertStatementImpl) ON_CONFLICT_EXPRESSION(indexExpressions ...Expression) onConflict {
 	i.OnConflict = onConflictClause{
 		insertStatement:  i,
 		indexExpressions: indexExpressions,
 	}
 	return &i.OnConflict
 }
+
+func (i *insertStatementImpl) ToPrepared(name string) PreparedStatement {
+	return PREPARE(name, i)
+}
+
+// copy returns new insert statement with the copy of the statement clauses, so that the clauses of the new statement
+// can be changed without affecting the original statement
+func (i *insertStatementImpl) copy() *insertStatementImpl {
+	newInsert := &insertStatementImpl{
+		Insert:      i.Insert,
+		ValuesQuery: i.ValuesQuery,
+		Returning:   i.Returning,
+		OnConflict:  i.OnConflict,
+		chunks:      i.chunks,
+	}
+	newInsert.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newInsert,
+		&newInsert.Insert,
+		&newInsert.ValuesQuery,
+		&newInsert.OnConflict,
+		&newInsert.Returning,
+	)
+	newInsert.OnConflict.insertStatement = newInsert
+
+	return newInsert
+}
@@ -1,7 +1,10 @@
 package postgres
 
 import (
+	"context"
+	"database/sql/driver"
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
 	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
@@ -50,6 +53,21 @@ VALUES ($1, $2, $3);
 `, 1, 2, 3)
 }
 
+func TestInsertArrayAndJSONValues(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1ColFloat, table1ColBool).
+		VALUES([]int64{1, 2}, []string{"a", "b"}, map[string]interface{}{"key": 1})
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float, col_bool)
+VALUES ($1, $2, $3);
+`, "{1,2}", `{"a","b"}`, `{"key":1}`)
+
+	assertDebugStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float, col_bool)
+VALUES ('{1,2}', '{"a","b"}', '{"key":1}');
+`)
+}
+
 func TestInsertMultipleRows(t *testing.T) {
 	stmt := table1.INSERT(table1Col1, table1ColFloat).
 		VALUES(1, 2).
@@ -90,6 +108,30 @@ VALUES ($1, $2),
 	assertStatementSql(t, stmt, expectedSQL, 1, float64(1.11), 1, float64(1.11))
 }
 
+func TestInsertValuesFromModelJSONField(t *testing.T) {
+	type Metadata struct {
+		Tags   []string
+		Rating int
+	}
+
+	type Table1Model struct {
+		Col1     int
+		ColFloat *Metadata `sql:"json"`
+	}
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat).
+		MODELS([]Table1Model{
+			{Col1: 1, ColFloat: &Metadata{Tags: []string{"new"}, Rating: 5}},
+			{Col1: 2},
+		})
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES ($1, $2),
+       ($3, $4);
+`, 1, `{"Tags":["new"],"Rating":5}`, 2, nil)
+}
+
 func TestInsertValuesFromModelColumnMismatch(t *testing.T) {
 	defer func() {
 		r := recover()
@@ -199,3 +241,41 @@ RETURNING table1.col1 AS "table1.col1",
           table1.col_bool AS "table1.col_bool";
 `)
 }
+
+func TestInsert_ON_CONFLICT_EXPRESSION(t *testing.T) {
+	stmt := table2.INSERT(table2ColInt, table2ColStr).
+		VALUES(1, "Email@Example.com").
+		ON_CONFLICT_EXPRESSION(table2ColInt, LOWER(table2ColStr)).DO_NOTHING()
+
+	assertDebugStatementSql(t, stmt, `
+INSERT INTO db.table2 (col_int, col_str)
+VALUES (1, 'Email@Example.com')
+ON CONFLICT (col_int, (LOWER(table2.col_str))) DO NOTHING;
+`)
+}
+
+func TestInsertExecAndReturnID(t *testing.T) {
+	drv := &copyDriver{columns: []string{"table1.col_int"}, rows: [][]driver.Value{{int64(7)}}}
+	db := openCopyDB(drv)
+	defer db.Close()
+
+	insert := table1.INSERT(table1Col1).VALUES(1).RETURNING(table1Col1)
+
+	id, err := insert.ExecAndReturnID(context.Background(), db, table1ColInt)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), id)
+	require.Equal(t, []string{"\nINSERT INTO db.table1 (col1)\nVALUES ($1)\nRETURNING table1.col_int AS \"table1.col_int\";\n"}, drv.prepared)
+
+	assertStatementSql(t, insert, `
+INSERT INTO db.table1 (col1)
+VALUES ($1)
+RETURNING table1.col1 AS "table1.col1";
+`, 1) // original statement is not changed
+
+	drv.rows = nil
+	_, err = insert.ExecAndReturnID(context.Background(), db, table1ColInt)
+	require.Equal(t, qrm.ErrNoRows, err)
+
+	_, err = table1.INSERT(table1Col1).VALUES(1).VALUES(2).ExecAndReturnID(context.Background(), db, table1ColInt)
+	require.EqualError(t, err, "jet: ExecAndReturnID requires single row INSERT statement")
+}
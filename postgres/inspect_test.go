@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspectSelect(t *testing.T) {
+	references := Inspect(
+		SELECT(table1ColInt, table2ColInt).
+			FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+			WHERE(table1Col1.EQ(Int(11)).AND(table2Col3.GT(Int(2)))),
+	)
+
+	require.Equal(t, jet.SelectStatementType, references.StatementType)
+	require.Equal(t, []TableReference{
+		{SchemaName: "db", TableName: "table1"},
+		{SchemaName: "db", TableName: "table2"},
+	}, references.Tables)
+	require.Empty(t, references.WrittenColumns)
+
+	intColumn := func(tableName, columnName string) ColumnReference {
+		return ColumnReference{TableName: tableName, ColumnName: columnName, Type: "Integer"}
+	}
+
+	otherColumn := intColumn("table2", "col_int")
+
+	require.Equal(t, []Predicate{
+		{Column: intColumn("table1", "col_int"), Operator: "=", OtherColumn: &otherColumn},
+		{Column: intColumn("table1", "col1"), Operator: "=", Value: int64(11)},
+		{Column: intColumn("table2", "col3"), Operator: ">", Value: int64(2)},
+	}, references.Predicates)
+	require.Equal(t, []ColumnReference{intColumn("table1", "col1")}, references.Filters)
+	require.Equal(t, []ConjunctFilter{{Column: intColumn("table1", "col1")}}, references.ConjunctFilters)
+}
+
+func TestInspectUpdate(t *testing.T) {
+	references := Inspect(
+		table1.UPDATE(table1ColInt, table1ColBool).
+			SET(Int(1), Bool(true)).
+			WHERE(table1Col1.EQ(Int(2))),
+	)
+
+	require.Equal(t, jet.UpdateStatementType, references.StatementType)
+	require.Equal(t, []ColumnReference{
+		{TableName: "table1", ColumnName: "col_int", Type: "Integer"},
+		{TableName: "table1", ColumnName: "col_bool", Type: "Bool"},
+	}, references.WrittenColumns)
+}
+
+func TestInspectInsert(t *testing.T) {
+	references := Inspect(
+		table1.INSERT(table1Col1, table1ColInt).
+			VALUES(1, 2).
+			ON_CONFLICT(table1Col1).DO_UPDATE(SET(table1ColBool.SET(Bool(false)))),
+	)
+
+	require.Equal(t, jet.InsertStatementType, references.StatementType)
+	require.Equal(t, []ColumnReference{
+		{TableName: "table1", ColumnName: "col1", Type: "Integer"},
+		{TableName: "table1", ColumnName: "col_int", Type: "Integer"},
+		{TableName: "table1", ColumnName: "col_bool", Type: "Bool"},
+	}, references.WrittenColumns)
+}
+
+func TestInspectWith(t *testing.T) {
+	cte := CTE("cte")
+	cteCol1 := table1Col1.From(cte)
+
+	references := Inspect(
+		WITH(
+			cte.AS(
+				SELECT(table1Col1).
+					FROM(table1).
+					WHERE(table1ColInt.EQ(Int(3))),
+			),
+		)(
+			SELECT(cteCol1, table2ColInt).
+				FROM(cte.INNER_JOIN(table2, cteCol1.EQ(table2ColInt))),
+		),
+	)
+
+	require.Equal(t, jet.SelectStatementType, references.StatementType)
+	require.Equal(t, 1, references.SubQueries)
+	require.Equal(t, []TableReference{
+		{SchemaName: "db", TableName: "table1", Statement: 1},
+		{SchemaName: "db", TableName: "table2"},
+	}, references.Tables)
+	require.Contains(t, references.Columns, ColumnReference{TableName: "table1", ColumnName: "col_int", Type: "Integer"})
+	require.Contains(t, references.Filters, ColumnReference{TableName: "table1", ColumnName: "col_int", Type: "Integer"})
+}
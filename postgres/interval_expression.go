@@ -25,6 +25,14 @@ const (
 	DECADE
 	CENTURY
 	MILLENNIUM
+
+	// fields which can only be extracted with EXTRACT
+	EPOCH
+	DOW
+	DOY
+	ISODOW
+	ISOYEAR
+	QUARTER
 )
 
 // IntervalExpression is representation of postgres INTERVAL
@@ -182,6 +190,41 @@ func INTERVALd(duration time.Duration) IntervalExpression {
 	return INTERVAL(quantityAndUnits...)
 }
 
+// Years creates interval of years. For example: NOW().SUB(Years(1))
+func Years(years int64) IntervalExpression {
+	return INTERVAL(float64(years), YEAR)
+}
+
+// Months creates interval of months. For example: CURRENT_DATE().ADD(Months(1))
+func Months(months int64) IntervalExpression {
+	return INTERVAL(float64(months), MONTH)
+}
+
+// Weeks creates interval of weeks
+func Weeks(weeks int64) IntervalExpression {
+	return INTERVAL(float64(weeks), WEEK)
+}
+
+// Days creates interval of days. For example: NOW().SUB(Days(30))
+func Days(days int64) IntervalExpression {
+	return INTERVAL(float64(days), DAY)
+}
+
+// Hours creates interval of hours
+func Hours(hours int64) IntervalExpression {
+	return INTERVAL(float64(hours), HOUR)
+}
+
+// Minutes creates interval of minutes
+func Minutes(minutes int64) IntervalExpression {
+	return INTERVAL(float64(minutes), MINUTE)
+}
+
+// Seconds creates interval of seconds
+func Seconds(seconds int64) IntervalExpression {
+	return INTERVAL(float64(seconds), SECOND)
+}
+
 func unitToString(unit quantityAndUnit) string {
 	switch unit {
 	case YEAR:
@@ -213,6 +256,31 @@ func unitToString(unit quantityAndUnit) string {
 	}
 }
 
+// EXTRACT retrieves field from date, time, timestamp or interval expression. Besides interval units, field can be
+// EPOCH, DOW, DOY, ISODOW, ISOYEAR or QUARTER. For example: EXTRACT(EPOCH, table.Duration)
+func EXTRACT(field quantityAndUnit, from Expression) FloatExpression {
+	return FloatExp(jet.EXTRACT(extractFieldToString(field), from))
+}
+
+func extractFieldToString(field quantityAndUnit) string {
+	switch field {
+	case EPOCH:
+		return "EPOCH"
+	case DOW:
+		return "DOW"
+	case DOY:
+		return "DOY"
+	case ISODOW:
+		return "ISODOW"
+	case ISOYEAR:
+		return "ISOYEAR"
+	case QUARTER:
+		return "QUARTER"
+	default:
+		return unitToString(field)
+	}
+}
+
 //---------------------------------------------------//
 
 type intervalWrapper struct {
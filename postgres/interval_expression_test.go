@@ -82,3 +82,20 @@ func TestIntervalExpressionMethods(t *testing.T) {
 	assertSerialize(t, table1ColInterval.DIV(table2ColInt), "(table1.col_interval / table2.col_int)")
 	assertSerialize(t, table1ColInterval.DIV(table2ColFloat), "(table1.col_interval / table2.col_float)")
 }
+
+func TestEXTRACT(t *testing.T) {
+	assertSerialize(t, EXTRACT(YEAR, table1ColTimestamp), "EXTRACT(YEAR FROM table1.col_timestamp)")
+	assertSerialize(t, EXTRACT(EPOCH, table1ColInterval), "EXTRACT(EPOCH FROM table1.col_interval)")
+	assertSerialize(t, EXTRACT(DOW, table1ColDate).EQ(Float(0)), "(EXTRACT(DOW FROM table1.col_date) = $1)", 0.0)
+	assertSerialize(t, EXTRACT(EPOCH, table1ColTimestamp.SUB(INTERVAL(1, DAY))),
+		"EXTRACT(EPOCH FROM (table1.col_timestamp - INTERVAL '1 DAY'))")
+	assertPanicErr(t, func() { INTERVAL(1, EPOCH) }, "jet: invalid INTERVAL unit type")
+}
+
+func TestRelativeInterval(t *testing.T) {
+	assertSerialize(t, NOW().SUB(Days(30)), "(NOW() - INTERVAL '30 DAY')")
+	assertSerialize(t, CURRENT_DATE().ADD(Months(1)), "(CURRENT_DATE + INTERVAL '1 MONTH')")
+	assertSerialize(t, table1ColTimestamp.ADD(Years(2)), "(table1.col_timestamp + INTERVAL '2 YEAR')")
+	assertSerialize(t, Weeks(1).ADD(Hours(3)).ADD(Minutes(4)).ADD(Seconds(-5)),
+		"(((INTERVAL '1 WEEK' + INTERVAL '3 HOUR') + INTERVAL '4 MINUTE') + INTERVAL '-5 SECOND')")
+}
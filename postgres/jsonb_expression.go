@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// JSONBExpression is representation of jsonb type expressions
+type JSONBExpression interface {
+	jet.Expression
+
+	isJSONB()
+
+	EQ(rhs JSONBExpression) BoolExpression
+	NOT_EQ(rhs JSONBExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs JSONBExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs JSONBExpression) BoolExpression
+
+	// GET returns object field with the key (-> operator)
+	GET(key string) JSONBExpression
+	// GET_ELEM returns array element at index (-> operator). Negative index counts from the end of the array.
+	GET_ELEM(index int) JSONBExpression
+	// GET_TEXT returns object field with the key as text (->> operator)
+	GET_TEXT(key string) StringExpression
+	// GET_ELEM_TEXT returns array element at index as text (->> operator)
+	GET_ELEM_TEXT(index int) StringExpression
+	// GET_PATH returns sub-object at the path of keys or array indexes (#> operator)
+	GET_PATH(path ...string) JSONBExpression
+	// GET_PATH_TEXT returns sub-object at the path of keys or array indexes as text (#>> operator)
+	GET_PATH_TEXT(path ...string) StringExpression
+
+	// CONTAINS returns true if this value contains rhs value (@> operator)
+	CONTAINS(rhs JSONBExpression) BoolExpression
+	// CONTAINED_BY returns true if this value is contained by rhs value (<@ operator)
+	CONTAINED_BY(rhs JSONBExpression) BoolExpression
+	// HAS_KEY returns true if key exists as a top-level object key or array string element (? operator)
+	HAS_KEY(key string) BoolExpression
+	// HAS_ANY_KEY returns true if any of the keys exist as top-level keys or array string elements (?| operator)
+	HAS_ANY_KEY(keys ...string) BoolExpression
+	// HAS_ALL_KEYS returns true if all the keys exist as top-level keys or array string elements (?& operator)
+	HAS_ALL_KEYS(keys ...string) BoolExpression
+
+	// CONCAT concatenates two jsonb values (|| operator)
+	CONCAT(rhs JSONBExpression) JSONBExpression
+}
+
+type jsonbInterfaceImpl struct {
+	parent JSONBExpression
+}
+
+func (j *jsonbInterfaceImpl) isJSONB() {}
+
+func (j *jsonbInterfaceImpl) EQ(rhs JSONBExpression) BoolExpression {
+	return jet.Eq(j.parent, rhs)
+}
+
+func (j *jsonbInterfaceImpl) NOT_EQ(rhs JSONBExpression) BoolExpression {
+	return jet.NotEq(j.parent, rhs)
+}
+
+func (j *jsonbInterfaceImpl) IS_DISTINCT_FROM(rhs JSONBExpression) BoolExpression {
+	return jet.IsDistinctFrom(j.parent, rhs)
+}
+
+func (j *jsonbInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs JSONBExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(j.parent, rhs)
+}
+
+func (j *jsonbInterfaceImpl) GET(key string) JSONBExpression {
+	return JSONBExp(jet.NewBinaryOperatorExpression(j.parent, CAST(String(key)).AS_TEXT(), "->"))
+}
+
+func (j *jsonbInterfaceImpl) GET_ELEM(index int) JSONBExpression {
+	return JSONBExp(jet.NewBinaryOperatorExpression(j.parent, CAST(Int(int64(index))).AS_INTEGER(), "->"))
+}
+
+func (j *jsonbInterfaceImpl) GET_TEXT(key string) StringExpression {
+	return StringExp(jet.NewBinaryOperatorExpression(j.parent, CAST(String(key)).AS_TEXT(), "->>"))
+}
+
+func (j *jsonbInterfaceImpl) GET_ELEM_TEXT(index int) StringExpression {
+	return StringExp(jet.NewBinaryOperatorExpression(j.parent, CAST(Int(int64(index))).AS_INTEGER(), "->>"))
+}
+
+func (j *jsonbInterfaceImpl) GET_PATH(path ...string) JSONBExpression {
+	return JSONBExp(jet.NewBinaryOperatorExpression(j.parent, textArray(path), "#>"))
+}
+
+func (j *jsonbInterfaceImpl) GET_PATH_TEXT(path ...string) StringExpression {
+	return StringExp(jet.NewBinaryOperatorExpression(j.parent, textArray(path), "#>>"))
+}
+
+func (j *jsonbInterfaceImpl) CONTAINS(rhs JSONBExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(j.parent, rhs, "@>"))
+}
+
+func (j *jsonbInterfaceImpl) CONTAINED_BY(rhs JSONBExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(j.parent, rhs, "<@"))
+}
+
+func (j *jsonbInterfaceImpl) HAS_KEY(key string) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(j.parent, CAST(String(key)).AS_TEXT(), "?"))
+}
+
+func (j *jsonbInterfaceImpl) HAS_ANY_KEY(keys ...string) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(j.parent, textArray(keys), "?|"))
+}
+
+func (j *jsonbInterfaceImpl) HAS_ALL_KEYS(keys ...string) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(j.parent, textArray(keys), "?&"))
+}
+
+func (j *jsonbInterfaceImpl) CONCAT(rhs JSONBExpression) JSONBExpression {
+	return JSONBExp(jet.NewBinaryOperatorExpression(j.parent, rhs, "||"))
+}
+
+//---------------------------------------------------//
+
+// Jsonb creates new jsonb literal expression from json text, for instance `{"rating": 5}`.
+func Jsonb(json string) JSONBExpression {
+	return CAST(String(json)).AS_JSONB()
+}
+
+// JsonbFrom creates new jsonb literal expression from go map, slice or struct value, marshaled to json text
+// with encoding/json.
+func JsonbFrom(value interface{}) JSONBExpression {
+	data, err := json.Marshal(value)
+
+	if err != nil {
+		panic(fmt.Sprintf("jet: failed to marshal %T to json, %s", value, err))
+	}
+
+	return Jsonb(string(data))
+}
+
+// JSONB_SET returns target with the item designated by path replaced by newValue. If createMissing is true
+// (default), newValue is added if the item designated by path does not exist.
+func JSONB_SET(target JSONBExpression, path []string, newValue JSONBExpression, createMissing ...bool) JSONBExpression {
+	args := []Expression{target, textArray(path), newValue}
+
+	if len(createMissing) > 0 {
+		args = append(args, Bool(createMissing[0]))
+	}
+
+	return JSONBExp(jet.NewFunc("jsonb_set", args, nil))
+}
+
+//---------------------------------------------------//
+
+type jsonbWrapper struct {
+	jsonbInterfaceImpl
+	Expression
+}
+
+func newJSONBExpressionWrap(expression Expression) JSONBExpression {
+	jsonbWrap := &jsonbWrapper{Expression: expression}
+	jsonbWrap.jsonbInterfaceImpl.parent = jsonbWrap
+	return jsonbWrap
+}
+
+// JSONBExp is jsonb expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as jsonb expression.
+// Does not add sql cast to generated sql builder output.
+func JSONBExp(expression Expression) JSONBExpression {
+	return newJSONBExpressionWrap(expression)
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"testing"
+)
+
+var docData = JSONBColumn("data")
+var docs = NewTable("db", "docs", "", docData)
+
+func TestJSONBExpression(t *testing.T) {
+	assertSerialize(t, Jsonb(`{"a": 1}`), "$1::jsonb", `{"a": 1}`)
+	assertSerialize(t, JsonbFrom(map[string]interface{}{"a": 1}), "$1::jsonb", `{"a":1}`)
+	assertSerialize(t, JsonbFrom([]string{"a"}), "$1::jsonb", `["a"]`)
+	assertSerialize(t, docData.EQ(Jsonb(`{}`)), "(docs.data = $1::jsonb)", `{}`)
+	assertSerialize(t, docData.GET("author"), "(docs.data -> $1::text)", "author")
+	assertSerialize(t, docData.GET_ELEM(-1), "(docs.data -> $1::integer)", int64(-1))
+	assertSerialize(t, docData.GET("author").GET_TEXT("name").EQ(String("John")),
+		"(((docs.data -> $1::text) ->> $2::text) = $3)", "author", "name", "John")
+	assertSerialize(t, docData.GET_ELEM_TEXT(0), "(docs.data ->> $1::integer)", int64(0))
+	assertSerialize(t, docData.GET_PATH("tags", "0"), `(docs.data #> $1::text[])`, `{"tags","0"}`)
+	assertSerialize(t, docData.GET_PATH_TEXT(`say "hi"`), `(docs.data #>> $1::text[])`, `{"say \"hi\""}`)
+	assertSerialize(t, docData.CONTAINS(Jsonb(`{"draft": true}`)), "(docs.data @> $1::jsonb)", `{"draft": true}`)
+	assertSerialize(t, docData.CONTAINED_BY(Jsonb(`{}`)), "(docs.data <@ $1::jsonb)", `{}`)
+	assertSerialize(t, docData.HAS_KEY("title"), "(docs.data ? $1::text)", "title")
+	assertSerialize(t, docData.HAS_ANY_KEY("a", "b"), "(docs.data ?| $1::text[])", `{"a","b"}`)
+	assertSerialize(t, docData.HAS_ALL_KEYS("a", "b"), "(docs.data ?& $1::text[])", `{"a","b"}`)
+	assertSerialize(t, docData.CONCAT(Jsonb(`{}`)), "(docs.data || $1::jsonb)", `{}`)
+}
+
+func TestJSONB_SET(t *testing.T) {
+	assertSerialize(t, JSONB_SET(docData, []string{"author", "name"}, Jsonb(`"Jane"`)),
+		"jsonb_set(docs.data, $1::text[], $2::jsonb)", `{"author","name"}`, `"Jane"`)
+	assertSerialize(t, JSONB_SET(docData, []string{"rating"}, Jsonb("5"), false),
+		"jsonb_set(docs.data, $1::text[], $2::jsonb, $3::boolean)", `{"rating"}`, "5", false)
+}
+
+func TestJSONBColumnUpdate(t *testing.T) {
+	assertStatementSql(t, docs.UPDATE().SET(docData.SET(JSONB_SET(docData, []string{"views"}, Jsonb("1")))).
+		WHERE(docData.HAS_KEY("views").IS_FALSE()), `
+UPDATE db.docs
+SET data = jsonb_set(docs.data, $1::text[], $2::jsonb)
+WHERE (docs.data ? $3::text) IS FALSE;
+`)
+}
@@ -22,3 +22,14 @@ func (l lateralImpl) AS(alias string) SelectTable {
 
 	return subQuery
 }
+
+// LATERAL_FUNC set returning function FROM item constructor, for function calls referencing columns of preceding
+// FROM items, for instance:
+//
+//	SELECT(elem).FROM(table1, LATERAL_FUNC(UNNEST(table1.Tags)).AS("t", elem))
+func LATERAL_FUNC(function Expression) tableFuncImpl {
+	return tableFuncImpl{
+		function: function,
+		lateral:  true,
+	}
+}
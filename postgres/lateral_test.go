@@ -12,3 +12,82 @@ func TestLATERAL(t *testing.T) {
      SELECT $1
 ) AS lat1`)
 }
+
+func TestLateralJoins(t *testing.T) {
+	subQuery := SELECT(table2ColInt).
+		FROM(table2).
+		WHERE(table2ColInt.EQ(table1ColInt)).
+		ORDER_BY(table2ColInt.DESC()).
+		LIMIT(3).
+		AsTable("top3")
+
+	assertStatementSql(t,
+		SELECT(table1ColInt, table2ColInt.From(subQuery)).
+			FROM(table1.CROSS_JOIN_LATERAL(subQuery)), `
+SELECT table1.col_int AS "table1.col_int",
+     top3."table2.col_int" AS "table2.col_int"
+FROM db.table1
+     CROSS JOIN LATERAL (
+          SELECT table2.col_int AS "table2.col_int"
+          FROM db.table2
+          WHERE table2.col_int = table1.col_int
+          ORDER BY table2.col_int DESC
+          LIMIT $1
+     ) AS top3;
+`, int64(3))
+
+	assertStatementSql(t,
+		SELECT(table1ColInt).
+			FROM(table1.
+				LEFT_JOIN_LATERAL(subQuery, Bool(true)).
+				INNER_JOIN_LATERAL(subQuery, table2ColInt.From(subQuery).GT(Int(1))),
+			), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+     LEFT JOIN LATERAL (
+          SELECT table2.col_int AS "table2.col_int"
+          FROM db.table2
+          WHERE table2.col_int = table1.col_int
+          ORDER BY table2.col_int DESC
+          LIMIT $1
+     ) AS top3 ON $2::boolean
+     INNER JOIN LATERAL (
+          SELECT table2.col_int AS "table2.col_int"
+          FROM db.table2
+          WHERE table2.col_int = table1.col_int
+          ORDER BY table2.col_int DESC
+          LIMIT $3
+     ) AS top3 ON (top3."table2.col_int" > $4);
+`, int64(3), true, int64(3), int64(1))
+}
+
+func TestFunctionTable(t *testing.T) {
+	postID := IntegerColumn("id")
+	tags := StringArrayColumn("tags")
+	posts := NewTable("db", "posts", "", postID, tags)
+	elem := StringColumn("elem")
+
+	assertStatementSql(t,
+		SELECT(postID, tags, elem).
+			FROM(posts.CROSS_JOIN_LATERAL(TABLE_FUNC(UNNEST(tags)).AS("t", elem))).
+			WHERE(elem.NOT_EQ(String("a"))), `
+SELECT posts.id AS "posts.id",
+     posts.tags AS "posts.tags",
+     t.elem AS "t.elem"
+FROM db.posts
+     CROSS JOIN LATERAL UNNEST(posts.tags) AS t (elem)
+WHERE t.elem != $1;
+`, "a")
+
+	assertStatementSql(t,
+		SELECT(elem).
+			FROM(posts, LATERAL_FUNC(UNNEST(tags)).AS("t", elem)), `
+SELECT t.elem AS "t.elem"
+FROM db.posts,
+     LATERAL UNNEST(posts.tags) AS t (elem);
+`)
+
+	assertPanicErr(t, func() {
+		TABLE_FUNC(UNNEST(tags)).AS("")
+	}, "jet: function table alias is empty")
+}
@@ -94,3 +94,10 @@ func TestTimestampz(t *testing.T) {
 		`$1::timestamp with time zone`, "2010-03-30 10:15:30 UTC")
 	assertSerialize(t, TimestampzT(time.Now()), `$1::timestamp with time zone`)
 }
+
+func TestFixedLiteral(t *testing.T) {
+	assertSerialize(t, FixedLiteral(true), `TRUE`)
+	assertSerialize(t, FixedLiteral(11), `11`)
+	assertSerialize(t, FixedLiteral("active"), `'active'`)
+	assertSerialize(t, table1ColBool.EQ(BoolExp(FixedLiteral(false))), `(table1.col_bool = FALSE)`)
+}
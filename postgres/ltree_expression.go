@@ -0,0 +1,111 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// LtreeExpression is representation of ltree extension label tree type
+type LtreeExpression interface {
+	jet.Expression
+
+	isLtree()
+
+	EQ(rhs LtreeExpression) BoolExpression
+	NOT_EQ(rhs LtreeExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs LtreeExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs LtreeExpression) BoolExpression
+
+	// CONTAINS returns true if this path is an ancestor of (or equal to) rhs path (@> operator)
+	CONTAINS(rhs LtreeExpression) BoolExpression
+	// CONTAINED_BY returns true if this path is a descendant of (or equal to) rhs path (<@ operator)
+	CONTAINED_BY(rhs LtreeExpression) BoolExpression
+	// MATCHES returns true if this path matches lquery pattern (~ operator)
+	MATCHES(lquery StringExpression) BoolExpression
+
+	CONCAT(rhs LtreeExpression) LtreeExpression
+}
+
+type ltreeInterfaceImpl struct {
+	parent LtreeExpression
+}
+
+func (l *ltreeInterfaceImpl) isLtree() {}
+
+func (l *ltreeInterfaceImpl) EQ(rhs LtreeExpression) BoolExpression {
+	return jet.Eq(l.parent, rhs)
+}
+
+func (l *ltreeInterfaceImpl) NOT_EQ(rhs LtreeExpression) BoolExpression {
+	return jet.NotEq(l.parent, rhs)
+}
+
+func (l *ltreeInterfaceImpl) IS_DISTINCT_FROM(rhs LtreeExpression) BoolExpression {
+	return jet.IsDistinctFrom(l.parent, rhs)
+}
+
+func (l *ltreeInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs LtreeExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(l.parent, rhs)
+}
+
+func (l *ltreeInterfaceImpl) CONTAINS(rhs LtreeExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(l.parent, rhs, "@>"))
+}
+
+func (l *ltreeInterfaceImpl) CONTAINED_BY(rhs LtreeExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(l.parent, rhs, "<@"))
+}
+
+func (l *ltreeInterfaceImpl) MATCHES(lquery StringExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(l.parent, CAST(lquery).AS("lquery"), "~"))
+}
+
+func (l *ltreeInterfaceImpl) CONCAT(rhs LtreeExpression) LtreeExpression {
+	return LtreeExp(jet.NewBinaryOperatorExpression(l.parent, rhs, "||"))
+}
+
+//---------------------------------------------------//
+
+// Ltree creates new ltree literal expression from label path, for instance "Top.Science.Astronomy".
+func Ltree(path string) LtreeExpression {
+	return CAST(String(path)).AS_LTREE()
+}
+
+// SUBLTREE returns subpath of ltree from position start to position end-1 (counting from 0).
+func SUBLTREE(ltree LtreeExpression, start, end IntegerExpression) LtreeExpression {
+	return LtreeExp(jet.NewFunc("subltree", []Expression{ltree, start, end}, nil))
+}
+
+// SUBPATH returns subpath of ltree starting at position offset, with optional length.
+// Negative offset and length count from the end of the path.
+func SUBPATH(ltree LtreeExpression, offset IntegerExpression, length ...IntegerExpression) LtreeExpression {
+	args := []Expression{ltree, offset}
+
+	if len(length) > 0 {
+		args = append(args, length[0])
+	}
+
+	return LtreeExp(jet.NewFunc("subpath", args, nil))
+}
+
+// NLEVEL returns number of labels in path.
+func NLEVEL(ltree LtreeExpression) IntegerExpression {
+	return IntExp(jet.NewFunc("nlevel", []Expression{ltree}, nil))
+}
+
+//---------------------------------------------------//
+
+type ltreeWrapper struct {
+	ltreeInterfaceImpl
+	Expression
+}
+
+func newLtreeExpressionWrap(expression Expression) LtreeExpression {
+	ltreeWrap := &ltreeWrapper{Expression: expression}
+	ltreeWrap.ltreeInterfaceImpl.parent = ltreeWrap
+	return ltreeWrap
+}
+
+// LtreeExp is ltree expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as ltree expression.
+// Does not add sql cast to generated sql builder output.
+func LtreeExp(expression Expression) LtreeExpression {
+	return newLtreeExpressionWrap(expression)
+}
@@ -0,0 +1,30 @@
+package postgres
+
+import "testing"
+
+var nodePath = LtreeColumn("path")
+var nodes = NewTable("db", "nodes", "", nodePath)
+
+func TestLtreeExpression(t *testing.T) {
+	assertSerialize(t, Ltree("Top.Science"), "$1::ltree", "Top.Science")
+	assertSerialize(t, nodePath.EQ(Ltree("Top")), "(nodes.path = $1::ltree)", "Top")
+	assertSerialize(t, nodePath.CONTAINS(Ltree("Top.Science")), "(nodes.path @> $1::ltree)", "Top.Science")
+	assertSerialize(t, nodePath.CONTAINED_BY(Ltree("Top")), "(nodes.path <@ $1::ltree)", "Top")
+	assertSerialize(t, nodePath.MATCHES(String("*.Astronomy.*")), "(nodes.path ~ $1::lquery)", "*.Astronomy.*")
+	assertSerialize(t, nodePath.CONCAT(Ltree("Stars")), "(nodes.path || $1::ltree)", "Stars")
+}
+
+func TestLtreeFunctions(t *testing.T) {
+	assertSerialize(t, SUBLTREE(nodePath, Int(1), Int(2)), "subltree(nodes.path, $1, $2)", int64(1), int64(2))
+	assertSerialize(t, SUBPATH(nodePath, Int(0), Int(2)), "subpath(nodes.path, $1, $2)", int64(0), int64(2))
+	assertSerialize(t, SUBPATH(nodePath, Int(-1)), "subpath(nodes.path, $1)", int64(-1))
+	assertSerialize(t, NLEVEL(nodePath).GT(Int(2)), "(nlevel(nodes.path) > $1)", int64(2))
+}
+
+func TestLtreeColumn(t *testing.T) {
+	subQuery := SELECT(nodePath).FROM(nodes).AsTable("sub_query")
+
+	subQueryLtreeColumn := nodePath.From(subQuery)
+	assertSerialize(t, subQueryLtreeColumn.CONTAINED_BY(Ltree("Top")), `(sub_query."nodes.path" <@ $1::ltree)`, "Top")
+	assertProjectionSerialize(t, subQueryLtreeColumn, `sub_query."nodes.path" AS "nodes.path"`)
+}
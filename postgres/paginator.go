@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// Page contains pagination metadata of the queried page
+type Page = jet.Page
+
+// Paginator queries select statement results page by page, either in offset mode (default), or in keyset mode.
+// Paginator never modifies the statement. In offset mode page LIMIT and OFFSET are applied on top of the statement
+// LIMIT and OFFSET, if any. In keyset mode statement is wrapped in a sub-query, ordered by keyset columns.
+type Paginator = jet.Paginator
+
+// NewPaginator creates new offset mode paginator of statement, with pageSize rows per page. Total number of rows
+// above WithTotalUpTo limit is estimated using query planner statistics.
+func NewPaginator(statement SelectStatement, pageSize int64) Paginator {
+	return jet.NewPaginator(Dialect, statement, pageSize, estimateRowCount)
+}
+
+// estimateRowCount returns the number of statement rows estimated by query planner
+func estimateRowCount(ctx context.Context, db qrm.Queryable, statement jet.Statement) (int64, error) {
+	query, args := statement.Sql()
+
+	rows, err := db.QueryContext(ctx, "EXPLAIN (FORMAT JSON) "+query, args...)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer rows.Close()
+
+	var plan string
+
+	if rows.Next() {
+		if err := rows.Scan(&plan); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	var explain []struct {
+		Plan struct {
+			PlanRows float64 `json:"Plan Rows"`
+		}
+	}
+
+	if err := json.Unmarshal([]byte(plan), &explain); err != nil || len(explain) == 0 {
+		return 0, fmt.Errorf("jet: unexpected query plan: %s", plan)
+	}
+
+	return int64(explain[0].Plan.PlanRows), nil
+}
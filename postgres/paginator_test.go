@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type queryRecorder struct {
+	queries []string
+	args    [][]interface{}
+}
+
+func (r *queryRecorder) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return r.ExecContext(context.Background(), query, args...)
+}
+
+func (r *queryRecorder) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return nil, sql.ErrConnDone
+}
+
+func (r *queryRecorder) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return r.QueryContext(context.Background(), query, args...)
+}
+
+func (r *queryRecorder) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	r.queries = append(r.queries, query)
+	r.args = append(r.args, args)
+	return nil, sql.ErrConnDone
+}
+
+func TestPaginatorOffset(t *testing.T) {
+	stmt := SELECT(table1Col1).FROM(table1).ORDER_BY(table1Col1)
+	db := &queryRecorder{}
+
+	var dest []struct{ Col1 int64 }
+
+	_, err := NewPaginator(stmt, 10).Page(3).Query(context.Background(), db, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Equal(t, `
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+ORDER BY table1.col1
+LIMIT $1
+OFFSET $2;
+`, db.queries[0])
+	require.Equal(t, []interface{}{int64(11), int64(20)}, db.args[0])
+
+	// statement is not modified
+	assertStatementSql(t, stmt, `
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+ORDER BY table1.col1;
+`)
+}
+
+func TestPaginatorOffsetStatementLimit(t *testing.T) {
+	stmt := SELECT(table1Col1).FROM(table1).ORDER_BY(table1Col1).LIMIT(25).OFFSET(5)
+	db := &queryRecorder{}
+
+	var dest []struct{ Col1 int64 }
+
+	_, err := NewPaginator(stmt, 10).Page(2).Query(context.Background(), db, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Equal(t, []interface{}{int64(11), int64(15)}, db.args[0])
+
+	// last page is cut at the statement LIMIT
+	_, err = NewPaginator(stmt, 10).Page(3).Query(context.Background(), db, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Equal(t, []interface{}{int64(5), int64(25)}, db.args[1])
+
+	_, err = NewPaginator(stmt, 10).Page(4).Query(context.Background(), db, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Equal(t, []interface{}{int64(0), int64(35)}, db.args[2])
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+ORDER BY table1.col1
+LIMIT $1
+OFFSET $2;
+`, int64(25), int64(5))
+}
+
+func TestPaginatorKeyset(t *testing.T) {
+	stmt := SELECT(table1Col1, table1ColInt).FROM(table1)
+	db := &queryRecorder{}
+
+	var dest []struct{ Col1 int64 }
+
+	_, err := NewPaginator(stmt, 10).Keyset("", table1Col1).Query(context.Background(), db, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Equal(t, `
+SELECT paginated."table1.col1" AS "table1.col1",
+     paginated."table1.col_int" AS "table1.col_int"
+FROM (
+          SELECT table1.col1 AS "table1.col1",
+               table1.col_int AS "table1.col_int"
+          FROM db.table1
+     ) AS paginated
+ORDER BY paginated."table1.col1" ASC
+LIMIT $1;
+`, db.queries[0])
+
+	_, err = NewPaginator(stmt, 10).Keyset("WzEwXQ", table1Col1).Query(context.Background(), db, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Contains(t, db.queries[1], `
+     ) AS paginated
+WHERE (paginated."table1.col1") > ($1)
+ORDER BY paginated."table1.col1" ASC
+LIMIT $2;
+`)
+	require.Equal(t, []interface{}{int64(10), int64(11)}, db.args[1])
+
+	_, err = NewPaginator(stmt, 10).Keyset("WzEwXQ", table1Col1, table1ColInt).Query(context.Background(), db, &dest)
+	require.EqualError(t, err, "jet: cursor has 1 value(s), expected 2")
+}
+
+func TestPaginatorWithTotal(t *testing.T) {
+	db := &queryRecorder{}
+
+	var dest []struct{ Col1 int64 }
+
+	page, err := NewPaginator(SELECT(table1Col1).FROM(table1), 10).WithTotal().Query(context.Background(), db, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Equal(t, int64(-1), page.Total)
+	require.Equal(t, `
+SELECT COUNT(*) AS "count"
+FROM (
+          SELECT table1.col1 AS "table1.col1"
+          FROM db.table1
+     ) AS paginated;
+`, db.queries[0])
+}
+
+func TestPaginatorInvalidDestination(t *testing.T) {
+	var dest struct{ Col1 int64 }
+
+	_, err := NewPaginator(SELECT(table1Col1).FROM(table1), 10).Query(context.Background(), &queryRecorder{}, &dest)
+	require.EqualError(t, err, "jet: paginator destination has to be a pointer to slice, got *struct { Col1 int64 }")
+}
+
+func TestPaginatorWithTotalUpTo(t *testing.T) {
+	db := &queryRecorder{}
+
+	var dest []struct{ Col1 int64 }
+
+	page, err := NewPaginator(SELECT(table1Col1).FROM(table1), 10).WithTotalUpTo(1000).Query(context.Background(), db, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Equal(t, int64(-1), page.Total)
+	require.Equal(t, `
+SELECT COUNT(*) AS "count"
+FROM (
+          SELECT 1
+          FROM (
+                    SELECT table1.col1 AS "table1.col1"
+                    FROM db.table1
+               ) AS paginated
+          LIMIT $1
+     ) AS limited;
+`, db.queries[0])
+	require.Equal(t, []interface{}{int64(1001)}, db.args[0])
+}
@@ -0,0 +1,8 @@
+// Package pgcatalog contains sql builder and model types of the most commonly used PostgreSQL system catalog tables
+// (subpackage table) and statistics views (subpackage view), so that ops tooling can write type safe queries against
+// system catalogs without running the generator against every cluster. Types are maintained by hand, in the same
+// shape as generator output, and they contain only a subset of catalog columns, stable across recent PostgreSQL
+// versions.
+//
+// PgStatStatements view is provided by pg_stat_statements extension, so it is not schema qualified by default.
+package pgcatalog
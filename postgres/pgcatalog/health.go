@@ -0,0 +1,141 @@
+package pgcatalog
+
+import (
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/postgres/pgcatalog/table"
+	"github.com/go-jet/jet/v2/postgres/pgcatalog/view"
+)
+
+// UnusedIndex is a destination for UnusedIndexes statement
+type UnusedIndex struct {
+	SchemaName string
+	TableName  string
+	IndexName  string
+	IndexScans int64
+	IndexSize  int64
+}
+
+// UnusedIndexes returns statement listing non unique user indexes scanned at most maxScans times since statistics
+// were last reset, ordered by index size in bytes. Result can be stored into []UnusedIndex destination.
+func UnusedIndexes(maxScans int64) SelectStatement {
+	indexes := view.PgStatUserIndexes
+	indexSize := relationSize(indexes.Indexrelid)
+
+	return SELECT(
+		indexes.Schemaname.AS("unused_index.schema_name"),
+		indexes.Relname.AS("unused_index.table_name"),
+		indexes.Indexrelname.AS("unused_index.index_name"),
+		indexes.IdxScan.AS("unused_index.index_scans"),
+		indexSize.AS("unused_index.index_size"),
+	).FROM(
+		indexes.INNER_JOIN(table.PgIndex, table.PgIndex.Indexrelid.EQ(indexes.Indexrelid)),
+	).WHERE(
+		indexes.IdxScan.LT_EQ(Int(maxScans)).
+			AND(table.PgIndex.Indisunique.IS_FALSE()),
+	).ORDER_BY(
+		indexSize.DESC(),
+	)
+}
+
+// CacheHitRatio is a destination for CacheHitRatios statement
+type CacheHitRatio struct {
+	SchemaName     string
+	TableName      string
+	HeapBlocksHit  int64
+	HeapBlocksRead int64
+	Ratio          *float64 // NULL if table blocks were never read
+}
+
+// CacheHitRatios returns statement listing buffer cache hit ratio of user tables, ordered from the lowest ratio.
+// Result can be stored into []CacheHitRatio destination.
+func CacheHitRatios() SelectStatement {
+	statio := view.PgStatioUserTables
+	ratio := ratio(statio.HeapBlksHit, statio.HeapBlksHit.ADD(statio.HeapBlksRead))
+
+	return SELECT(
+		statio.Schemaname.AS("cache_hit_ratio.schema_name"),
+		statio.Relname.AS("cache_hit_ratio.table_name"),
+		statio.HeapBlksHit.AS("cache_hit_ratio.heap_blocks_hit"),
+		statio.HeapBlksRead.AS("cache_hit_ratio.heap_blocks_read"),
+		ratio.AS("cache_hit_ratio.ratio"),
+	).FROM(
+		statio,
+	).ORDER_BY(
+		ratio.ASC(),
+	)
+}
+
+// TableBloat is a destination for TablesBloat statement
+type TableBloat struct {
+	SchemaName         string
+	TableName          string
+	LiveTuples         int64
+	DeadTuples         int64
+	DeadTuplesRatio    *float64
+	TableSize          int64
+	EstimatedBloatSize *int64
+}
+
+// TablesBloat returns statement estimating bloat of user tables with at least minDeadTuples dead tuples, ordered
+// by estimated bloat size in bytes. Estimation is based on dead tuples statistics, and it is as accurate as the
+// statistics collected since the last (auto)vacuum. Result can be stored into []TableBloat destination.
+func TablesBloat(minDeadTuples int64) SelectStatement {
+	tables := view.PgStatUserTables
+	deadTuplesRatio := ratio(tables.NDeadTup, tables.NLiveTup.ADD(tables.NDeadTup))
+	tableSize := relationSize(tables.Relid)
+	estimatedBloatSize := CAST(CAST(tableSize).AS_DOUBLE().MUL(deadTuplesRatio)).AS_BIGINT()
+
+	return SELECT(
+		tables.Schemaname.AS("table_bloat.schema_name"),
+		tables.Relname.AS("table_bloat.table_name"),
+		tables.NLiveTup.AS("table_bloat.live_tuples"),
+		tables.NDeadTup.AS("table_bloat.dead_tuples"),
+		deadTuplesRatio.AS("table_bloat.dead_tuples_ratio"),
+		tableSize.AS("table_bloat.table_size"),
+		estimatedBloatSize.AS("table_bloat.estimated_bloat_size"),
+	).FROM(
+		tables,
+	).WHERE(
+		tables.NDeadTup.GT_EQ(Int(minDeadTuples)),
+	).ORDER_BY(
+		estimatedBloatSize.DESC(),
+	)
+}
+
+// TopQuery is a destination for TopQueries statement
+type TopQuery struct {
+	QueryID       int64
+	Query         string
+	Calls         int64
+	TotalExecTime float64 // milliseconds
+	MeanExecTime  float64 // milliseconds
+	Rows          int64
+}
+
+// TopQueries returns statement listing limit queries with the highest total execution time, as tracked by
+// pg_stat_statements extension (PostgreSQL 13+). Result can be stored into []TopQuery destination.
+func TopQueries(limit int64) SelectStatement {
+	statements := view.PgStatStatements
+
+	return SELECT(
+		statements.Queryid.AS("top_query.query_id"),
+		statements.Query.AS("top_query.query"),
+		statements.Calls.AS("top_query.calls"),
+		statements.TotalExecTime.AS("top_query.total_exec_time"),
+		statements.MeanExecTime.AS("top_query.mean_exec_time"),
+		statements.Rows.AS("top_query.rows"),
+	).FROM(
+		statements,
+	).ORDER_BY(
+		statements.TotalExecTime.DESC(),
+	).LIMIT(limit)
+}
+
+func relationSize(relationOid IntegerExpression) IntegerExpression {
+	return IntExp(Func("pg_relation_size", relationOid))
+}
+
+// ratio returns part/total as double precision, or NULL if total is 0
+func ratio(part, total IntegerExpression) FloatExpression {
+	return CAST(part).AS_DOUBLE().DIV(FloatExp(NULLIF(total, Int(0))))
+}
@@ -0,0 +1,20 @@
+package model
+
+type PgClass struct {
+	Oid            int64 `sql:"primary_key"`
+	Relname        string
+	Relnamespace   int64
+	Reltype        int64
+	Relowner       int64
+	Relam          int64
+	Relpages       int32
+	Reltuples      float32
+	Relallvisible  int32
+	Reltoastrelid  int64
+	Relhasindex    bool
+	Relisshared    bool
+	Relpersistence string
+	Relkind        string
+	Relnatts       int16
+	Relispartition bool
+}
@@ -0,0 +1,11 @@
+package model
+
+type PgDatabase struct {
+	Oid           int64 `sql:"primary_key"`
+	Datname       string
+	Datdba        int64
+	Encoding      int32
+	Datistemplate bool
+	Datallowconn  bool
+	Datconnlimit  int32
+}
@@ -0,0 +1,16 @@
+package model
+
+type PgIndex struct {
+	Indexrelid     int64 `sql:"primary_key"`
+	Indrelid       int64
+	Indnatts       int16
+	Indnkeyatts    int16
+	Indisunique    bool
+	Indisprimary   bool
+	Indisexclusion bool
+	Indimmediate   bool
+	Indisclustered bool
+	Indisvalid     bool
+	Indisready     bool
+	Indislive      bool
+}
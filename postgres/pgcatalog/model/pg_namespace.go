@@ -0,0 +1,7 @@
+package model
+
+type PgNamespace struct {
+	Oid      int64 `sql:"primary_key"`
+	Nspname  string
+	Nspowner int64
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+)
+
+type PgStatActivity struct {
+	Datid           *int64
+	Datname         *string
+	Pid             *int32
+	LeaderPid       *int32
+	Usesysid        *int64
+	Usename         *string
+	ApplicationName *string
+	ClientAddr      *string
+	ClientPort      *int32
+	BackendStart    *time.Time
+	XactStart       *time.Time
+	QueryStart      *time.Time
+	StateChange     *time.Time
+	WaitEventType   *string
+	WaitEvent       *string
+	State           *string
+	BackendXid      *string
+	BackendXmin     *string
+	Query           *string
+	BackendType     *string
+}
@@ -0,0 +1,22 @@
+package model
+
+type PgStatStatements struct {
+	Userid            *int64
+	Dbid              *int64
+	Toplevel          *bool
+	Queryid           *int64
+	Query             *string
+	Calls             *int64
+	TotalExecTime     *float64
+	MinExecTime       *float64
+	MaxExecTime       *float64
+	MeanExecTime      *float64
+	StddevExecTime    *float64
+	Rows              *int64
+	SharedBlksHit     *int64
+	SharedBlksRead    *int64
+	SharedBlksDirtied *int64
+	SharedBlksWritten *int64
+	TempBlksRead      *int64
+	TempBlksWritten   *int64
+}
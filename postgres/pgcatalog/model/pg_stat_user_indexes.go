@@ -0,0 +1,12 @@
+package model
+
+type PgStatUserIndexes struct {
+	Relid        *int64
+	Indexrelid   *int64
+	Schemaname   *string
+	Relname      *string
+	Indexrelname *string
+	IdxScan      *int64
+	IdxTupRead   *int64
+	IdxTupFetch  *int64
+}
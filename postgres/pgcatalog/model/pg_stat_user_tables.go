@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+)
+
+type PgStatUserTables struct {
+	Relid            *int64
+	Schemaname       *string
+	Relname          *string
+	SeqScan          *int64
+	SeqTupRead       *int64
+	IdxScan          *int64
+	IdxTupFetch      *int64
+	NTupIns          *int64
+	NTupUpd          *int64
+	NTupDel          *int64
+	NTupHotUpd       *int64
+	NLiveTup         *int64
+	NDeadTup         *int64
+	NModSinceAnalyze *int64
+	LastVacuum       *time.Time
+	LastAutovacuum   *time.Time
+	LastAnalyze      *time.Time
+	LastAutoanalyze  *time.Time
+	VacuumCount      *int64
+	AutovacuumCount  *int64
+	AnalyzeCount     *int64
+	AutoanalyzeCount *int64
+}
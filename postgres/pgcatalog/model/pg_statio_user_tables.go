@@ -0,0 +1,15 @@
+package model
+
+type PgStatioUserTables struct {
+	Relid         *int64
+	Schemaname    *string
+	Relname       *string
+	HeapBlksRead  *int64
+	HeapBlksHit   *int64
+	IdxBlksRead   *int64
+	IdxBlksHit    *int64
+	ToastBlksRead *int64
+	ToastBlksHit  *int64
+	TidxBlksRead  *int64
+	TidxBlksHit   *int64
+}
@@ -0,0 +1,95 @@
+package pgcatalog_test
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/postgres/pgcatalog"
+	"github.com/go-jet/jet/v2/postgres/pgcatalog/table"
+	"github.com/go-jet/jet/v2/postgres/pgcatalog/view"
+)
+
+func TestCatalogQuery(t *testing.T) {
+	stmt := SELECT(
+		view.PgStatUserIndexes.Schemaname,
+		view.PgStatUserIndexes.Indexrelname,
+	).FROM(
+		view.PgStatUserIndexes.
+			INNER_JOIN(table.PgIndex, table.PgIndex.Indexrelid.EQ(view.PgStatUserIndexes.Indexrelid)),
+	).WHERE(
+		view.PgStatUserIndexes.IdxScan.EQ(Int(0)).
+			AND(table.PgIndex.Indisunique.IS_FALSE()),
+	)
+
+	testutils.AssertStatementSql(t, stmt, `
+SELECT pg_stat_user_indexes.schemaname AS "pg_stat_user_indexes.schemaname",
+     pg_stat_user_indexes.indexrelname AS "pg_stat_user_indexes.indexrelname"
+FROM pg_catalog.pg_stat_user_indexes
+     INNER JOIN pg_catalog.pg_index ON (pg_index.indexrelid = pg_stat_user_indexes.indexrelid)
+WHERE (pg_stat_user_indexes.idx_scan = $1) AND pg_index.indisunique IS FALSE;
+`, int64(0))
+}
+
+func TestPgStatStatementsNotSchemaQualified(t *testing.T) {
+	testutils.AssertStatementSql(t, view.PgStatStatements.SELECT(view.PgStatStatements.Query).LIMIT(1), `
+SELECT pg_stat_statements.query AS "pg_stat_statements.query"
+FROM pg_stat_statements
+LIMIT $1;
+`, int64(1))
+}
+
+func TestUnusedIndexes(t *testing.T) {
+	testutils.AssertStatementSql(t, pgcatalog.UnusedIndexes(10), `
+SELECT pg_stat_user_indexes.schemaname AS "unused_index.schema_name",
+     pg_stat_user_indexes.relname AS "unused_index.table_name",
+     pg_stat_user_indexes.indexrelname AS "unused_index.index_name",
+     pg_stat_user_indexes.idx_scan AS "unused_index.index_scans",
+     pg_relation_size(pg_stat_user_indexes.indexrelid) AS "unused_index.index_size"
+FROM pg_catalog.pg_stat_user_indexes
+     INNER JOIN pg_catalog.pg_index ON (pg_index.indexrelid = pg_stat_user_indexes.indexrelid)
+WHERE (pg_stat_user_indexes.idx_scan <= $1) AND pg_index.indisunique IS FALSE
+ORDER BY pg_relation_size(pg_stat_user_indexes.indexrelid) DESC;
+`, int64(10))
+}
+
+func TestCacheHitRatios(t *testing.T) {
+	testutils.AssertStatementSql(t, pgcatalog.CacheHitRatios(), `
+SELECT pg_statio_user_tables.schemaname AS "cache_hit_ratio.schema_name",
+     pg_statio_user_tables.relname AS "cache_hit_ratio.table_name",
+     pg_statio_user_tables.heap_blks_hit AS "cache_hit_ratio.heap_blocks_hit",
+     pg_statio_user_tables.heap_blks_read AS "cache_hit_ratio.heap_blocks_read",
+     (pg_statio_user_tables.heap_blks_hit::double precision / NULLIF(pg_statio_user_tables.heap_blks_hit + pg_statio_user_tables.heap_blks_read, $1)) AS "cache_hit_ratio.ratio"
+FROM pg_catalog.pg_statio_user_tables
+ORDER BY pg_statio_user_tables.heap_blks_hit::double precision / NULLIF(pg_statio_user_tables.heap_blks_hit + pg_statio_user_tables.heap_blks_read, $2) ASC;
+`, int64(0), int64(0))
+}
+
+func TestTablesBloat(t *testing.T) {
+	testutils.AssertStatementSql(t, pgcatalog.TablesBloat(1000), `
+SELECT pg_stat_user_tables.schemaname AS "table_bloat.schema_name",
+     pg_stat_user_tables.relname AS "table_bloat.table_name",
+     pg_stat_user_tables.n_live_tup AS "table_bloat.live_tuples",
+     pg_stat_user_tables.n_dead_tup AS "table_bloat.dead_tuples",
+     (pg_stat_user_tables.n_dead_tup::double precision / NULLIF(pg_stat_user_tables.n_live_tup + pg_stat_user_tables.n_dead_tup, $1)) AS "table_bloat.dead_tuples_ratio",
+     pg_relation_size(pg_stat_user_tables.relid) AS "table_bloat.table_size",
+     (pg_relation_size(pg_stat_user_tables.relid)::double precision * (pg_stat_user_tables.n_dead_tup::double precision / NULLIF(pg_stat_user_tables.n_live_tup + pg_stat_user_tables.n_dead_tup, $2)))::bigint AS "table_bloat.estimated_bloat_size"
+FROM pg_catalog.pg_stat_user_tables
+WHERE pg_stat_user_tables.n_dead_tup >= $3
+ORDER BY (pg_relation_size(pg_stat_user_tables.relid)::double precision * (pg_stat_user_tables.n_dead_tup::double precision / NULLIF(pg_stat_user_tables.n_live_tup + pg_stat_user_tables.n_dead_tup, $4)))::bigint DESC;
+`, int64(0), int64(0), int64(1000), int64(0))
+}
+
+func TestTopQueries(t *testing.T) {
+	testutils.AssertStatementSql(t, pgcatalog.TopQueries(20), `
+SELECT pg_stat_statements.queryid AS "top_query.query_id",
+     pg_stat_statements.query AS "top_query.query",
+     pg_stat_statements.calls AS "top_query.calls",
+     pg_stat_statements.total_exec_time AS "top_query.total_exec_time",
+     pg_stat_statements.mean_exec_time AS "top_query.mean_exec_time",
+     pg_stat_statements.rows AS "top_query.rows"
+FROM pg_stat_statements
+ORDER BY pg_stat_statements.total_exec_time DESC
+LIMIT $1;
+`, int64(20))
+}
@@ -0,0 +1,103 @@
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var PgClass = newPgClassTable("pg_catalog", "pg_class", "")
+
+type pgClassTable struct {
+	postgres.Table
+
+	//Columns
+	Oid            postgres.ColumnInteger
+	Relname        postgres.ColumnString
+	Relnamespace   postgres.ColumnInteger
+	Reltype        postgres.ColumnInteger
+	Relowner       postgres.ColumnInteger
+	Relam          postgres.ColumnInteger
+	Relpages       postgres.ColumnInteger
+	Reltuples      postgres.ColumnFloat
+	Relallvisible  postgres.ColumnInteger
+	Reltoastrelid  postgres.ColumnInteger
+	Relhasindex    postgres.ColumnBool
+	Relisshared    postgres.ColumnBool
+	Relpersistence postgres.ColumnString
+	Relkind        postgres.ColumnString
+	Relnatts       postgres.ColumnInteger
+	Relispartition postgres.ColumnBool
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type PgClassTable struct {
+	pgClassTable
+
+	EXCLUDED pgClassTable
+}
+
+// AS creates new PgClassTable with assigned alias
+func (a PgClassTable) AS(alias string) *PgClassTable {
+	return newPgClassTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new PgClassTable with assigned schema name
+func (a PgClassTable) FromSchema(schemaName string) *PgClassTable {
+	return newPgClassTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newPgClassTable(schemaName, tableName, alias string) *PgClassTable {
+	return &PgClassTable{
+		pgClassTable: newPgClassTableImpl(schemaName, tableName, alias),
+		EXCLUDED:     newPgClassTableImpl("", "excluded", ""),
+	}
+}
+
+func newPgClassTableImpl(schemaName, tableName, alias string) pgClassTable {
+	var (
+		OidColumn            = postgres.IntegerColumn("oid")
+		RelnameColumn        = postgres.StringColumn("relname")
+		RelnamespaceColumn   = postgres.IntegerColumn("relnamespace")
+		ReltypeColumn        = postgres.IntegerColumn("reltype")
+		RelownerColumn       = postgres.IntegerColumn("relowner")
+		RelamColumn          = postgres.IntegerColumn("relam")
+		RelpagesColumn       = postgres.IntegerColumn("relpages")
+		ReltuplesColumn      = postgres.FloatColumn("reltuples")
+		RelallvisibleColumn  = postgres.IntegerColumn("relallvisible")
+		ReltoastrelidColumn  = postgres.IntegerColumn("reltoastrelid")
+		RelhasindexColumn    = postgres.BoolColumn("relhasindex")
+		RelissharedColumn    = postgres.BoolColumn("relisshared")
+		RelpersistenceColumn = postgres.StringColumn("relpersistence")
+		RelkindColumn        = postgres.StringColumn("relkind")
+		RelnattsColumn       = postgres.IntegerColumn("relnatts")
+		RelispartitionColumn = postgres.BoolColumn("relispartition")
+		allColumns           = postgres.ColumnList{OidColumn, RelnameColumn, RelnamespaceColumn, ReltypeColumn, RelownerColumn, RelamColumn, RelpagesColumn, ReltuplesColumn, RelallvisibleColumn, ReltoastrelidColumn, RelhasindexColumn, RelissharedColumn, RelpersistenceColumn, RelkindColumn, RelnattsColumn, RelispartitionColumn}
+		mutableColumns       = postgres.ColumnList{RelnameColumn, RelnamespaceColumn, ReltypeColumn, RelownerColumn, RelamColumn, RelpagesColumn, ReltuplesColumn, RelallvisibleColumn, ReltoastrelidColumn, RelhasindexColumn, RelissharedColumn, RelpersistenceColumn, RelkindColumn, RelnattsColumn, RelispartitionColumn}
+	)
+
+	return pgClassTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		Oid:            OidColumn,
+		Relname:        RelnameColumn,
+		Relnamespace:   RelnamespaceColumn,
+		Reltype:        ReltypeColumn,
+		Relowner:       RelownerColumn,
+		Relam:          RelamColumn,
+		Relpages:       RelpagesColumn,
+		Reltuples:      ReltuplesColumn,
+		Relallvisible:  RelallvisibleColumn,
+		Reltoastrelid:  ReltoastrelidColumn,
+		Relhasindex:    RelhasindexColumn,
+		Relisshared:    RelissharedColumn,
+		Relpersistence: RelpersistenceColumn,
+		Relkind:        RelkindColumn,
+		Relnatts:       RelnattsColumn,
+		Relispartition: RelispartitionColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
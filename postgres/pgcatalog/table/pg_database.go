@@ -0,0 +1,76 @@
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var PgDatabase = newPgDatabaseTable("pg_catalog", "pg_database", "")
+
+type pgDatabaseTable struct {
+	postgres.Table
+
+	//Columns
+	Oid           postgres.ColumnInteger
+	Datname       postgres.ColumnString
+	Datdba        postgres.ColumnInteger
+	Encoding      postgres.ColumnInteger
+	Datistemplate postgres.ColumnBool
+	Datallowconn  postgres.ColumnBool
+	Datconnlimit  postgres.ColumnInteger
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type PgDatabaseTable struct {
+	pgDatabaseTable
+
+	EXCLUDED pgDatabaseTable
+}
+
+// AS creates new PgDatabaseTable with assigned alias
+func (a PgDatabaseTable) AS(alias string) *PgDatabaseTable {
+	return newPgDatabaseTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new PgDatabaseTable with assigned schema name
+func (a PgDatabaseTable) FromSchema(schemaName string) *PgDatabaseTable {
+	return newPgDatabaseTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newPgDatabaseTable(schemaName, tableName, alias string) *PgDatabaseTable {
+	return &PgDatabaseTable{
+		pgDatabaseTable: newPgDatabaseTableImpl(schemaName, tableName, alias),
+		EXCLUDED:        newPgDatabaseTableImpl("", "excluded", ""),
+	}
+}
+
+func newPgDatabaseTableImpl(schemaName, tableName, alias string) pgDatabaseTable {
+	var (
+		OidColumn           = postgres.IntegerColumn("oid")
+		DatnameColumn       = postgres.StringColumn("datname")
+		DatdbaColumn        = postgres.IntegerColumn("datdba")
+		EncodingColumn      = postgres.IntegerColumn("encoding")
+		DatistemplateColumn = postgres.BoolColumn("datistemplate")
+		DatallowconnColumn  = postgres.BoolColumn("datallowconn")
+		DatconnlimitColumn  = postgres.IntegerColumn("datconnlimit")
+		allColumns          = postgres.ColumnList{OidColumn, DatnameColumn, DatdbaColumn, EncodingColumn, DatistemplateColumn, DatallowconnColumn, DatconnlimitColumn}
+		mutableColumns      = postgres.ColumnList{DatnameColumn, DatdbaColumn, EncodingColumn, DatistemplateColumn, DatallowconnColumn, DatconnlimitColumn}
+	)
+
+	return pgDatabaseTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		Oid:           OidColumn,
+		Datname:       DatnameColumn,
+		Datdba:        DatdbaColumn,
+		Encoding:      EncodingColumn,
+		Datistemplate: DatistemplateColumn,
+		Datallowconn:  DatallowconnColumn,
+		Datconnlimit:  DatconnlimitColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -0,0 +1,91 @@
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var PgIndex = newPgIndexTable("pg_catalog", "pg_index", "")
+
+type pgIndexTable struct {
+	postgres.Table
+
+	//Columns
+	Indexrelid     postgres.ColumnInteger
+	Indrelid       postgres.ColumnInteger
+	Indnatts       postgres.ColumnInteger
+	Indnkeyatts    postgres.ColumnInteger
+	Indisunique    postgres.ColumnBool
+	Indisprimary   postgres.ColumnBool
+	Indisexclusion postgres.ColumnBool
+	Indimmediate   postgres.ColumnBool
+	Indisclustered postgres.ColumnBool
+	Indisvalid     postgres.ColumnBool
+	Indisready     postgres.ColumnBool
+	Indislive      postgres.ColumnBool
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type PgIndexTable struct {
+	pgIndexTable
+
+	EXCLUDED pgIndexTable
+}
+
+// AS creates new PgIndexTable with assigned alias
+func (a PgIndexTable) AS(alias string) *PgIndexTable {
+	return newPgIndexTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new PgIndexTable with assigned schema name
+func (a PgIndexTable) FromSchema(schemaName string) *PgIndexTable {
+	return newPgIndexTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newPgIndexTable(schemaName, tableName, alias string) *PgIndexTable {
+	return &PgIndexTable{
+		pgIndexTable: newPgIndexTableImpl(schemaName, tableName, alias),
+		EXCLUDED:     newPgIndexTableImpl("", "excluded", ""),
+	}
+}
+
+func newPgIndexTableImpl(schemaName, tableName, alias string) pgIndexTable {
+	var (
+		IndexrelidColumn     = postgres.IntegerColumn("indexrelid")
+		IndrelidColumn       = postgres.IntegerColumn("indrelid")
+		IndnattsColumn       = postgres.IntegerColumn("indnatts")
+		IndnkeyattsColumn    = postgres.IntegerColumn("indnkeyatts")
+		IndisuniqueColumn    = postgres.BoolColumn("indisunique")
+		IndisprimaryColumn   = postgres.BoolColumn("indisprimary")
+		IndisexclusionColumn = postgres.BoolColumn("indisexclusion")
+		IndimmediateColumn   = postgres.BoolColumn("indimmediate")
+		IndisclusteredColumn = postgres.BoolColumn("indisclustered")
+		IndisvalidColumn     = postgres.BoolColumn("indisvalid")
+		IndisreadyColumn     = postgres.BoolColumn("indisready")
+		IndisliveColumn      = postgres.BoolColumn("indislive")
+		allColumns           = postgres.ColumnList{IndexrelidColumn, IndrelidColumn, IndnattsColumn, IndnkeyattsColumn, IndisuniqueColumn, IndisprimaryColumn, IndisexclusionColumn, IndimmediateColumn, IndisclusteredColumn, IndisvalidColumn, IndisreadyColumn, IndisliveColumn}
+		mutableColumns       = postgres.ColumnList{IndrelidColumn, IndnattsColumn, IndnkeyattsColumn, IndisuniqueColumn, IndisprimaryColumn, IndisexclusionColumn, IndimmediateColumn, IndisclusteredColumn, IndisvalidColumn, IndisreadyColumn, IndisliveColumn}
+	)
+
+	return pgIndexTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		Indexrelid:     IndexrelidColumn,
+		Indrelid:       IndrelidColumn,
+		Indnatts:       IndnattsColumn,
+		Indnkeyatts:    IndnkeyattsColumn,
+		Indisunique:    IndisuniqueColumn,
+		Indisprimary:   IndisprimaryColumn,
+		Indisexclusion: IndisexclusionColumn,
+		Indimmediate:   IndimmediateColumn,
+		Indisclustered: IndisclusteredColumn,
+		Indisvalid:     IndisvalidColumn,
+		Indisready:     IndisreadyColumn,
+		Indislive:      IndisliveColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
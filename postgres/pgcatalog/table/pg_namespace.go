@@ -0,0 +1,64 @@
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var PgNamespace = newPgNamespaceTable("pg_catalog", "pg_namespace", "")
+
+type pgNamespaceTable struct {
+	postgres.Table
+
+	//Columns
+	Oid      postgres.ColumnInteger
+	Nspname  postgres.ColumnString
+	Nspowner postgres.ColumnInteger
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type PgNamespaceTable struct {
+	pgNamespaceTable
+
+	EXCLUDED pgNamespaceTable
+}
+
+// AS creates new PgNamespaceTable with assigned alias
+func (a PgNamespaceTable) AS(alias string) *PgNamespaceTable {
+	return newPgNamespaceTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new PgNamespaceTable with assigned schema name
+func (a PgNamespaceTable) FromSchema(schemaName string) *PgNamespaceTable {
+	return newPgNamespaceTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newPgNamespaceTable(schemaName, tableName, alias string) *PgNamespaceTable {
+	return &PgNamespaceTable{
+		pgNamespaceTable: newPgNamespaceTableImpl(schemaName, tableName, alias),
+		EXCLUDED:         newPgNamespaceTableImpl("", "excluded", ""),
+	}
+}
+
+func newPgNamespaceTableImpl(schemaName, tableName, alias string) pgNamespaceTable {
+	var (
+		OidColumn      = postgres.IntegerColumn("oid")
+		NspnameColumn  = postgres.StringColumn("nspname")
+		NspownerColumn = postgres.IntegerColumn("nspowner")
+		allColumns     = postgres.ColumnList{OidColumn, NspnameColumn, NspownerColumn}
+		mutableColumns = postgres.ColumnList{NspnameColumn, NspownerColumn}
+	)
+
+	return pgNamespaceTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		Oid:      OidColumn,
+		Nspname:  NspnameColumn,
+		Nspowner: NspownerColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -0,0 +1,115 @@
+package view
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var PgStatActivity = newPgStatActivityTable("pg_catalog", "pg_stat_activity", "")
+
+type pgStatActivityTable struct {
+	postgres.Table
+
+	//Columns
+	Datid           postgres.ColumnInteger
+	Datname         postgres.ColumnString
+	Pid             postgres.ColumnInteger
+	LeaderPid       postgres.ColumnInteger
+	Usesysid        postgres.ColumnInteger
+	Usename         postgres.ColumnString
+	ApplicationName postgres.ColumnString
+	ClientAddr      postgres.ColumnString
+	ClientPort      postgres.ColumnInteger
+	BackendStart    postgres.ColumnTimestampz
+	XactStart       postgres.ColumnTimestampz
+	QueryStart      postgres.ColumnTimestampz
+	StateChange     postgres.ColumnTimestampz
+	WaitEventType   postgres.ColumnString
+	WaitEvent       postgres.ColumnString
+	State           postgres.ColumnString
+	BackendXid      postgres.ColumnString
+	BackendXmin     postgres.ColumnString
+	Query           postgres.ColumnString
+	BackendType     postgres.ColumnString
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type PgStatActivityTable struct {
+	pgStatActivityTable
+
+	EXCLUDED pgStatActivityTable
+}
+
+// AS creates new PgStatActivityTable with assigned alias
+func (a PgStatActivityTable) AS(alias string) *PgStatActivityTable {
+	return newPgStatActivityTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new PgStatActivityTable with assigned schema name
+func (a PgStatActivityTable) FromSchema(schemaName string) *PgStatActivityTable {
+	return newPgStatActivityTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newPgStatActivityTable(schemaName, tableName, alias string) *PgStatActivityTable {
+	return &PgStatActivityTable{
+		pgStatActivityTable: newPgStatActivityTableImpl(schemaName, tableName, alias),
+		EXCLUDED:            newPgStatActivityTableImpl("", "excluded", ""),
+	}
+}
+
+func newPgStatActivityTableImpl(schemaName, tableName, alias string) pgStatActivityTable {
+	var (
+		DatidColumn           = postgres.IntegerColumn("datid")
+		DatnameColumn         = postgres.StringColumn("datname")
+		PidColumn             = postgres.IntegerColumn("pid")
+		LeaderPidColumn       = postgres.IntegerColumn("leader_pid")
+		UsesysidColumn        = postgres.IntegerColumn("usesysid")
+		UsenameColumn         = postgres.StringColumn("usename")
+		ApplicationNameColumn = postgres.StringColumn("application_name")
+		ClientAddrColumn      = postgres.StringColumn("client_addr")
+		ClientPortColumn      = postgres.IntegerColumn("client_port")
+		BackendStartColumn    = postgres.TimestampzColumn("backend_start")
+		XactStartColumn       = postgres.TimestampzColumn("xact_start")
+		QueryStartColumn      = postgres.TimestampzColumn("query_start")
+		StateChangeColumn     = postgres.TimestampzColumn("state_change")
+		WaitEventTypeColumn   = postgres.StringColumn("wait_event_type")
+		WaitEventColumn       = postgres.StringColumn("wait_event")
+		StateColumn           = postgres.StringColumn("state")
+		BackendXidColumn      = postgres.StringColumn("backend_xid")
+		BackendXminColumn     = postgres.StringColumn("backend_xmin")
+		QueryColumn           = postgres.StringColumn("query")
+		BackendTypeColumn     = postgres.StringColumn("backend_type")
+		allColumns            = postgres.ColumnList{DatidColumn, DatnameColumn, PidColumn, LeaderPidColumn, UsesysidColumn, UsenameColumn, ApplicationNameColumn, ClientAddrColumn, ClientPortColumn, BackendStartColumn, XactStartColumn, QueryStartColumn, StateChangeColumn, WaitEventTypeColumn, WaitEventColumn, StateColumn, BackendXidColumn, BackendXminColumn, QueryColumn, BackendTypeColumn}
+		mutableColumns        = postgres.ColumnList{DatidColumn, DatnameColumn, PidColumn, LeaderPidColumn, UsesysidColumn, UsenameColumn, ApplicationNameColumn, ClientAddrColumn, ClientPortColumn, BackendStartColumn, XactStartColumn, QueryStartColumn, StateChangeColumn, WaitEventTypeColumn, WaitEventColumn, StateColumn, BackendXidColumn, BackendXminColumn, QueryColumn, BackendTypeColumn}
+	)
+
+	return pgStatActivityTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		Datid:           DatidColumn,
+		Datname:         DatnameColumn,
+		Pid:             PidColumn,
+		LeaderPid:       LeaderPidColumn,
+		Usesysid:        UsesysidColumn,
+		Usename:         UsenameColumn,
+		ApplicationName: ApplicationNameColumn,
+		ClientAddr:      ClientAddrColumn,
+		ClientPort:      ClientPortColumn,
+		BackendStart:    BackendStartColumn,
+		XactStart:       XactStartColumn,
+		QueryStart:      QueryStartColumn,
+		StateChange:     StateChangeColumn,
+		WaitEventType:   WaitEventTypeColumn,
+		WaitEvent:       WaitEventColumn,
+		State:           StateColumn,
+		BackendXid:      BackendXidColumn,
+		BackendXmin:     BackendXminColumn,
+		Query:           QueryColumn,
+		BackendType:     BackendTypeColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
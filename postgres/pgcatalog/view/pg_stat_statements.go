@@ -0,0 +1,109 @@
+package view
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var PgStatStatements = newPgStatStatementsTable("", "pg_stat_statements", "")
+
+type pgStatStatementsTable struct {
+	postgres.Table
+
+	//Columns
+	Userid            postgres.ColumnInteger
+	Dbid              postgres.ColumnInteger
+	Toplevel          postgres.ColumnBool
+	Queryid           postgres.ColumnInteger
+	Query             postgres.ColumnString
+	Calls             postgres.ColumnInteger
+	TotalExecTime     postgres.ColumnFloat
+	MinExecTime       postgres.ColumnFloat
+	MaxExecTime       postgres.ColumnFloat
+	MeanExecTime      postgres.ColumnFloat
+	StddevExecTime    postgres.ColumnFloat
+	Rows              postgres.ColumnInteger
+	SharedBlksHit     postgres.ColumnInteger
+	SharedBlksRead    postgres.ColumnInteger
+	SharedBlksDirtied postgres.ColumnInteger
+	SharedBlksWritten postgres.ColumnInteger
+	TempBlksRead      postgres.ColumnInteger
+	TempBlksWritten   postgres.ColumnInteger
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type PgStatStatementsTable struct {
+	pgStatStatementsTable
+
+	EXCLUDED pgStatStatementsTable
+}
+
+// AS creates new PgStatStatementsTable with assigned alias
+func (a PgStatStatementsTable) AS(alias string) *PgStatStatementsTable {
+	return newPgStatStatementsTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new PgStatStatementsTable with assigned schema name
+func (a PgStatStatementsTable) FromSchema(schemaName string) *PgStatStatementsTable {
+	return newPgStatStatementsTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newPgStatStatementsTable(schemaName, tableName, alias string) *PgStatStatementsTable {
+	return &PgStatStatementsTable{
+		pgStatStatementsTable: newPgStatStatementsTableImpl(schemaName, tableName, alias),
+		EXCLUDED:              newPgStatStatementsTableImpl("", "excluded", ""),
+	}
+}
+
+func newPgStatStatementsTableImpl(schemaName, tableName, alias string) pgStatStatementsTable {
+	var (
+		UseridColumn            = postgres.IntegerColumn("userid")
+		DbidColumn              = postgres.IntegerColumn("dbid")
+		ToplevelColumn          = postgres.BoolColumn("toplevel")
+		QueryidColumn           = postgres.IntegerColumn("queryid")
+		QueryColumn             = postgres.StringColumn("query")
+		CallsColumn             = postgres.IntegerColumn("calls")
+		TotalExecTimeColumn     = postgres.FloatColumn("total_exec_time")
+		MinExecTimeColumn       = postgres.FloatColumn("min_exec_time")
+		MaxExecTimeColumn       = postgres.FloatColumn("max_exec_time")
+		MeanExecTimeColumn      = postgres.FloatColumn("mean_exec_time")
+		StddevExecTimeColumn    = postgres.FloatColumn("stddev_exec_time")
+		RowsColumn              = postgres.IntegerColumn("rows")
+		SharedBlksHitColumn     = postgres.IntegerColumn("shared_blks_hit")
+		SharedBlksReadColumn    = postgres.IntegerColumn("shared_blks_read")
+		SharedBlksDirtiedColumn = postgres.IntegerColumn("shared_blks_dirtied")
+		SharedBlksWrittenColumn = postgres.IntegerColumn("shared_blks_written")
+		TempBlksReadColumn      = postgres.IntegerColumn("temp_blks_read")
+		TempBlksWrittenColumn   = postgres.IntegerColumn("temp_blks_written")
+		allColumns              = postgres.ColumnList{UseridColumn, DbidColumn, ToplevelColumn, QueryidColumn, QueryColumn, CallsColumn, TotalExecTimeColumn, MinExecTimeColumn, MaxExecTimeColumn, MeanExecTimeColumn, StddevExecTimeColumn, RowsColumn, SharedBlksHitColumn, SharedBlksReadColumn, SharedBlksDirtiedColumn, SharedBlksWrittenColumn, TempBlksReadColumn, TempBlksWrittenColumn}
+		mutableColumns          = postgres.ColumnList{UseridColumn, DbidColumn, ToplevelColumn, QueryidColumn, QueryColumn, CallsColumn, TotalExecTimeColumn, MinExecTimeColumn, MaxExecTimeColumn, MeanExecTimeColumn, StddevExecTimeColumn, RowsColumn, SharedBlksHitColumn, SharedBlksReadColumn, SharedBlksDirtiedColumn, SharedBlksWrittenColumn, TempBlksReadColumn, TempBlksWrittenColumn}
+	)
+
+	return pgStatStatementsTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		Userid:            UseridColumn,
+		Dbid:              DbidColumn,
+		Toplevel:          ToplevelColumn,
+		Queryid:           QueryidColumn,
+		Query:             QueryColumn,
+		Calls:             CallsColumn,
+		TotalExecTime:     TotalExecTimeColumn,
+		MinExecTime:       MinExecTimeColumn,
+		MaxExecTime:       MaxExecTimeColumn,
+		MeanExecTime:      MeanExecTimeColumn,
+		StddevExecTime:    StddevExecTimeColumn,
+		Rows:              RowsColumn,
+		SharedBlksHit:     SharedBlksHitColumn,
+		SharedBlksRead:    SharedBlksReadColumn,
+		SharedBlksDirtied: SharedBlksDirtiedColumn,
+		SharedBlksWritten: SharedBlksWrittenColumn,
+		TempBlksRead:      TempBlksReadColumn,
+		TempBlksWritten:   TempBlksWrittenColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
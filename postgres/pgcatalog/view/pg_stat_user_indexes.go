@@ -0,0 +1,79 @@
+package view
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var PgStatUserIndexes = newPgStatUserIndexesTable("pg_catalog", "pg_stat_user_indexes", "")
+
+type pgStatUserIndexesTable struct {
+	postgres.Table
+
+	//Columns
+	Relid        postgres.ColumnInteger
+	Indexrelid   postgres.ColumnInteger
+	Schemaname   postgres.ColumnString
+	Relname      postgres.ColumnString
+	Indexrelname postgres.ColumnString
+	IdxScan      postgres.ColumnInteger
+	IdxTupRead   postgres.ColumnInteger
+	IdxTupFetch  postgres.ColumnInteger
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type PgStatUserIndexesTable struct {
+	pgStatUserIndexesTable
+
+	EXCLUDED pgStatUserIndexesTable
+}
+
+// AS creates new PgStatUserIndexesTable with assigned alias
+func (a PgStatUserIndexesTable) AS(alias string) *PgStatUserIndexesTable {
+	return newPgStatUserIndexesTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new PgStatUserIndexesTable with assigned schema name
+func (a PgStatUserIndexesTable) FromSchema(schemaName string) *PgStatUserIndexesTable {
+	return newPgStatUserIndexesTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newPgStatUserIndexesTable(schemaName, tableName, alias string) *PgStatUserIndexesTable {
+	return &PgStatUserIndexesTable{
+		pgStatUserIndexesTable: newPgStatUserIndexesTableImpl(schemaName, tableName, alias),
+		EXCLUDED:               newPgStatUserIndexesTableImpl("", "excluded", ""),
+	}
+}
+
+func newPgStatUserIndexesTableImpl(schemaName, tableName, alias string) pgStatUserIndexesTable {
+	var (
+		RelidColumn        = postgres.IntegerColumn("relid")
+		IndexrelidColumn   = postgres.IntegerColumn("indexrelid")
+		SchemanameColumn   = postgres.StringColumn("schemaname")
+		RelnameColumn      = postgres.StringColumn("relname")
+		IndexrelnameColumn = postgres.StringColumn("indexrelname")
+		IdxScanColumn      = postgres.IntegerColumn("idx_scan")
+		IdxTupReadColumn   = postgres.IntegerColumn("idx_tup_read")
+		IdxTupFetchColumn  = postgres.IntegerColumn("idx_tup_fetch")
+		allColumns         = postgres.ColumnList{RelidColumn, IndexrelidColumn, SchemanameColumn, RelnameColumn, IndexrelnameColumn, IdxScanColumn, IdxTupReadColumn, IdxTupFetchColumn}
+		mutableColumns     = postgres.ColumnList{RelidColumn, IndexrelidColumn, SchemanameColumn, RelnameColumn, IndexrelnameColumn, IdxScanColumn, IdxTupReadColumn, IdxTupFetchColumn}
+	)
+
+	return pgStatUserIndexesTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		Relid:        RelidColumn,
+		Indexrelid:   IndexrelidColumn,
+		Schemaname:   SchemanameColumn,
+		Relname:      RelnameColumn,
+		Indexrelname: IndexrelnameColumn,
+		IdxScan:      IdxScanColumn,
+		IdxTupRead:   IdxTupReadColumn,
+		IdxTupFetch:  IdxTupFetchColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -0,0 +1,88 @@
+package view
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var PgStatioUserTables = newPgStatioUserTablesTable("pg_catalog", "pg_statio_user_tables", "")
+
+type pgStatioUserTablesTable struct {
+	postgres.Table
+
+	//Columns
+	Relid         postgres.ColumnInteger
+	Schemaname    postgres.ColumnString
+	Relname       postgres.ColumnString
+	HeapBlksRead  postgres.ColumnInteger
+	HeapBlksHit   postgres.ColumnInteger
+	IdxBlksRead   postgres.ColumnInteger
+	IdxBlksHit    postgres.ColumnInteger
+	ToastBlksRead postgres.ColumnInteger
+	ToastBlksHit  postgres.ColumnInteger
+	TidxBlksRead  postgres.ColumnInteger
+	TidxBlksHit   postgres.ColumnInteger
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type PgStatioUserTablesTable struct {
+	pgStatioUserTablesTable
+
+	EXCLUDED pgStatioUserTablesTable
+}
+
+// AS creates new PgStatioUserTablesTable with assigned alias
+func (a PgStatioUserTablesTable) AS(alias string) *PgStatioUserTablesTable {
+	return newPgStatioUserTablesTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new PgStatioUserTablesTable with assigned schema name
+func (a PgStatioUserTablesTable) FromSchema(schemaName string) *PgStatioUserTablesTable {
+	return newPgStatioUserTablesTable(schemaName, a.TableName(), a.Alias())
+}
+
+func newPgStatioUserTablesTable(schemaName, tableName, alias string) *PgStatioUserTablesTable {
+	return &PgStatioUserTablesTable{
+		pgStatioUserTablesTable: newPgStatioUserTablesTableImpl(schemaName, tableName, alias),
+		EXCLUDED:                newPgStatioUserTablesTableImpl("", "excluded", ""),
+	}
+}
+
+func newPgStatioUserTablesTableImpl(schemaName, tableName, alias string) pgStatioUserTablesTable {
+	var (
+		RelidColumn         = postgres.IntegerColumn("relid")
+		SchemanameColumn    = postgres.StringColumn("schemaname")
+		RelnameColumn       = postgres.StringColumn("relname")
+		HeapBlksReadColumn  = postgres.IntegerColumn("heap_blks_read")
+		HeapBlksHitColumn   = postgres.IntegerColumn("heap_blks_hit")
+		IdxBlksReadColumn   = postgres.IntegerColumn("idx_blks_read")
+		IdxBlksHitColumn    = postgres.IntegerColumn("idx_blks_hit")
+		ToastBlksReadColumn = postgres.IntegerColumn("toast_blks_read")
+		ToastBlksHitColumn  = postgres.IntegerColumn("toast_blks_hit")
+		TidxBlksReadColumn  = postgres.IntegerColumn("tidx_blks_read")
+		TidxBlksHitColumn   = postgres.IntegerColumn("tidx_blks_hit")
+		allColumns          = postgres.ColumnList{RelidColumn, SchemanameColumn, RelnameColumn, HeapBlksReadColumn, HeapBlksHitColumn, IdxBlksReadColumn, IdxBlksHitColumn, ToastBlksReadColumn, ToastBlksHitColumn, TidxBlksReadColumn, TidxBlksHitColumn}
+		mutableColumns      = postgres.ColumnList{RelidColumn, SchemanameColumn, RelnameColumn, HeapBlksReadColumn, HeapBlksHitColumn, IdxBlksReadColumn, IdxBlksHitColumn, ToastBlksReadColumn, ToastBlksHitColumn, TidxBlksReadColumn, TidxBlksHitColumn}
+	)
+
+	return pgStatioUserTablesTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		Relid:         RelidColumn,
+		Schemaname:    SchemanameColumn,
+		Relname:       RelnameColumn,
+		HeapBlksRead:  HeapBlksReadColumn,
+		HeapBlksHit:   HeapBlksHitColumn,
+		IdxBlksRead:   IdxBlksReadColumn,
+		IdxBlksHit:    IdxBlksHitColumn,
+		ToastBlksRead: ToastBlksReadColumn,
+		ToastBlksHit:  ToastBlksHitColumn,
+		TidxBlksRead:  TidxBlksReadColumn,
+		TidxBlksHit:   TidxBlksHitColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -0,0 +1,37 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// PreparedStatement is a set of statements used to explicitly manage server-side prepared statement
+type PreparedStatement struct {
+	// Prepare creates prepared statement on the server (PREPARE name AS query)
+	Prepare Statement
+	// Execute executes previously prepared statement with statement arguments (EXECUTE name(args...))
+	Execute Statement
+	// Deallocate removes prepared statement from the server (DEALLOCATE name)
+	Deallocate Statement
+}
+
+// PREPARE creates PREPARE/EXECUTE/DEALLOCATE statements for server-side prepared statement name.
+// Statement query is used as a prepared statement body, and statement arguments are inlined into EXECUTE
+// statement, because EXECUTE does not accept query parameters.
+func PREPARE(name string, statement Statement) PreparedStatement {
+	query, args := statement.Sql()
+
+	return PreparedStatement{
+		Prepare:    newClauseStatement(jet.PrepareStatementType, &jet.ClausePrepare{Name: name, Query: query}),
+		Execute:    newClauseStatement(jet.ExecuteStatementType, &jet.ClauseExecute{Name: name, Args: args}),
+		Deallocate: newClauseStatement(jet.DeallocateStatementType, &jet.ClauseDeallocate{Name: name}),
+	}
+}
+
+type clauseStatementImpl struct {
+	jet.SerializerStatement
+}
+
+func newClauseStatement(statementType jet.StatementType, clauses ...jet.Clause) Statement {
+	newStatement := &clauseStatementImpl{}
+	newStatement.SerializerStatement = jet.NewStatementImpl(Dialect, statementType, newStatement, clauses...)
+
+	return newStatement
+}
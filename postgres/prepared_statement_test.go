@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestPREPARE(t *testing.T) {
+	prepared := PREPARE("select_table1",
+		SELECT(table1Col1).
+			FROM(table1).
+			WHERE(table1Col1.EQ(Int(11)).AND(table1ColBool.EQ(Bool(true)))),
+	)
+
+	assertStatementSql(t, prepared.Prepare, `
+PREPARE select_table1 AS
+SELECT table1.col1 AS "table1.col1"
+FROM db.table1
+WHERE (table1.col1 = $1) AND (table1.col_bool = $2::boolean);
+`)
+	assertStatementSql(t, prepared.Execute, `
+EXECUTE select_table1 (11, TRUE);
+`)
+	assertStatementSql(t, prepared.Deallocate, `
+DEALLOCATE select_table1;
+`)
+}
+
+func TestToPrepared(t *testing.T) {
+	prepared := table1.UPDATE(table1Col1).
+		SET(Int(2)).
+		WHERE(table1ColBool.EQ(Bool(false))).
+		ToPrepared("UpdateTable1")
+
+	assertStatementSql(t, prepared.Prepare, `
+PREPARE "UpdateTable1" AS
+UPDATE db.table1
+SET col1 = $1
+WHERE table1.col_bool = $2::boolean;
+`)
+	assertStatementSql(t, prepared.Execute, `
+EXECUTE "UpdateTable1" (2, FALSE);
+`)
+	assertStatementSql(t, table1.DELETE().WHERE(table1Col1.EQ(Int(1))).ToPrepared("del").Execute, `
+EXECUTE del (1);
+`)
+}
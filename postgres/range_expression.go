@@ -0,0 +1,227 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// RangeExpression is representation of range types (int4range, int8range, numrange, tsrange, tstzrange and daterange)
+type RangeExpression interface {
+	jet.Expression
+
+	isRange()
+
+	EQ(rhs RangeExpression) BoolExpression
+	NOT_EQ(rhs RangeExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs RangeExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs RangeExpression) BoolExpression
+
+	// CONTAINS returns true if this range contains rhs range (@> operator)
+	CONTAINS(rhs RangeExpression) BoolExpression
+	// CONTAINS_ELEMENT returns true if this range contains element (@> operator)
+	CONTAINS_ELEMENT(element Expression) BoolExpression
+	// CONTAINED_BY returns true if this range is contained by rhs range (<@ operator)
+	CONTAINED_BY(rhs RangeExpression) BoolExpression
+	// OVERLAP returns true if this range and rhs range have points in common (&& operator)
+	OVERLAP(rhs RangeExpression) BoolExpression
+	// STRICTLY_LEFT_OF returns true if this range is strictly left of rhs range (<< operator)
+	STRICTLY_LEFT_OF(rhs RangeExpression) BoolExpression
+	// STRICTLY_RIGHT_OF returns true if this range is strictly right of rhs range (>> operator)
+	STRICTLY_RIGHT_OF(rhs RangeExpression) BoolExpression
+	// ADJACENT_TO returns true if this range is adjacent to rhs range (-|- operator)
+	ADJACENT_TO(rhs RangeExpression) BoolExpression
+
+	// UNION returns union of this range and rhs range (+ operator). Ranges have to overlap or be adjacent.
+	UNION(rhs RangeExpression) RangeExpression
+	// INTERSECTION returns intersection of this range and rhs range (* operator)
+	INTERSECTION(rhs RangeExpression) RangeExpression
+	// DIFFERENCE returns difference of this range and rhs range (- operator). Result has to be a single range.
+	DIFFERENCE(rhs RangeExpression) RangeExpression
+}
+
+type rangeInterfaceImpl struct {
+	parent RangeExpression
+}
+
+func (r *rangeInterfaceImpl) isRange() {}
+
+func (r *rangeInterfaceImpl) EQ(rhs RangeExpression) BoolExpression {
+	return jet.Eq(r.parent, rhs)
+}
+
+func (r *rangeInterfaceImpl) NOT_EQ(rhs RangeExpression) BoolExpression {
+	return jet.NotEq(r.parent, rhs)
+}
+
+func (r *rangeInterfaceImpl) IS_DISTINCT_FROM(rhs RangeExpression) BoolExpression {
+	return jet.IsDistinctFrom(r.parent, rhs)
+}
+
+func (r *rangeInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs RangeExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(r.parent, rhs)
+}
+
+func (r *rangeInterfaceImpl) CONTAINS(rhs RangeExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "@>"))
+}
+
+func (r *rangeInterfaceImpl) CONTAINS_ELEMENT(element Expression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, element, "@>"))
+}
+
+func (r *rangeInterfaceImpl) CONTAINED_BY(rhs RangeExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "<@"))
+}
+
+func (r *rangeInterfaceImpl) OVERLAP(rhs RangeExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "&&"))
+}
+
+func (r *rangeInterfaceImpl) STRICTLY_LEFT_OF(rhs RangeExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "<<"))
+}
+
+func (r *rangeInterfaceImpl) STRICTLY_RIGHT_OF(rhs RangeExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, ">>"))
+}
+
+func (r *rangeInterfaceImpl) ADJACENT_TO(rhs RangeExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "-|-"))
+}
+
+func (r *rangeInterfaceImpl) UNION(rhs RangeExpression) RangeExpression {
+	return RangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "+"))
+}
+
+func (r *rangeInterfaceImpl) INTERSECTION(rhs RangeExpression) RangeExpression {
+	return RangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "*"))
+}
+
+func (r *rangeInterfaceImpl) DIFFERENCE(rhs RangeExpression) RangeExpression {
+	return RangeExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "-"))
+}
+
+//---------------------------------------------------//
+
+// Range bounds, used as the optional bounds argument of range constructors. Default bounds are "[)".
+const (
+	RangeBoundsInclusiveExclusive = "[)"
+	RangeBoundsExclusiveInclusive = "(]"
+	RangeBoundsInclusive          = "[]"
+	RangeBoundsExclusive          = "()"
+)
+
+// Int4Range creates new int4range from lower and upper bound, with optional bounds ("[)", "(]", "[]" or "()").
+// Use IntExp(NULL) bound for unbounded range.
+func Int4Range(lower, upper IntegerExpression, bounds ...string) RangeExpression {
+	return newRange("int4range", lower, upper, bounds)
+}
+
+// Int8Range creates new int8range from lower and upper bound, with optional bounds ("[)", "(]", "[]" or "()").
+func Int8Range(lower, upper IntegerExpression, bounds ...string) RangeExpression {
+	return newRange("int8range", lower, upper, bounds)
+}
+
+// NumRange creates new numrange from lower and upper bound, with optional bounds ("[)", "(]", "[]" or "()").
+func NumRange(lower, upper FloatExpression, bounds ...string) RangeExpression {
+	return newRange("numrange", lower, upper, bounds)
+}
+
+// TsRange creates new tsrange from lower and upper bound, with optional bounds ("[)", "(]", "[]" or "()").
+func TsRange(lower, upper TimestampExpression, bounds ...string) RangeExpression {
+	return newRange("tsrange", lower, upper, bounds)
+}
+
+// TstzRange creates new tstzrange from lower and upper bound, with optional bounds ("[)", "(]", "[]" or "()").
+func TstzRange(lower, upper TimestampzExpression, bounds ...string) RangeExpression {
+	return newRange("tstzrange", lower, upper, bounds)
+}
+
+// DateRange creates new daterange from lower and upper bound, with optional bounds ("[)", "(]", "[]" or "()").
+func DateRange(lower, upper DateExpression, bounds ...string) RangeExpression {
+	return newRange("daterange", lower, upper, bounds)
+}
+
+func newRange(rangeType string, lower, upper Expression, bounds []string) RangeExpression {
+	args := []Expression{lower, upper}
+
+	if len(bounds) > 0 {
+		switch bounds[0] {
+		case RangeBoundsInclusiveExclusive, RangeBoundsExclusiveInclusive, RangeBoundsInclusive, RangeBoundsExclusive:
+			args = append(args, jet.FixedLiteral(bounds[0]))
+		default:
+			panic("jet: invalid range bounds '" + bounds[0] + "', expected one of '[)', '(]', '[]' or '()'")
+		}
+	}
+
+	return RangeExp(jet.NewFunc(rangeType, args, nil))
+}
+
+// LOWER_BOUND returns lower bound of the range, or NULL if range is empty or lower bound is infinite.
+func LOWER_BOUND(rangeExp RangeExpression) Expression {
+	return jet.NewFunc("lower", []Expression{rangeExp}, nil)
+}
+
+// UPPER_BOUND returns upper bound of the range, or NULL if range is empty or upper bound is infinite.
+func UPPER_BOUND(rangeExp RangeExpression) Expression {
+	return jet.NewFunc("upper", []Expression{rangeExp}, nil)
+}
+
+// ISEMPTY returns true if the range is empty.
+func ISEMPTY(rangeExp RangeExpression) BoolExpression {
+	return BoolExp(jet.NewFunc("isempty", []Expression{rangeExp}, nil))
+}
+
+// LOWER_INC returns true if lower bound of the range is inclusive.
+func LOWER_INC(rangeExp RangeExpression) BoolExpression {
+	return BoolExp(jet.NewFunc("lower_inc", []Expression{rangeExp}, nil))
+}
+
+// UPPER_INC returns true if upper bound of the range is inclusive.
+func UPPER_INC(rangeExp RangeExpression) BoolExpression {
+	return BoolExp(jet.NewFunc("upper_inc", []Expression{rangeExp}, nil))
+}
+
+//---------------------------------------------------//
+
+type rangeWrapper struct {
+	rangeInterfaceImpl
+	Expression
+}
+
+func newRangeExpressionWrap(expression Expression) RangeExpression {
+	rangeWrap := &rangeWrapper{Expression: expression}
+	rangeWrap.rangeInterfaceImpl.parent = rangeWrap
+	return rangeWrap
+}
+
+// RangeExp is range expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as range expression.
+// Does not add sql cast to generated sql builder output.
+func RangeExp(expression Expression) RangeExpression {
+	return newRangeExpressionWrap(expression)
+}
+
+// OVERLAPS_PERIOD returns true if half-open period [start, end) overlaps half-open period [from, to). Period start
+// or end NULL value is unbounded, and nil from or to is unbounded as well. For timestamp, timestamp with time zone
+// and date periods, condition uses range overlap operator (tstzrange(start, end) && tstzrange(from, to)), which can
+// use GiST expression index. Period end has to be greater than or equal to period start.
+func OVERLAPS_PERIOD(start, end, from, to Expression) BoolExpression {
+	rangeType := periodRangeType(start)
+
+	if rangeType == "" {
+		return jet.OVERLAPS_PERIOD(start, end, from, to)
+	}
+
+	return newRange(rangeType, start, end, nil).OVERLAP(newRange(rangeType, nullIfNil(from), nullIfNil(to), nil))
+}
+
+func periodRangeType(start Expression) string {
+	switch start.(type) {
+	case TimestampzExpression:
+		return "tstzrange"
+	case TimestampExpression:
+		return "tsrange"
+	case DateExpression:
+		return "daterange"
+	default:
+		return ""
+	}
+}
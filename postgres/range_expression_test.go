@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var bookingRoomID = IntegerColumn("room_id")
+var bookingDuring = RangeColumn("during")
+var bookings = NewTable("db", "bookings", "", bookingRoomID, bookingDuring)
+
+func TestRangeConstructors(t *testing.T) {
+	assertSerialize(t, Int4Range(Int(1), Int(10)), "int4range($1, $2)", int64(1), int64(10))
+	assertSerialize(t, Int4Range(Int(1), Int(10), RangeBoundsInclusive), "int4range($1, $2, '[]')", int64(1), int64(10))
+	assertSerialize(t, Int8Range(Int(1), IntExp(NULL)), "int8range($1, NULL)", int64(1))
+	assertSerialize(t, NumRange(Float(1.5), Float(2.5), "()"), "numrange($1, $2, '()')", 1.5, 2.5)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	assertSerialize(t, TsRange(TimestampT(from), TimestampT(to)), "tsrange($1::timestamp without time zone, $2::timestamp without time zone)", from, to)
+	assertSerialize(t, TstzRange(TimestampzT(from), TimestampzT(to), "(]"), "tstzrange($1::timestamp with time zone, $2::timestamp with time zone, '(]')", from, to)
+	assertSerialize(t, DateRange(DateT(from), DateT(to)), "daterange($1::date, $2::date)", from, to)
+
+	require.PanicsWithValue(t, "jet: invalid range bounds '[[', expected one of '[)', '(]', '[]' or '()'", func() {
+		Int4Range(Int(1), Int(2), "[[")
+	})
+}
+
+func TestRangeExpression(t *testing.T) {
+	other := Int4Range(Int(1), Int(10))
+
+	assertSerialize(t, bookingDuring.EQ(other), "(bookings.during = int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingDuring.CONTAINS(other), "(bookings.during @> int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingDuring.CONTAINS_ELEMENT(Int(5)), "(bookings.during @> $1)", int64(5))
+	assertSerialize(t, bookingDuring.CONTAINED_BY(other), "(bookings.during <@ int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingDuring.OVERLAP(other), "(bookings.during && int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingDuring.STRICTLY_LEFT_OF(other), "(bookings.during << int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingDuring.STRICTLY_RIGHT_OF(other), "(bookings.during >> int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingDuring.ADJACENT_TO(other), "(bookings.during -|- int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingDuring.UNION(other), "(bookings.during + int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingDuring.INTERSECTION(other), "(bookings.during * int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingDuring.DIFFERENCE(other).OVERLAP(other),
+		"((bookings.during - int4range($1, $2)) && int4range($3, $4))", int64(1), int64(10), int64(1), int64(10))
+}
+
+func TestRangeFunctions(t *testing.T) {
+	assertSerialize(t, IntExp(LOWER_BOUND(bookingDuring)).GT(Int(2)), "(lower(bookings.during) > $1)", int64(2))
+	assertSerialize(t, UPPER_BOUND(bookingDuring), "upper(bookings.during)")
+	assertSerialize(t, ISEMPTY(bookingDuring), "isempty(bookings.during)")
+	assertSerialize(t, LOWER_INC(bookingDuring), "lower_inc(bookings.during)")
+	assertSerialize(t, UPPER_INC(bookingDuring), "upper_inc(bookings.during)")
+}
+
+func TestRangeColumn(t *testing.T) {
+	subQuery := SELECT(bookingDuring).FROM(bookings).AsTable("sub_query")
+
+	subQueryRangeColumn := bookingDuring.From(subQuery)
+	assertSerialize(t, subQueryRangeColumn.CONTAINS_ELEMENT(Int(1)), `(sub_query."bookings.during" @> $1)`, int64(1))
+	assertProjectionSerialize(t, subQueryRangeColumn, `sub_query."bookings.during" AS "bookings.during"`)
+}
+
+func TestOVERLAPS_PERIOD(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assertSerialize(t, OVERLAPS_PERIOD(table1ColTimestampz, table2ColTimestampz, TimestampzT(from), nil),
+		"(tstzrange(table1.col_timestampz, table2.col_timestampz) && tstzrange($1::timestamp with time zone, NULL))", from)
+	assertSerialize(t, OVERLAPS_PERIOD(table1ColTimestamp, table2ColTimestamp, nil, TimestampT(from)),
+		"(tsrange(table1.col_timestamp, table2.col_timestamp) && tsrange(NULL, $1::timestamp without time zone))", from)
+	assertSerialize(t, OVERLAPS_PERIOD(table1ColDate, table2ColDate, DateT(from), nil),
+		"(daterange(table1.col_date, table2.col_date) && daterange($1::date, NULL))", from)
+	assertSerialize(t, OVERLAPS_PERIOD(table1ColInt, table2ColInt, Int(1), nil),
+		`(
+    table2.col_int IS NULL
+        OR (table2.col_int > $1)
+)`, int64(1))
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// ReplicationSlotTable is a table returned by PG_CREATE_LOGICAL_REPLICATION_SLOT.
+// Query result can be stored into ReplicationSlot destination.
+type ReplicationSlotTable struct {
+	readableTableInterfaceImpl
+	jet.Serializer
+
+	SlotName ColumnString
+	Lsn      ColumnString
+}
+
+// ReplicationSlot is a destination for ReplicationSlotTable rows
+type ReplicationSlot struct {
+	SlotName string
+	Lsn      string
+}
+
+// PG_CREATE_LOGICAL_REPLICATION_SLOT creates new logical replication slot using output plugin (for instance
+// "pgoutput", "test_decoding" or "wal2json"). Temporary slot is dropped at the end of the session.
+// For instance:
+//
+//	slot := PG_CREATE_LOGICAL_REPLICATION_SLOT(String("orders_cdc"), String("wal2json"))
+//	SELECT(slot.SlotName, slot.Lsn).FROM(slot)
+func PG_CREATE_LOGICAL_REPLICATION_SLOT(slotName, plugin StringExpression, temporary ...BoolExpression) *ReplicationSlotTable {
+	args := []Expression{slotName, plugin}
+
+	if len(temporary) > 0 {
+		args = append(args, temporary[0])
+	}
+
+	slot := &ReplicationSlotTable{
+		Serializer: newReplicationFuncClause("pg_create_logical_replication_slot", "replication_slot", args),
+		SlotName:   StringColumn("slot_name"),
+		Lsn:        StringColumn("lsn"),
+	}
+
+	jet.SetTableName(slot.SlotName, "replication_slot")
+	jet.SetTableName(slot.Lsn, "replication_slot")
+	slot.readableTableInterfaceImpl.parent = slot
+
+	return slot
+}
+
+// LogicalSlotChangeTable is a table returned by PG_LOGICAL_SLOT_GET_CHANGES and PG_LOGICAL_SLOT_PEEK_CHANGES.
+// Query result can be stored into []LogicalSlotChange destination.
+type LogicalSlotChangeTable struct {
+	readableTableInterfaceImpl
+	jet.Serializer
+
+	Lsn  ColumnString
+	Xid  ColumnInteger
+	Data ColumnString
+}
+
+// LogicalSlotChange is a destination for LogicalSlotChangeTable rows
+type LogicalSlotChange struct {
+	Lsn  string
+	Xid  uint32
+	Data string
+}
+
+// PG_LOGICAL_SLOT_GET_CHANGES returns and consumes changes in the slot slotName, starting from the point changes
+// have been consumed last. If uptoLsn is not nil, only changes from transactions committing before uptoLsn are
+// returned. If uptoNChanges is not nil, decoding stops once number of rows produced exceeds uptoNChanges.
+// Options are pairs of output plugin option names and values.
+// For instance:
+//
+//	changes := PG_LOGICAL_SLOT_GET_CHANGES(String("orders_cdc"), nil, Int(1000), String("include-xids"), String("0"))
+//	SELECT(changes.Lsn, changes.Xid, changes.Data).FROM(changes)
+func PG_LOGICAL_SLOT_GET_CHANGES(slotName, uptoLsn StringExpression, uptoNChanges IntegerExpression, options ...StringExpression) *LogicalSlotChangeTable {
+	return newLogicalSlotChangeTable("pg_logical_slot_get_changes", slotName, uptoLsn, uptoNChanges, options)
+}
+
+// PG_LOGICAL_SLOT_PEEK_CHANGES behaves like PG_LOGICAL_SLOT_GET_CHANGES, except that changes are not consumed,
+// and they will be returned again in the future calls.
+func PG_LOGICAL_SLOT_PEEK_CHANGES(slotName, uptoLsn StringExpression, uptoNChanges IntegerExpression, options ...StringExpression) *LogicalSlotChangeTable {
+	return newLogicalSlotChangeTable("pg_logical_slot_peek_changes", slotName, uptoLsn, uptoNChanges, options)
+}
+
+func newLogicalSlotChangeTable(funcName string, slotName, uptoLsn StringExpression, uptoNChanges IntegerExpression,
+	options []StringExpression) *LogicalSlotChangeTable {
+
+	if len(options)%2 != 0 {
+		panic("jet: " + funcName + " options have to be name and value pairs")
+	}
+
+	args := []Expression{slotName, nullIfNil(uptoLsn), nullIfNil(uptoNChanges)}
+
+	for _, option := range options {
+		args = append(args, option)
+	}
+
+	changes := &LogicalSlotChangeTable{
+		Serializer: newReplicationFuncClause(funcName, "logical_slot_change", args),
+		Lsn:        StringColumn("lsn"),
+		Xid:        IntegerColumn("xid"),
+		Data:       StringColumn("data"),
+	}
+
+	jet.SetTableName(changes.Lsn, "logical_slot_change")
+	jet.SetTableName(changes.Xid, "logical_slot_change")
+	jet.SetTableName(changes.Data, "logical_slot_change")
+	changes.readableTableInterfaceImpl.parent = changes
+
+	return changes
+}
+
+// PG_DROP_REPLICATION_SLOT drops physical or logical replication slot
+func PG_DROP_REPLICATION_SLOT(slotName StringExpression) Expression {
+	return jet.NewFunc("pg_drop_replication_slot", []Expression{slotName}, nil)
+}
+
+func nullIfNil(expression Expression) Expression {
+	if expression == nil {
+		return NULL
+	}
+
+	return expression
+}
+
+func newReplicationFuncClause(funcName, alias string, args []Expression) jet.Serializer {
+	return jet.NewSerializerClauseImpl(&replicationFuncClause{
+		funcName: funcName,
+		alias:    alias,
+		args:     args,
+	})
+}
+
+type replicationFuncClause struct {
+	funcName string
+	alias    string
+	args     []Expression
+}
+
+func (r *replicationFuncClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	out.WriteString(r.funcName + "(")
+
+	for i, arg := range r.args {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		jet.Serialize(arg, statementType, out)
+	}
+
+	out.WriteString(") AS")
+	out.WriteIdentifier(r.alias)
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestPG_CREATE_LOGICAL_REPLICATION_SLOT(t *testing.T) {
+	slot := PG_CREATE_LOGICAL_REPLICATION_SLOT(String("orders_cdc"), String("wal2json"), Bool(true))
+
+	assertStatementSql(t, SELECT(slot.SlotName, slot.Lsn).FROM(slot), `
+SELECT replication_slot.slot_name AS "replication_slot.slot_name",
+     replication_slot.lsn AS "replication_slot.lsn"
+FROM pg_create_logical_replication_slot($1, $2, $3::boolean) AS replication_slot;
+`, "orders_cdc", "wal2json", true)
+}
+
+func TestPG_LOGICAL_SLOT_GET_CHANGES(t *testing.T) {
+	changes := PG_LOGICAL_SLOT_GET_CHANGES(String("orders_cdc"), nil, Int(1000), String("include-xids"), String("0"))
+
+	assertStatementSql(t, SELECT(changes.Lsn, changes.Xid, changes.Data).FROM(changes), `
+SELECT logical_slot_change.lsn AS "logical_slot_change.lsn",
+     logical_slot_change.xid AS "logical_slot_change.xid",
+     logical_slot_change.data AS "logical_slot_change.data"
+FROM pg_logical_slot_get_changes($1, NULL, $2, $3, $4) AS logical_slot_change;
+`, "orders_cdc", int64(1000), "include-xids", "0")
+}
+
+func TestPG_LOGICAL_SLOT_PEEK_CHANGES(t *testing.T) {
+	changes := PG_LOGICAL_SLOT_PEEK_CHANGES(String("orders_cdc"), String("0/16B3748"), nil)
+
+	assertStatementSql(t, SELECT(changes.Data).FROM(changes).WHERE(changes.Xid.GT(Int(10))), `
+SELECT logical_slot_change.data AS "logical_slot_change.data"
+FROM pg_logical_slot_peek_changes($1, $2, NULL) AS logical_slot_change
+WHERE logical_slot_change.xid > $3;
+`, "orders_cdc", "0/16B3748", int64(10))
+}
+
+func TestPG_LOGICAL_SLOT_GET_CHANGES_InvalidOptions(t *testing.T) {
+	assertPanicErr(t, func() {
+		PG_LOGICAL_SLOT_GET_CHANGES(String("orders_cdc"), nil, nil, String("include-xids"))
+	}, "jet: pg_logical_slot_get_changes options have to be name and value pairs")
+}
+
+func TestPG_DROP_REPLICATION_SLOT(t *testing.T) {
+	assertStatementSql(t, SELECT(PG_DROP_REPLICATION_SLOT(String("orders_cdc"))), `
+SELECT pg_drop_replication_slot($1);
+`, "orders_cdc")
+}
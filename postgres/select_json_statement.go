@@ -0,0 +1,76 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// SelectJsonStatement is a SELECT statement which result is returned as a single json value. Used as a correlated
+// sub-query projection, it loads child collections in the same query, without join row explosion. Json value can be
+// scanned into nested destination field tagged with `sql:"json"`.
+type SelectJsonStatement interface {
+	Statement
+	Expression
+
+	FROM(tables ...ReadableTable) SelectJsonStatement
+	WHERE(condition BoolExpression) SelectJsonStatement
+	ORDER_BY(orderByClauses ...OrderByClause) SelectJsonStatement
+	LIMIT(limit int64) SelectJsonStatement
+	OFFSET(offset int64) SelectJsonStatement
+}
+
+// SELECT_JSON_ARR creates new SelectJsonStatement, which returns all the selected rows as a json array of objects.
+// Empty json array is returned if no row is selected.
+func SELECT_JSON_ARR(projection Projection, projections ...Projection) SelectJsonStatement {
+	return newSelectJsonStatement(true, append([]Projection{projection}, projections...))
+}
+
+// SELECT_JSON_OBJ creates new SelectJsonStatement, which returns selected row as a json object.
+// NULL is returned if no row is selected.
+func SELECT_JSON_OBJ(projection Projection, projections ...Projection) SelectJsonStatement {
+	return newSelectJsonStatement(false, append([]Projection{projection}, projections...))
+}
+
+func newSelectJsonStatement(array bool, projections []Projection) SelectJsonStatement {
+	rows := SELECT(projections[0], projections[1:]...)
+	records := rows.AsTable("records")
+
+	var jsonProjection Expression = jet.NewStringFunc("ROW_TO_JSON", jet.TableRow(records))
+
+	if array {
+		jsonProjection = COALESCE(JSON_AGG(jsonProjection), jet.Raw("'[]'"))
+	}
+
+	return &selectJsonStatementImpl{
+		SelectStatement: SELECT(jsonProjection).FROM(records),
+		rows:            rows,
+	}
+}
+
+type selectJsonStatementImpl struct {
+	SelectStatement
+
+	rows SelectStatement
+}
+
+func (s *selectJsonStatementImpl) FROM(tables ...ReadableTable) SelectJsonStatement {
+	s.rows.FROM(tables...)
+	return s
+}
+
+func (s *selectJsonStatementImpl) WHERE(condition BoolExpression) SelectJsonStatement {
+	s.rows.WHERE(condition)
+	return s
+}
+
+func (s *selectJsonStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) SelectJsonStatement {
+	s.rows.ORDER_BY(orderByClauses...)
+	return s
+}
+
+func (s *selectJsonStatementImpl) LIMIT(limit int64) SelectJsonStatement {
+	s.rows.LIMIT(limit)
+	return s
+}
+
+func (s *selectJsonStatementImpl) OFFSET(offset int64) SelectJsonStatement {
+	s.rows.OFFSET(offset)
+	return s
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import "testing"
+
+func TestSELECT_JSON_ARR(t *testing.T) {
+	assertStatementSql(t, SELECT(
+		table1Col1,
+		SELECT_JSON_ARR(table2Col3, table2ColStr).
+			FROM(table2).
+			WHERE(table2ColInt.EQ(table1ColInt)).
+			ORDER_BY(table2Col3).
+			LIMIT(10).AS("table1.table2s"),
+	).FROM(table1), `
+SELECT table1.col1 AS "table1.col1",
+     (
+          SELECT COALESCE(JSON_AGG(ROW_TO_JSON(records)), '[]')
+          FROM (
+                    SELECT table2.col3 AS "table2.col3",
+                         table2.col_str AS "table2.col_str"
+                    FROM db.table2
+                    WHERE table2.col_int = table1.col_int
+                    ORDER BY table2.col3
+                    LIMIT $1
+               ) AS records
+     ) AS "table1.table2s"
+FROM db.table1;
+`, int64(10))
+}
+
+func TestSELECT_JSON_OBJ(t *testing.T) {
+	assertStatementSql(t, SELECT_JSON_OBJ(table3Col1, table3StrCol).
+		FROM(table3).
+		WHERE(table3Col1.EQ(Int(1))), `
+SELECT ROW_TO_JSON(records)
+FROM (
+          SELECT table3.col1 AS "table3.col1",
+               table3.col2 AS "table3.col2"
+          FROM db.table3
+          WHERE table3.col1 = $1
+     ) AS records;
+`, int64(1))
+}
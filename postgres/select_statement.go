@@ -23,15 +23,20 @@ var (
 	ORDER_BY     = jet.ORDER_BY
 	UNBOUNDED    = int64(math.MaxInt64)
 	CURRENT_ROW  = jet.CURRENT_ROW
+	ROWS         = jet.ROWS
+	RANGE        = jet.RANGE
+	GROUPS       = jet.GROUPS
 )
 
-// PRECEDING window frame clause
-func PRECEDING(offset int64) jet.FrameExtent {
+// PRECEDING window frame clause. Offset is an integer, UNBOUNDED, or an expression (for instance interval
+// expression for RANGE frames over date and time columns).
+func PRECEDING(offset interface{}) jet.FrameExtent {
 	return jet.PRECEDING(toJetFrameOffset(offset))
 }
 
-// FOLLOWING window frame clause
-func FOLLOWING(offset int64) jet.FrameExtent {
+// FOLLOWING window frame clause. Offset is an integer, UNBOUNDED, or an expression (for instance interval
+// expression for RANGE frames over date and time columns).
+func FOLLOWING(offset interface{}) jet.FrameExtent {
 	return jet.FOLLOWING(toJetFrameOffset(offset))
 }
 
@@ -45,12 +50,21 @@ type SelectStatement interface {
 	Expression
 
 	DISTINCT(on ...jet.ColumnExpression) SelectStatement
+	// DISTINCT_ON keeps only the first row of each set of rows with equal values of the on expressions, for instance
+	// the latest row per key. ORDER BY, if set, has to start with the same expressions.
+	DISTINCT_ON(on ...jet.ColumnExpression) SelectStatement
 	FROM(tables ...ReadableTable) SelectStatement
 	WHERE(expression BoolExpression) SelectStatement
 	GROUP_BY(groupByClauses ...GroupByClause) SelectStatement
+	// GROUP_BY_NON_AGGREGATED groups by all the projections referencing columns and not containing aggregate or
+	// window functions, followed by additional groupByClauses. Aliased projections are grouped by their expression.
+	GROUP_BY_NON_AGGREGATED(groupByClauses ...GroupByClause) SelectStatement
 	HAVING(boolExpression BoolExpression) SelectStatement
 	WINDOW(name string) windowExpand
 	ORDER_BY(orderByClauses ...OrderByClause) SelectStatement
+	// APPLY appends projections, group by and order by lists of the clauses to the statement, and joins where and
+	// having conditions of the clauses with the statement conditions using AND operator
+	APPLY(clauses ...SelectClauses) SelectStatement
 	LIMIT(limit int64) SelectStatement
 	OFFSET(offset int64) SelectStatement
 	FOR(lock RowLock) SelectStatement
@@ -63,9 +77,12 @@ type SelectStatement interface {
 	EXCEPT_ALL(rhs SelectStatement) setStatement
 
 	AsTable(alias string) SelectTable
+
+	// ToPrepared creates PREPARE/EXECUTE/DEALLOCATE statements for server-side prepared statement name
+	ToPrepared(name string) PreparedStatement
 }
 
-//SELECT creates new SelectStatement with list of projections
+// SELECT creates new SelectStatement with list of projections
 func SELECT(projection Projection, projections ...Projection) SelectStatement {
 	return newSelectStatement(nil, append([]Projection{projection}, projections...))
 }
@@ -107,9 +124,18 @@ type selectStatementImpl struct {
 func (s *selectStatementImpl) DISTINCT(on ...jet.ColumnExpression) SelectStatement {
 	s.Select.Distinct = true
 	s.Select.DistinctOnColumns = on
+	s.OrderBy.DistinctOn = on
 	return s
 }
 
+func (s *selectStatementImpl) DISTINCT_ON(on ...jet.ColumnExpression) SelectStatement {
+	if len(on) == 0 {
+		panic("jet: DISTINCT ON expression list is empty")
+	}
+
+	return s.DISTINCT(on...)
+}
+
 func (s *selectStatementImpl) FROM(tables ...ReadableTable) SelectStatement {
 	s.From.Tables = readableTablesToSerializerList(tables)
 	return s
@@ -122,6 +148,13 @@ func (s *selectStatementImpl) WHERE(condition BoolExpression) SelectStatement {
 
 func (s *selectStatementImpl) GROUP_BY(groupByClauses ...GroupByClause) SelectStatement {
 	s.GroupBy.List = groupByClauses
+	s.GroupBy.NonAggregatedProjections = nil
+	return s
+}
+
+func (s *selectStatementImpl) GROUP_BY_NON_AGGREGATED(groupByClauses ...GroupByClause) SelectStatement {
+	s.GroupBy.List = groupByClauses
+	s.GroupBy.NonAggregatedProjections = s.Select.ProjectionList
 	return s
 }
 
@@ -140,6 +173,13 @@ func (s *selectStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) SelectSt
 	return s
 }
 
+func (s *selectStatementImpl) APPLY(clauses ...SelectClauses) SelectStatement {
+	for _, clause := range clauses {
+		clause.ApplyTo(&s.Select, &s.Where, &s.GroupBy, &s.Having, &s.OrderBy)
+	}
+	return s
+}
+
 func (s *selectStatementImpl) LIMIT(limit int64) SelectStatement {
 	s.Limit.Count = limit
 	return s
@@ -174,10 +214,15 @@ func (w windowExpand) AS(window ...jet.Window) SelectStatement {
 	return w.selectStatement
 }
 
-func toJetFrameOffset(offset int64) jet.Serializer {
+func toJetFrameOffset(offset interface{}) jet.Serializer {
 	if offset == UNBOUNDED {
 		return jet.UNBOUNDED
 	}
+
+	if exp, ok := offset.(jet.Serializer); ok {
+		return exp
+	}
+
 	return jet.FixedLiteral(offset)
 }
 
@@ -188,3 +233,7 @@ func readableTablesToSerializerList(tables []ReadableTable) []jet.Serializer {
 	}
 	return ret
 }
+
+func (s *selectStatementImpl) ToPrepared(name string) PreparedStatement {
+	return PREPARE(name, s)
+}
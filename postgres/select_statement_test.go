@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestInvalidSelect(t *testing.T) {
@@ -35,6 +37,29 @@ FROM db.table1;
 `)
 }
 
+func TestSelectDistinctOn(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt, table1ColFloat, table1ColTime).
+		DISTINCT_ON(table1ColInt, table1ColFloat).
+		FROM(table1).
+		ORDER_BY(table1ColFloat, table1ColInt.ASC(), table1ColTime.DESC()), `
+SELECT DISTINCT ON (table1.col_int, table1.col_float) table1.col_int AS "table1.col_int",
+     table1.col_float AS "table1.col_float",
+     table1.col_time AS "table1.col_time"
+FROM db.table1
+ORDER BY table1.col_float, table1.col_int ASC, table1.col_time DESC;
+`)
+	assertStatementSql(t, SELECT(table1ColInt).DISTINCT_ON(table1ColInt, table1ColFloat).FROM(table1).ORDER_BY(table1ColInt), `
+SELECT DISTINCT ON (table1.col_int, table1.col_float) table1.col_int AS "table1.col_int"
+FROM db.table1
+ORDER BY table1.col_int;
+`)
+	assertStatementSqlErr(t, SELECT(table1ColInt).DISTINCT_ON(table1ColInt).FROM(table1).ORDER_BY(table1ColTime.DESC()),
+		"jet: SELECT DISTINCT ON expressions must match initial ORDER BY expressions")
+	assertStatementSqlErr(t, SELECT(table1ColInt).DISTINCT(table1ColInt).FROM(table1).ORDER_BY(table1ColInt.ADD(Int(1))),
+		"jet: SELECT DISTINCT ON expressions must match initial ORDER BY expressions")
+	assertPanicErr(t, func() { SELECT(table1ColInt).DISTINCT_ON() }, "jet: DISTINCT ON expression list is empty")
+}
+
 func TestSelectFrom(t *testing.T) {
 	assertStatementSql(t, SELECT(table1ColInt, table2ColFloat).FROM(table1), `
 SELECT table1.col_int AS "table1.col_int",
@@ -76,6 +101,52 @@ GROUP BY table2.col_float;
 `)
 }
 
+func TestSelectGroupByNonAggregated(t *testing.T) {
+	assertStatementSql(t,
+		SELECT(
+			table2ColStr,
+			LOWER(table2ColStr).AS("lower_str"),
+			Int(1).AS("one"),
+			SUM(table2ColInt).AS("sum"),
+			COUNT(STAR).AS("count"),
+			table2ColFloat.ADD(MAXf(table2ColFloat)).AS("max"),
+			ROW_NUMBER().OVER(),
+		).FROM(table2).
+			GROUP_BY_NON_AGGREGATED(table2ColBool), `
+SELECT table2.col_str AS "table2.col_str",
+     LOWER(table2.col_str) AS "lower_str",
+     $1 AS "one",
+     SUM(table2.col_int) AS "sum",
+     COUNT(*) AS "count",
+     (table2.col_float + MAX(table2.col_float)) AS "max",
+     ROW_NUMBER() OVER ()
+FROM db.table2
+GROUP BY table2.col_str, LOWER(table2.col_str), table2.col_bool;
+`, int64(1))
+
+	assertStatementSql(t,
+		SELECT(table2ColInt, COUNT(STAR)).FROM(table2).GROUP_BY_NON_AGGREGATED().GROUP_BY(table2ColFloat), `
+SELECT table2.col_int AS "table2.col_int",
+     COUNT(*)
+FROM db.table2
+GROUP BY table2.col_float;
+`)
+
+	// parametrized projections are grouped by referenced columns
+	assertStatementSql(t,
+		SELECT(
+			table2ColInt.ADD(Int(10)).AS("plus_ten"),
+			CONCAT(table2ColStr, String("-"), table2ColStr, table2ColInt).AS("concat"),
+			COUNT(STAR),
+		).FROM(table2).GROUP_BY_NON_AGGREGATED(), `
+SELECT (table2.col_int + $1) AS "plus_ten",
+     CONCAT(table2.col_str, $2::text, table2.col_str, table2.col_int) AS "concat",
+     COUNT(*)
+FROM db.table2
+GROUP BY table2.col_int, table2.col_str;
+`, int64(10), "-")
+}
+
 func TestSelectHaving(t *testing.T) {
 	assertStatementSql(t, SELECT(table3ColInt).FROM(table3).HAVING(table1ColBool.EQ(Bool(true))), `
 SELECT table3.col_int AS "table3.col_int"
@@ -84,6 +155,42 @@ HAVING table1.col_bool = $1::boolean;
 `, true)
 }
 
+func TestSelectNullSafeEq(t *testing.T) {
+	assertSerialize(t, NullSafeEq(table1ColInt, table2ColInt), "(table1.col_int IS NOT DISTINCT FROM table2.col_int)")
+}
+
+func TestSelectApplyClauses(t *testing.T) {
+	base := SelectClauses{
+		Where:   table1ColBool.IS_TRUE(),
+		OrderBy: []OrderByClause{table1ColInt.DESC()},
+	}
+	filters := SelectClauses{
+		Projections: []Projection{table1ColFloat},
+		Where:       table1ColInt.GT(Int(10)),
+		GroupBy:     []GroupByClause{table1ColInt, table1ColFloat},
+		Having:      COUNT(STAR).GT(Int(1)),
+		OrderBy:     []OrderByClause{table1ColFloat.ASC()},
+	}
+
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColInt.IS_NOT_NULL()).
+		APPLY(base.Merge(filters))
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int",
+     table1.col_float AS "table1.col_float"
+FROM db.table1
+WHERE table1.col_int IS NOT NULL AND (table1.col_bool IS TRUE AND (table1.col_int > $1))
+GROUP BY table1.col_int, table1.col_float
+HAVING COUNT(*) > $2
+ORDER BY table1.col_int DESC, table1.col_float ASC;
+`, int64(10), int64(1))
+
+	require.Nil(t, base.Projections)
+	require.Len(t, base.OrderBy, 1)
+}
+
 func TestSelectOrderBy(t *testing.T) {
 	assertStatementSql(t, SELECT(table2ColFloat).FROM(table2).ORDER_BY(table2ColInt.DESC()), `
 SELECT table2.col_float AS "table2.col_float"
@@ -94,6 +201,11 @@ ORDER BY table2.col_int DESC;
 SELECT table2.col_float AS "table2.col_float"
 FROM db.table2
 ORDER BY table2.col_int DESC, table2.col_int ASC;
+`)
+	assertStatementSql(t, SELECT(table2ColFloat).FROM(table2).ORDER_BY(NULLS_LAST(table2ColInt.DESC()), NULLS_FIRST(table2ColFloat)), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int DESC NULLS LAST, table2.col_float NULLS FIRST;
 `)
 }
 
@@ -132,5 +244,33 @@ FOR KEY SHARE NOWAIT;
 SELECT table1.col_bool AS "table1.col_bool"
 FROM db.table1
 FOR NO KEY UPDATE SKIP LOCKED;
+`)
+	assertStatementSql(t, SELECT(table1ColBool).FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+		FOR(UPDATE().OF(table1, table2).NOWAIT()), `
+SELECT table1.col_bool AS "table1.col_bool"
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+FOR UPDATE OF table1, table2 NOWAIT;
+`)
+}
+
+func TestSelectWindowFrame(t *testing.T) {
+	assertStatementSql(t,
+		SELECT(
+			SUMi(table1ColInt).OVER(ROWS(PRECEDING(UNBOUNDED), CURRENT_ROW)),
+			AVG(table1ColInt).OVER(ORDER_BY(table1ColTimestamp).RANGE(PRECEDING(INTERVAL(1, DAY)), CURRENT_ROW)),
+			COUNT(STAR).OVER(ORDER_BY(table1ColInt).GROUPS(PRECEDING(1), FOLLOWING(1)).EXCLUDE_CURRENT_ROW()),
+			MAXi(table1ColInt).OVER(Window("w")),
+			MINi(table1ColInt).OVER(Window("w")),
+		).FROM(
+			table1,
+		).WINDOW("w").AS(PARTITION_BY(table1ColBool).ORDER_BY(table1ColInt).ROWS(PRECEDING(2), FOLLOWING(2))), `
+SELECT SUM(table1.col_int) OVER (ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW),
+     AVG(table1.col_int) OVER (ORDER BY table1.col_timestamp RANGE BETWEEN INTERVAL '1 DAY' PRECEDING AND CURRENT ROW),
+     COUNT(*) OVER (ORDER BY table1.col_int GROUPS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE CURRENT ROW),
+     MAX(table1.col_int) OVER (w),
+     MIN(table1.col_int) OVER (w)
+FROM db.table1
+WINDOW w AS (PARTITION BY table1.col_bool ORDER BY table1.col_int ROWS BETWEEN 2 PRECEDING AND 2 FOLLOWING);
 `)
 }
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// SetConstraintsStatement is interface for SET CONSTRAINTS statement
+type SetConstraintsStatement interface {
+	DEFERRED() Statement
+	IMMEDIATE() Statement
+}
+
+// SET_CONSTRAINTS creates SET CONSTRAINTS statement for the list of constraint names, optionally schema qualified
+// (for instance "public.film_language_id_fkey"). If constraint names are not specified, statement applies to all
+// deferrable constraints (SET CONSTRAINTS ALL). Statement changes constraint checking mode only within the current
+// transaction.
+func SET_CONSTRAINTS(constraints ...string) SetConstraintsStatement {
+	return &setConstraintsStatementImpl{
+		clause: clauseSetConstraints{Constraints: constraints},
+	}
+}
+
+type setConstraintsStatementImpl struct {
+	clause clauseSetConstraints
+}
+
+func (s *setConstraintsStatementImpl) DEFERRED() Statement {
+	s.clause.Mode = "DEFERRED"
+	return newClauseStatement(jet.SetConstraintsStatementType, &s.clause)
+}
+
+func (s *setConstraintsStatementImpl) IMMEDIATE() Statement {
+	s.clause.Mode = "IMMEDIATE"
+	return newClauseStatement(jet.SetConstraintsStatementType, &s.clause)
+}
+
+type clauseSetConstraints struct {
+	Constraints []string
+	Mode        string
+}
+
+func (c *clauseSetConstraints) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	out.NewLine()
+	out.WriteString("SET CONSTRAINTS")
+
+	if len(c.Constraints) == 0 {
+		out.WriteString("ALL")
+	}
+
+	for i, constraint := range c.Constraints {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+
+		if constraint == "" {
+			panic("jet: empty constraint name in SET CONSTRAINTS")
+		}
+
+		for j, name := range strings.Split(constraint, ".") {
+			if j > 0 {
+				out.WriteByte('.')
+			}
+			out.WriteIdentifier(name)
+		}
+	}
+
+	out.WriteString(c.Mode)
+}
+
+type txBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// BeginTxDeferred starts new transaction over db (*sql.DB or *sql.Conn) with all deferrable constraints deferred
+// until transaction commit. Useful for bulk inserts of rows with circular foreign keys, which can not be inserted
+// in any order without violating immediately checked constraints.
+func BeginTxDeferred(ctx context.Context, db txBeginner, opts *sql.TxOptions) (*sql.Tx, error) {
+	tx, err := db.BeginTx(ctx, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := SET_CONSTRAINTS().DEFERRED().ExecContext(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestSetConstraints(t *testing.T) {
+	assertStatementSql(t, SET_CONSTRAINTS().DEFERRED(), `
+SET CONSTRAINTS ALL DEFERRED;
+`)
+	assertStatementSql(t, SET_CONSTRAINTS("film_language_id_fkey").IMMEDIATE(), `
+SET CONSTRAINTS film_language_id_fkey IMMEDIATE;
+`)
+	assertStatementSql(t, SET_CONSTRAINTS("public.film_language_id_fkey", "Actor_Fkey").DEFERRED(), `
+SET CONSTRAINTS public.film_language_id_fkey, "Actor_Fkey" DEFERRED;
+`)
+}
+
+func TestSetConstraintsEmptyName(t *testing.T) {
+	assertStatementSqlErr(t, SET_CONSTRAINTS("").DEFERRED(), "jet: empty constraint name in SET CONSTRAINTS")
+}
@@ -27,6 +27,15 @@ type readableTable interface {
 
 	// Creates a cross join tableName Expression using onCondition.
 	CROSS_JOIN(table ReadableTable) ReadableTable
+
+	// Creates an inner lateral join tableName Expression using onCondition.
+	INNER_JOIN_LATERAL(table ReadableTable, onCondition BoolExpression) ReadableTable
+
+	// Creates a left lateral join tableName Expression using onCondition.
+	LEFT_JOIN_LATERAL(table ReadableTable, onCondition BoolExpression) ReadableTable
+
+	// Creates a cross lateral join tableName Expression.
+	CROSS_JOIN_LATERAL(table ReadableTable) ReadableTable
 }
 
 type writableTable interface {
@@ -81,6 +90,24 @@ func (r readableTableInterfaceImpl) CROSS_JOIN(table ReadableTable) ReadableTabl
 	return newJoinTable(r.parent, table, jet.CrossJoin, nil)
 }
 
+// Creates an inner lateral join tableName Expression using onCondition. Joined sub-query or function table can
+// reference columns of preceding tables, and it should not be constructed with LATERAL.
+func (r readableTableInterfaceImpl) INNER_JOIN_LATERAL(table ReadableTable, onCondition BoolExpression) ReadableTable {
+	return newJoinTable(r.parent, table, jet.InnerJoinLateral, onCondition)
+}
+
+// Creates a left lateral join tableName Expression using onCondition. Joined sub-query or function table can
+// reference columns of preceding tables, and it should not be constructed with LATERAL.
+func (r readableTableInterfaceImpl) LEFT_JOIN_LATERAL(table ReadableTable, onCondition BoolExpression) ReadableTable {
+	return newJoinTable(r.parent, table, jet.LeftJoinLateral, onCondition)
+}
+
+// Creates a cross lateral join tableName Expression. Joined sub-query or function table can reference columns of
+// preceding tables, and it should not be constructed with LATERAL.
+func (r readableTableInterfaceImpl) CROSS_JOIN_LATERAL(table ReadableTable) ReadableTable {
+	return newJoinTable(r.parent, table, jet.CrossJoinLateral, nil)
+}
+
 type writableTableInterfaceImpl struct {
 	parent WritableTable
 }
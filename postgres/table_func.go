@@ -0,0 +1,45 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// FunctionTable is interface for set returning function calls used as FROM items
+type FunctionTable interface {
+	readableTable
+	jet.FunctionTable
+}
+
+// TABLE_FUNC set returning function FROM item constructor, for instance:
+//
+//	elem := StringColumn("elem")
+//	SELECT(elem).FROM(TABLE_FUNC(UNNEST(StringArray("a", "b"))).AS("t", elem))
+func TABLE_FUNC(function Expression) tableFuncImpl {
+	return tableFuncImpl{
+		function: function,
+	}
+}
+
+type tableFuncImpl struct {
+	function Expression
+	lateral  bool
+}
+
+// AS sets function table alias, and optional names of function result columns. Columns are bound to the alias, so
+// they can be used in the rest of the statement.
+func (t tableFuncImpl) AS(alias string, columns ...jet.ColumnExpression) FunctionTable {
+	funcTable := &functionTableImpl{}
+
+	if t.lateral {
+		funcTable.FunctionTable = jet.NewLateralFunctionTable(t.function, alias, columns...)
+	} else {
+		funcTable.FunctionTable = jet.NewFunctionTable(t.function, alias, columns...)
+	}
+
+	funcTable.readableTableInterfaceImpl.parent = funcTable
+
+	return funcTable
+}
+
+type functionTableImpl struct {
+	jet.FunctionTable
+	readableTableInterfaceImpl
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// TIME_BUCKET groups timestamps into buckets of bucketWidth interval. Requires TimescaleDB extension.
+func TIME_BUCKET(bucketWidth IntervalExpression, ts Expression) TimestampzExpression {
+	return TimestampzExp(jet.NewFunc("time_bucket", []Expression{bucketWidth, ts}, nil))
+}
+
+// DATE_BIN bins source timestamp into the interval of stride length, aligned with origin timestamp.
+// Available from PostgreSQL 14.
+func DATE_BIN(stride IntervalExpression, source, origin Expression) TimestampzExpression {
+	return TimestampzExp(jet.NewFunc("date_bin", []Expression{stride, source, origin}, nil))
+}
+
+// BUCKET_BY groups timestamps into buckets of bucketWidth duration, counting from unix epoch. Unlike TIME_BUCKET and
+// DATE_BIN it does not require any extension or specific server version. Bucket width has to be a positive whole
+// number of seconds.
+func BUCKET_BY(ts Expression, bucketWidth time.Duration) TimestampzExpression {
+	seconds := Int(jet.BucketWidthSeconds(bucketWidth))
+	epoch := jet.NewFloatFunc("date_part", FixedLiteral("epoch"), ts)
+
+	return TimestampzExp(jet.NewFunc("to_timestamp", []Expression{FLOOR(epoch.DIV(seconds)).MUL(seconds)}, nil))
+}
+
+// TimeSeries is a table of consecutive timestamps, used to fill the gaps of time series data with empty buckets.
+type TimeSeries interface {
+	ReadableTable
+
+	// Time returns time series column
+	Time() TimestampzExpression
+}
+
+// TIME_SERIES creates new time series table of timestamps from start to stop (inclusive) separated by step interval.
+// Time series is aliased with alias, and its only column has the same name.
+// For instance, to fill empty hourly buckets:
+//
+//	series := TIME_SERIES("bucket", start, stop, INTERVAL(1, HOUR))
+//	SELECT(series.Time(), COUNT(Event.ID)).
+//		FROM(series.LEFT_JOIN(Event, BUCKET_BY(Event.CreatedAt, time.Hour).EQ(series.Time()))).
+//		GROUP_BY(series.Time())
+func TIME_SERIES(alias string, start, stop TimestampzExpression, step IntervalExpression) TimeSeries {
+	newTimeSeries := &timeSeries{
+		Serializer: jet.NewSerializerClauseImpl(&timeSeriesClause{
+			alias: alias,
+			start: start,
+			stop:  stop,
+			step:  step,
+		}),
+		time: TimestampzColumn(alias),
+	}
+
+	newTimeSeries.readableTableInterfaceImpl.parent = newTimeSeries
+
+	return newTimeSeries
+}
+
+type timeSeries struct {
+	readableTableInterfaceImpl
+	jet.Serializer
+
+	time TimestampzExpression
+}
+
+func (t *timeSeries) Time() TimestampzExpression {
+	return t.time
+}
+
+type timeSeriesClause struct {
+	alias       string
+	start, stop TimestampzExpression
+	step        IntervalExpression
+}
+
+func (t *timeSeriesClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	out.WriteString("generate_series(")
+	jet.Serialize(t.start, statementType, out)
+	out.WriteString(", ")
+	jet.Serialize(t.stop, statementType, out)
+	out.WriteString(", ")
+	jet.Serialize(t.step, statementType, out)
+	out.WriteString(") AS")
+	out.WriteIdentifier(t.alias)
+}
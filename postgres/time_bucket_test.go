@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTIME_BUCKET(t *testing.T) {
+	assertSerialize(t, TIME_BUCKET(INTERVAL(15, MINUTE), table1ColTimestampz),
+		"time_bucket(INTERVAL '15 MINUTE', table1.col_timestampz)")
+}
+
+func TestDATE_BIN(t *testing.T) {
+	assertSerialize(t, DATE_BIN(INTERVAL(1, HOUR), table1ColTimestampz, TimestampzT(time.Unix(0, 0).UTC())),
+		"date_bin(INTERVAL '1 HOUR', table1.col_timestampz, $1::timestamp with time zone)", time.Unix(0, 0).UTC())
+}
+
+func TestBUCKET_BY(t *testing.T) {
+	assertSerialize(t, BUCKET_BY(table1ColTimestampz, time.Hour),
+		"to_timestamp(FLOOR(date_part('epoch', table1.col_timestampz) / $1) * $2)", int64(3600), int64(3600))
+
+	assertPanicErr(t, func() { BUCKET_BY(table1ColTimestampz, 1500*time.Millisecond) },
+		"jet: BUCKET_BY bucket width has to be a positive whole number of seconds, got 1.5s")
+	assertPanicErr(t, func() { BUCKET_BY(table1ColTimestampz, 0) },
+		"jet: BUCKET_BY bucket width has to be a positive whole number of seconds, got 0s")
+}
+
+func TestTIME_SERIES(t *testing.T) {
+	series := TIME_SERIES("bucket", table2ColTimestampz, table1ColTimestampz, INTERVAL(1, DAY))
+
+	assertStatementSql(t, SELECT(series.Time(), COUNT(table1ColInt)).
+		FROM(series.LEFT_JOIN(table1, BUCKET_BY(table1ColTimestampz, 24*time.Hour).EQ(series.Time()))).
+		GROUP_BY(series.Time()), `
+SELECT bucket AS "bucket",
+     COUNT(table1.col_int)
+FROM generate_series(table2.col_timestampz, table1.col_timestampz, INTERVAL '1 DAY') AS bucket
+     LEFT JOIN db.table1 ON (to_timestamp(FLOOR(date_part('epoch', table1.col_timestampz) / $1) * $2) = bucket)
+GROUP BY bucket;
+`, int64(86400), int64(86400))
+}
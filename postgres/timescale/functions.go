@@ -0,0 +1,39 @@
+// Package timescale contains TimescaleDB specific functions, to be used with postgres package statements.
+// TIME_BUCKET function is part of the postgres package.
+package timescale
+
+import (
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+// TIME_BUCKET_GAPFILL groups timestamps into buckets of bucketWidth interval, and creates empty buckets for
+// the periods without data. Optional bounds are start and finish of the gap filling range. If bounds are not set,
+// they are inferred from the statement WHERE condition.
+func TIME_BUCKET_GAPFILL(bucketWidth postgres.IntervalExpression, ts postgres.Expression, bounds ...postgres.Expression) postgres.TimestampzExpression {
+	if len(bounds) > 2 {
+		panic("jet: TIME_BUCKET_GAPFILL accepts at most two bounds, start and finish")
+	}
+
+	return postgres.TimestampzExp(jet.NewFunc("time_bucket_gapfill", append([]jet.Expression{bucketWidth, ts}, bounds...), nil))
+}
+
+// LOCF (last observation carried forward) fills the gaps of TIME_BUCKET_GAPFILL with the last non-null value.
+func LOCF(value postgres.Expression) postgres.Expression {
+	return jet.NewFunc("locf", []jet.Expression{value}, nil)
+}
+
+// INTERPOLATE fills the gaps of TIME_BUCKET_GAPFILL with linearly interpolated value.
+func INTERPOLATE(value postgres.Expression) postgres.Expression {
+	return jet.NewFunc("interpolate", []jet.Expression{value}, nil)
+}
+
+// FIRST is aggregate function. Returns the value of the first row, ordered by time expression.
+func FIRST(value, time postgres.Expression) postgres.Expression {
+	return jet.NewFunc("first", []jet.Expression{value, time}, nil)
+}
+
+// LAST is aggregate function. Returns the value of the last row, ordered by time expression.
+func LAST(value, time postgres.Expression) postgres.Expression {
+	return jet.NewFunc("last", []jet.Expression{value, time}, nil)
+}
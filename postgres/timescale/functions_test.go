@@ -0,0 +1,43 @@
+package timescale
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	. "github.com/go-jet/jet/v2/postgres"
+)
+
+var (
+	colTime  = TimestampzColumn("time")
+	colValue = FloatColumn("value")
+	metrics  = NewTable("public", "metrics", "", colTime, colValue)
+)
+
+func TestFIRST_LAST(t *testing.T) {
+	testutils.AssertStatementSql(t, SELECT(FIRST(colValue, colTime), LAST(colValue, colTime)).FROM(metrics), `
+SELECT first(metrics.value, metrics.time),
+     last(metrics.value, metrics.time)
+FROM public.metrics;
+`)
+}
+
+func TestTIME_BUCKET_GAPFILL(t *testing.T) {
+	bucket := TIME_BUCKET_GAPFILL(INTERVAL(5, MINUTE), colTime)
+
+	testutils.AssertStatementSql(t, SELECT(bucket.AS("bucket"), LOCF(AVG(colValue)), INTERPOLATE(MAX(colValue))).
+		FROM(metrics).
+		WHERE(colTime.GT(NOW().SUB(INTERVAL(1, HOUR))).AND(colTime.LT(NOW()))).
+		GROUP_BY(bucket), `
+SELECT time_bucket_gapfill(INTERVAL '5 MINUTE', metrics.time) AS "bucket",
+     locf(AVG(metrics.value)),
+     interpolate(MAX(metrics.value))
+FROM public.metrics
+WHERE (metrics.time > (NOW() - INTERVAL '1 HOUR')) AND (metrics.time < NOW())
+GROUP BY time_bucket_gapfill(INTERVAL '5 MINUTE', metrics.time);
+`)
+
+	testutils.AssertSerialize(t, Dialect, TIME_BUCKET_GAPFILL(INTERVAL(1, DAY), colTime, colTime, NOW()),
+		"time_bucket_gapfill(INTERVAL '1 DAY', metrics.time, metrics.time, NOW())")
+	testutils.AssertPanicErr(t, func() { TIME_BUCKET_GAPFILL(INTERVAL(1, DAY), colTime, NOW(), NOW(), NOW()) },
+		"jet: TIME_BUCKET_GAPFILL accepts at most two bounds, start and finish")
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// SIMILARITY returns a number from 0 to 1, indicating how similar the two strings are, based on the number of
+// shared trigrams. Requires pg_trgm extension.
+func SIMILARITY(lhs, rhs StringExpression) FloatExpression {
+	return jet.NewFloatFunc("similarity", lhs, rhs)
+}
+
+// WORD_SIMILARITY returns a number from 0 to 1, indicating the greatest similarity between the set of trigrams
+// of the first string and any continuous extent of an ordered set of trigrams of the second string.
+// Requires pg_trgm extension.
+func WORD_SIMILARITY(lhs, rhs StringExpression) FloatExpression {
+	return jet.NewFloatFunc("word_similarity", lhs, rhs)
+}
+
+// SIMILAR returns true if strings have similarity greater than the current similarity threshold
+// set by pg_trgm.similarity_threshold (% operator). Requires pg_trgm extension.
+func SIMILAR(lhs, rhs StringExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(lhs, rhs, "%"))
+}
+
+// WORD_SIMILAR returns true if the similarity between the trigram set of the first string and any continuous
+// extent of an ordered trigram set of the second string is greater than the current word similarity threshold
+// set by pg_trgm.word_similarity_threshold (<% operator). Requires pg_trgm extension.
+func WORD_SIMILAR(lhs, rhs StringExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(lhs, rhs, "<%"))
+}
+
+// LEVENSHTEIN returns the Levenshtein distance between two strings. Requires fuzzystrmatch extension.
+func LEVENSHTEIN(source, target StringExpression) IntegerExpression {
+	return IntExp(jet.NewFunc("levenshtein", []Expression{source, target}, nil))
+}
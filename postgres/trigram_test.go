@@ -0,0 +1,30 @@
+package postgres
+
+import "testing"
+
+func TestSIMILARITY(t *testing.T) {
+	assertSerialize(t, SIMILARITY(table2ColStr, String("word")), "similarity(table2.col_str, $1)", "word")
+	assertSerialize(t, WORD_SIMILARITY(String("word"), table2ColStr), "word_similarity($1, table2.col_str)", "word")
+}
+
+func TestSIMILAR(t *testing.T) {
+	assertSerialize(t, SIMILAR(table2ColStr, String("word")), "(table2.col_str % $1)", "word")
+	assertSerialize(t, WORD_SIMILAR(String("word"), table2ColStr), "($1 <% table2.col_str)", "word")
+}
+
+func TestLEVENSHTEIN(t *testing.T) {
+	assertSerialize(t, LEVENSHTEIN(table2ColStr, String("word")).LT(Int(3)),
+		"(levenshtein(table2.col_str, $1) < $2)", "word", int64(3))
+}
+
+func TestFuzzySearch(t *testing.T) {
+	assertDebugStatementSql(t, SELECT(table2ColStr).
+		FROM(table2).
+		WHERE(SIMILAR(table2ColStr, String("word"))).
+		ORDER_BY(SIMILARITY(table2ColStr, String("word")).DESC()), `
+SELECT table2.col_str AS "table2.col_str"
+FROM db.table2
+WHERE table2.col_str % 'word'
+ORDER BY similarity(table2.col_str, 'word') DESC;
+`)
+}
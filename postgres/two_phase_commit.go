@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// PREPARE_TRANSACTION creates PREPARE TRANSACTION statement, which prepares current transaction for two-phase commit
+// under global transaction identifier gid. Once prepared, transaction is no longer associated with the current session,
+// and it can be committed or rolled back from any session using COMMIT_PREPARED or ROLLBACK_PREPARED.
+// Server max_prepared_transactions setting has to be greater than zero.
+func PREPARE_TRANSACTION(gid string) Statement {
+	return newClauseStatement(jet.PrepareTransactionStatementType, &clauseTwoPhase{Command: "PREPARE TRANSACTION", GID: gid})
+}
+
+// COMMIT_PREPARED creates COMMIT PREPARED statement, which commits transaction previously prepared for two-phase commit
+func COMMIT_PREPARED(gid string) Statement {
+	return newClauseStatement(jet.CommitPreparedStatementType, &clauseTwoPhase{Command: "COMMIT PREPARED", GID: gid})
+}
+
+// ROLLBACK_PREPARED creates ROLLBACK PREPARED statement, which rolls back transaction previously prepared for
+// two-phase commit
+func ROLLBACK_PREPARED(gid string) Statement {
+	return newClauseStatement(jet.RollbackPreparedStatementType, &clauseTwoPhase{Command: "ROLLBACK PREPARED", GID: gid})
+}
+
+type clauseTwoPhase struct {
+	Command string
+	GID     string
+}
+
+func (c *clauseTwoPhase) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if c.GID == "" {
+		panic("jet: empty transaction identifier in " + c.Command)
+	}
+
+	out.NewLine()
+	out.WriteString(c.Command)
+	// transaction identifier has to be a string literal, it can not be passed as a parameter
+	out.WriteString("'" + strings.Replace(c.GID, "'", "''", -1) + "'")
+}
+
+// TwoPhaseParticipant is a database taking part in two-phase commit
+type TwoPhaseParticipant struct {
+	// Tx is participant transaction with all the participant changes already executed
+	Tx *sql.Tx
+	// DB is a participant database connection (not Tx), used to commit or roll back prepared transaction
+	DB qrm.Executable
+}
+
+// CommitTwoPhase commits participant transactions atomically using two-phase commit. Each participant transaction
+// is prepared first, with transaction identifier gid suffixed by participant ordinal ("gid_1", "gid_2", ...). If any
+// of the transactions fails to prepare, all the transactions are rolled back. Otherwise, all the prepared transactions
+// are committed, and the first commit error is returned. Transactions left prepared due to commit errors are listed
+// in pg_prepared_xacts, and they have to be committed by the recovery process using COMMIT_PREPARED.
+//
+// Participant Tx is finished by CommitTwoPhase, and it should not be used afterwards.
+func CommitTwoPhase(ctx context.Context, gid string, participants ...TwoPhaseParticipant) error {
+	prepared := 0
+
+	for i, participant := range participants {
+		_, err := PREPARE_TRANSACTION(participantGID(gid, i)).ExecContext(ctx, participant.Tx)
+
+		if err != nil {
+			rollbackTwoPhase(ctx, gid, participants, prepared)
+			return fmt.Errorf("jet: failed to prepare transaction '%s': %w", participantGID(gid, i), err)
+		}
+
+		// transaction is no longer associated with the session, so tx is finished only to release the connection
+		_ = participant.Tx.Rollback()
+		prepared++
+	}
+
+	var commitErr error
+
+	for i, participant := range participants {
+		_, err := COMMIT_PREPARED(participantGID(gid, i)).ExecContext(ctx, participant.DB)
+
+		if err != nil && commitErr == nil {
+			commitErr = fmt.Errorf("jet: failed to commit prepared transaction '%s': %w", participantGID(gid, i), err)
+		}
+	}
+
+	return commitErr
+}
+
+func rollbackTwoPhase(ctx context.Context, gid string, participants []TwoPhaseParticipant, prepared int) {
+	for i, participant := range participants {
+		if i < prepared {
+			_, _ = ROLLBACK_PREPARED(participantGID(gid, i)).ExecContext(ctx, participant.DB)
+		} else {
+			_ = participant.Tx.Rollback()
+		}
+	}
+}
+
+func participantGID(gid string, index int) string {
+	return gid + "_" + strconv.Itoa(index+1)
+}
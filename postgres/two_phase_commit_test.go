@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwoPhaseCommitStatements(t *testing.T) {
+	assertStatementSql(t, PREPARE_TRANSACTION("order_42"), `
+PREPARE TRANSACTION 'order_42';
+`)
+	assertStatementSql(t, COMMIT_PREPARED("order_42"), `
+COMMIT PREPARED 'order_42';
+`)
+	assertStatementSql(t, ROLLBACK_PREPARED("it's"), `
+ROLLBACK PREPARED 'it''s';
+`)
+}
+
+func TestTwoPhaseCommitEmptyGID(t *testing.T) {
+	assertStatementSqlErr(t, PREPARE_TRANSACTION(""), "jet: empty transaction identifier in PREPARE TRANSACTION")
+}
+
+func TestCommitTwoPhaseNoParticipants(t *testing.T) {
+	require.NoError(t, CommitTwoPhase(context.Background(), "order_42"))
+	require.Equal(t, "order_42_2", participantGID("order_42", 1))
+}
@@ -1,7 +1,10 @@
 package postgres
 
 import (
+	"context"
+
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
 )
 
 // UpdateStatement is interface of SQL UPDATE statement
@@ -14,6 +17,9 @@ type UpdateStatement interface {
 	FROM(tables ...ReadableTable) UpdateStatement
 	WHERE(expression BoolExpression) UpdateStatement
 	RETURNING(projections ...Projection) UpdateStatement
+
+	// ToPrepared creates PREPARE/EXECUTE/DEALLOCATE statements for server-side prepared statement name
+	ToPrepared(name string) PreparedStatement
 }
 
 type updateStatementImpl struct {
@@ -79,6 +85,44 @@ func (u *updateStatementImpl) RETURNING(projections ...jet.Projection) UpdateSta
 	return u
 }
 
+// copy returns new update statement with the copy of the statement clauses, so that the clauses of the new statement
+// can be changed without affecting the original statement
+func (u *updateStatementImpl) copy() *updateStatementImpl {
+	update := &updateStatementImpl{
+		Update:    u.Update,
+		Set:       u.Set,
+		SetNew:    u.SetNew,
+		From:      u.From,
+		Where:     u.Where,
+		Returning: u.Returning,
+	}
+	update.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, update,
+		&update.Update,
+		&update.Set,
+		&update.SetNew,
+		&update.From,
+		&update.Where,
+		&update.Returning)
+
+	return update
+}
+
+// UpdateAndReload executes update statement with RETURNING clause listing all the columns of the updated table, and
+// refreshes model (pointer to struct, or pointer to slice for multiple rows update) with the updated row values.
+// Statement RETURNING clause, if any, is ignored.
+func UpdateAndReload(ctx context.Context, db qrm.Queryable, stmt UpdateStatement, model interface{}) error {
+	update, ok := stmt.(*updateStatementImpl)
+
+	if !ok {
+		panic("jet: UpdateAndReload requires UPDATE statement created with table UPDATE method")
+	}
+
+	reload := update.copy()
+	reload.Returning.ProjectionList = update.Update.Projections()
+
+	return reload.QueryContext(ctx, db, model)
+}
+
 type clauseSet struct {
 	Columns []jet.Column
 	Values  []jet.Serializer
@@ -117,3 +161,7 @@ func (s *clauseSet) Serialize(statementType jet.StatementType, out *jet.SQLBuild
 		out.WriteString(")")
 	}
 }
+
+func (u *updateStatementImpl) ToPrepared(name string) PreparedStatement {
+	return PREPARE(name, u)
+}
@@ -1,8 +1,13 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestUpdateWithOneValue(t *testing.T) {
@@ -60,3 +65,32 @@ func TestInvalidInputs(t *testing.T) {
 	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
 	assertStatementSqlErr(t, table1.UPDATE(nil).SET(1), "jet: nil column in columns list")
 }
+
+func TestUpdateAndReload(t *testing.T) {
+	stmt := table3.UPDATE(table3StrCol).
+		SET(String("new")).
+		WHERE(table3ColInt.GT(Int(5))).
+		RETURNING(table3ColInt)
+
+	db := &queryRecorder{}
+	var dest struct{}
+
+	err := UpdateAndReload(context.Background(), db, stmt, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Equal(t, []string{`
+UPDATE db.table3
+SET col2 = $1
+WHERE table3.col_int > $2
+RETURNING table3.col1 AS "table3.col1",
+          table3.col_int AS "table3.col_int",
+          table3.col2 AS "table3.col2";
+`}, db.queries)
+	require.Equal(t, [][]interface{}{{"new", int64(5)}}, db.args)
+
+	assertStatementSql(t, stmt, `
+UPDATE db.table3
+SET col2 = $1
+WHERE table3.col_int > $2
+RETURNING table3.col_int AS "table3.col_int";
+`)
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// INSERTED returns true for the rows inserted by INSERT ON CONFLICT DO UPDATE statement, and false for the
+// rows updated on conflict. It can be used only in RETURNING clause.
+func INSERTED() BoolExpression {
+	return BoolExp(Raw("xmax = 0"))
+}
+
+// upsert is destination of INSERTED projection added by UpsertOrGet
+type upsert struct {
+	Inserted bool
+}
+
+// UpsertOrGet executes single row INSERT ON CONFLICT DO UPDATE statement with RETURNING clause, scans returned row
+// into destination, and reports whether the row was inserted or already existed and was updated.
+// To get existing row unchanged, conflict action can set any of the conflict columns to itself, for instance:
+//
+//	stmt := Link.INSERT(Link.URL, Link.Name).
+//		VALUES("http://www.postgresqltutorial.com", "PostgreSQL Tutorial").
+//		ON_CONFLICT(Link.URL).DO_UPDATE(SET(Link.URL.SET(Link.EXCLUDED.URL))).
+//		RETURNING(Link.AllColumns)
+//
+//	inserted, err := UpsertOrGet(ctx, db, stmt, &dest)
+func UpsertOrGet(ctx context.Context, db qrm.Queryable, stmt InsertStatement, destination interface{}) (inserted bool, err error) {
+	insertStmt, ok := stmt.(*insertStatementImpl)
+
+	if !ok || len(insertStmt.Returning.ProjectionList) == 0 {
+		panic("jet: UpsertOrGet requires insert statement with RETURNING clause")
+	}
+
+	upsertStmt := insertStmt.copy()
+	upsertStmt.RETURNING(append(ProjectionList{INSERTED().AS("upsert.inserted")}, insertStmt.Returning.ProjectionList...)...)
+
+	rows, err := upsertStmt.Rows(ctx, db)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return false, err
+		}
+
+		return false, qrm.ErrNoRows
+	}
+
+	if err := rows.Scan(destination); err != nil {
+		return false, err
+	}
+
+	var result upsert
+
+	if err := rows.Scan(&result); err != nil {
+		return false, err
+	}
+
+	return result.Inserted, rows.Err()
+}
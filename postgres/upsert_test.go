@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestINSERTED(t *testing.T) {
+	assertProjectionSerialize(t, INSERTED().AS("inserted"), `(xmax = 0) AS "inserted"`)
+}
+
+func TestUpsertOrGet(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1ColInt).
+		VALUES(1, 2).
+		ON_CONFLICT(table1Col1).DO_UPDATE(SET(table1Col1.SET(table1Col1))).
+		RETURNING(table1Col1, table1ColInt)
+
+	db := &queryRecorder{}
+
+	var dest struct{ Col1 int64 }
+
+	_, err := UpsertOrGet(context.Background(), db, stmt, &dest)
+	require.True(t, errors.Is(err, sql.ErrConnDone))
+	require.Equal(t, `
+INSERT INTO db.table1 (col1, col_int)
+VALUES ($1, $2)
+ON CONFLICT (col1) DO UPDATE
+       SET col1 = table1.col1
+RETURNING (xmax = 0) AS "upsert.inserted",
+          table1.col1 AS "table1.col1",
+          table1.col_int AS "table1.col_int";
+`, db.queries[0])
+
+	// statement is not modified
+	require.NotContains(t, stmt.DebugSql(), "xmax")
+
+	assertPanicErr(t, func() {
+		UpsertOrGet(context.Background(), db, table1.INSERT(table1Col1).VALUES(1), &dest)
+	}, "jet: UpsertOrGet requires insert statement with RETURNING clause")
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// VectorExpression is representation of pgvector extension vector type
+type VectorExpression interface {
+	jet.Expression
+
+	isVector()
+
+	EQ(rhs VectorExpression) BoolExpression
+	NOT_EQ(rhs VectorExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs VectorExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs VectorExpression) BoolExpression
+
+	// L2_DISTANCE returns euclidean distance between vectors (<-> operator)
+	L2_DISTANCE(rhs VectorExpression) FloatExpression
+	// NEGATIVE_INNER_PRODUCT returns negative inner product of vectors (<#> operator)
+	NEGATIVE_INNER_PRODUCT(rhs VectorExpression) FloatExpression
+	// COSINE_DISTANCE returns cosine distance between vectors (<=> operator)
+	COSINE_DISTANCE(rhs VectorExpression) FloatExpression
+}
+
+type vectorInterfaceImpl struct {
+	parent VectorExpression
+}
+
+func (v *vectorInterfaceImpl) isVector() {}
+
+func (v *vectorInterfaceImpl) EQ(rhs VectorExpression) BoolExpression {
+	return jet.Eq(v.parent, rhs)
+}
+
+func (v *vectorInterfaceImpl) NOT_EQ(rhs VectorExpression) BoolExpression {
+	return jet.NotEq(v.parent, rhs)
+}
+
+func (v *vectorInterfaceImpl) IS_DISTINCT_FROM(rhs VectorExpression) BoolExpression {
+	return jet.IsDistinctFrom(v.parent, rhs)
+}
+
+func (v *vectorInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs VectorExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(v.parent, rhs)
+}
+
+func (v *vectorInterfaceImpl) L2_DISTANCE(rhs VectorExpression) FloatExpression {
+	return FloatExp(jet.NewBinaryOperatorExpression(v.parent, rhs, "<->"))
+}
+
+func (v *vectorInterfaceImpl) NEGATIVE_INNER_PRODUCT(rhs VectorExpression) FloatExpression {
+	return FloatExp(jet.NewBinaryOperatorExpression(v.parent, rhs, "<#>"))
+}
+
+func (v *vectorInterfaceImpl) COSINE_DISTANCE(rhs VectorExpression) FloatExpression {
+	return FloatExp(jet.NewBinaryOperatorExpression(v.parent, rhs, "<=>"))
+}
+
+//---------------------------------------------------//
+
+// Vector creates new vector literal expression from the list of values.
+// For instance, to find five nearest items:
+//
+//	SELECT(Items.AllColumns).
+//		FROM(Items).
+//		ORDER_BY(Items.Embedding.L2_DISTANCE(Vector([]float32{1, 2, 3}))).
+//		LIMIT(5)
+func Vector(values []float32) VectorExpression {
+	elements := make([]string, len(values))
+
+	for i, value := range values {
+		elements[i] = strconv.FormatFloat(float64(value), 'f', -1, 32)
+	}
+
+	return CAST(String("[" + strings.Join(elements, ",") + "]")).AS_VECTOR()
+}
+
+//---------------------------------------------------//
+
+type vectorWrapper struct {
+	vectorInterfaceImpl
+	Expression
+}
+
+func newVectorExpressionWrap(expression Expression) VectorExpression {
+	vectorWrap := &vectorWrapper{Expression: expression}
+	vectorWrap.vectorInterfaceImpl.parent = vectorWrap
+	return vectorWrap
+}
+
+// VectorExp is vector expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as vector expression.
+// Does not add sql cast to generated sql builder output.
+func VectorExp(expression Expression) VectorExpression {
+	return newVectorExpressionWrap(expression)
+}
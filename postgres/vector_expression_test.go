@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"testing"
+)
+
+var itemID = IntegerColumn("id")
+var itemEmbedding = VectorColumn("embedding")
+var items = NewTable("db", "items", "", itemID, itemEmbedding)
+
+func TestVectorExpression(t *testing.T) {
+	assertSerialize(t, Vector([]float32{1, 2.5, -3}), "$1::vector", "[1,2.5,-3]")
+	assertSerialize(t, itemEmbedding.EQ(Vector([]float32{1})), "(items.embedding = $1::vector)", "[1]")
+	assertSerialize(t, itemEmbedding.L2_DISTANCE(Vector([]float32{1, 2})), "(items.embedding <-> $1::vector)", "[1,2]")
+	assertSerialize(t, itemEmbedding.NEGATIVE_INNER_PRODUCT(itemEmbedding), "(items.embedding <#> items.embedding)")
+	assertSerialize(t, itemEmbedding.COSINE_DISTANCE(VectorExp(Raw("'[1,2]'"))), "(items.embedding <=> ('[1,2]'))")
+}
+
+func TestVectorNearestNeighbors(t *testing.T) {
+	assertStatementSql(t, SELECT(itemID).
+		FROM(items).
+		ORDER_BY(itemEmbedding.L2_DISTANCE(Vector([]float32{0.5, 1}))).
+		LIMIT(5), `
+SELECT items.id AS "items.id"
+FROM db.items
+ORDER BY items.embedding <-> $1::vector
+LIMIT $2;
+`, "[0.5,1]", int64(5))
+}
+
+func TestVectorColumn(t *testing.T) {
+	subQuery := SELECT(itemEmbedding).FROM(items).AsTable("sub_query")
+
+	subQueryVectorColumn := itemEmbedding.From(subQuery)
+	assertSerialize(t, subQueryVectorColumn, `sub_query."items.embedding"`)
+	assertProjectionSerialize(t, subQueryVectorColumn, `sub_query."items.embedding" AS "items.embedding"`)
+}
@@ -10,6 +10,10 @@ type CommonTableExpression interface {
 	AS_NOT_MATERIALIZED(statement jet.SerializerStatement) CommonTableExpression
 	// ALIAS is used to create another alias of the CTE, if a CTE needs to appear multiple times in the main query.
 	ALIAS(alias string) SelectTable
+	// SELF returns references to the CTE columns, to be used in recursive CTE definition, for instance:
+	//
+	//	tree.SELF().Integer("depth").ADD(Int(1))
+	SELF() jet.CTESelf
 
 	internalCTE() *jet.CommonTableExpression
 }
@@ -63,6 +67,11 @@ func (c *commonTableExpression) ALIAS(name string) SelectTable {
 	return newSelectTable(c, name)
 }
 
+// SELF returns references to the CTE columns, to be used in recursive CTE definition
+func (c *commonTableExpression) SELF() jet.CTESelf {
+	return jet.NewCTESelf(c)
+}
+
 func toInternalCTE(ctes []CommonTableExpression) []*jet.CommonTableExpression {
 	var ret []*jet.CommonTableExpression
 
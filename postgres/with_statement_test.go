@@ -0,0 +1,58 @@
+package postgres
+
+import "testing"
+
+func TestWithRecursiveSelf(t *testing.T) {
+	employeeID := IntegerColumn("id")
+	managerID := IntegerColumn("manager_id")
+	employees := NewTable("db", "employees", "", employeeID, managerID)
+
+	tree := CTE("tree")
+	self := tree.SELF()
+
+	stmt := WITH_RECURSIVE(
+		tree.AS(
+			SELECT(
+				employeeID.AS("id"),
+				Int(0).AS("depth"),
+			).FROM(
+				employees,
+			).WHERE(
+				managerID.IS_NULL(),
+			).UNION_ALL(
+				SELECT(
+					employeeID,
+					self.Integer("depth").ADD(Int(1)),
+				).FROM(
+					employees.INNER_JOIN(tree, managerID.EQ(self.Integer("id"))),
+				),
+			),
+		),
+	)(
+		SELECT(self.Integer("id"), self.Integer("depth")).
+			FROM(tree).
+			ORDER_BY(self.Integer("depth")),
+	)
+
+	assertStatementSql(t, stmt, `
+WITH RECURSIVE tree AS (
+     (
+          SELECT employees.id AS "id",
+               $1 AS "depth"
+          FROM db.employees
+          WHERE employees.manager_id IS NULL
+     )
+     UNION ALL
+     (
+          SELECT employees.id AS "employees.id",
+               tree.depth + $2
+          FROM db.employees
+               INNER JOIN tree ON (employees.manager_id = tree.id)
+     )
+)
+SELECT tree.id AS "id",
+     tree.depth AS "depth"
+FROM tree
+ORDER BY tree.depth;
+`, int64(0), int64(1))
+}
@@ -13,3 +13,14 @@ type DB interface {
 	Query(query string, args ...interface{}) (*sql.Rows, error)
 	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
 }
+
+// Queryable is the minimal database interface needed to execute queries returning rows. Besides *sql.DB, *sql.Tx
+// and *sql.Conn, it is implemented by sqlx types, transaction wrappers and mocks.
+type Queryable interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// Executable is the minimal database interface needed to execute statements without returning any rows
+type Executable interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
@@ -0,0 +1,244 @@
+package qrm
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// RowScanner scans current result set row into destination, without reflection. Destination is always of the type
+// scanner is registered for. Slice destination scanners append one element per row, while struct destination
+// scanners are called only for the first row.
+type RowScanner func(rows *sql.Rows, destPtr interface{}) error
+
+// RowScannerFactory returns row scanner for the result set columns (in the form "table.column"), or false if some
+// of the columns can not be scanned by the scanner. In that case query result is mapped using reflection.
+type RowScannerFactory func(columns []string) (RowScanner, bool)
+
+var rowScanners = struct {
+	sync.RWMutex
+	factories map[reflect.Type]RowScannerFactory
+}{
+	factories: map[reflect.Type]RowScannerFactory{},
+}
+
+// RegisterRowScanner registers row scanner factory for the destination type, usually generated ahead of time.
+// Destination has to be a pointer to slice or pointer to struct, for instance &[]model.Actor{} or &model.Actor{}.
+// Destinations of registered types are scanned without reflection, one element per row, so registered types
+// should be flat structs without nested destinations.
+func RegisterRowScanner(destPtr interface{}, factory RowScannerFactory) {
+	destType := reflect.TypeOf(destPtr)
+
+	if destType == nil || destType.Kind() != reflect.Ptr {
+		panic("jet: row scanner destination has to be a pointer to slice or pointer to struct")
+	}
+
+	rowScanners.Lock()
+	defer rowScanners.Unlock()
+
+	if factory == nil {
+		delete(rowScanners.factories, destType)
+		return
+	}
+
+	rowScanners.factories[destType] = factory
+}
+
+func getRowScannerFactory(destType reflect.Type) RowScannerFactory {
+	rowScanners.RLock()
+	defer rowScanners.RUnlock()
+
+	return rowScanners.factories[destType]
+}
+
+// ModelScanner is implemented by generated models with scanner option turned on, to scan result set rows
+// without reflection.
+type ModelScanner interface {
+	// ScanColumns returns result set columns, in the form "table.column", ScanRow expects
+	ScanColumns() []string
+	// ScanRow scans current result set row into the model
+	ScanRow(rows *sql.Rows) error
+}
+
+var modelScannerType = reflect.TypeOf((*ModelScanner)(nil)).Elem()
+
+// fastRowScanner returns row scanner used to scan the result set without reflection, if destination type has
+// registered row scanner, if destination is a slice of primitive types and result set has a single column, or if
+// useModelScanner is set and destination model implements ModelScanner interface.
+func fastRowScanner(rows *sql.Rows, destPtr interface{}, useModelScanner bool) (RowScanner, bool) {
+	factory := getRowScannerFactory(reflect.TypeOf(destPtr))
+	primitiveScanner := primitiveSliceScanner(destPtr)
+
+	if useModelScanner && factory == nil {
+		factory = modelScannerFactory(destPtr)
+	}
+
+	if factory == nil && primitiveScanner == nil {
+		return nil, false
+	}
+
+	columns, err := rows.Columns()
+
+	if err != nil {
+		return nil, false
+	}
+
+	if factory != nil {
+		return factory(columns)
+	}
+
+	return primitiveScanner, len(columns) == 1
+}
+
+// modelScannerFactory returns row scanner factory for pointer to model, or pointer to slice of models, implementing
+// ModelScanner interface. Nil is returned for all the other destinations.
+func modelScannerFactory(destPtr interface{}) RowScannerFactory {
+	destType := reflect.TypeOf(destPtr)
+
+	if model, ok := destPtr.(ModelScanner); ok && destType.Elem().Kind() == reflect.Struct {
+		return func(columns []string) (RowScanner, bool) {
+			return func(rows *sql.Rows, destPtr interface{}) error {
+				return destPtr.(ModelScanner).ScanRow(rows)
+			}, scanColumnsMatch(model.ScanColumns(), columns)
+		}
+	}
+
+	if destType.Elem().Kind() != reflect.Slice || destType.Elem().Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	elemType := destType.Elem().Elem()
+
+	if !reflect.PtrTo(elemType).Implements(modelScannerType) {
+		return nil
+	}
+
+	return func(columns []string) (RowScanner, bool) {
+		model := reflect.New(elemType).Interface().(ModelScanner)
+
+		return func(rows *sql.Rows, destPtr interface{}) error {
+			elemPtr := reflect.New(elemType)
+
+			if err := elemPtr.Interface().(ModelScanner).ScanRow(rows); err != nil {
+				return err
+			}
+
+			slice := reflect.ValueOf(destPtr).Elem()
+			slice.Set(reflect.Append(slice, elemPtr.Elem()))
+
+			return nil
+		}, scanColumnsMatch(model.ScanColumns(), columns)
+	}
+}
+
+func scanColumnsMatch(scanColumns, columns []string) bool {
+	if len(scanColumns) != len(columns) {
+		return false
+	}
+
+	for i := range columns {
+		if !strings.EqualFold(scanColumns[i], columns[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func scanRowsFast(rows *sql.Rows, scanner RowScanner, destPtr interface{},
+	limiter *resultLimiter) (rowsProcessed int64, err error) {
+
+	structDest := reflect.TypeOf(destPtr).Elem().Kind() == reflect.Struct
+
+	for rows.Next() {
+		rowsProcessed++
+
+		if err = limiter.add(nil); err != nil {
+			return rowsProcessed, err
+		}
+
+		if structDest && rowsProcessed > 1 {
+			continue
+		}
+
+		if err = scanner(rows, destPtr); err != nil {
+			return rowsProcessed, err
+		}
+	}
+
+	if err = rows.Close(); err != nil {
+		return rowsProcessed, err
+	}
+
+	return rowsProcessed, rows.Err()
+}
+
+// primitiveSliceScanner returns row scanner for the most common primitive slice destinations. As with reflection
+// based mapping, NULL values are not appended to the destination.
+func primitiveSliceScanner(destPtr interface{}) RowScanner {
+	switch destPtr.(type) {
+	case *[]int64:
+		return func(rows *sql.Rows, destPtr interface{}) error {
+			var value sql.NullInt64
+			if err := rows.Scan(&value); err != nil || !value.Valid {
+				return err
+			}
+			dest := destPtr.(*[]int64)
+			*dest = append(*dest, value.Int64)
+			return nil
+		}
+	case *[]int32:
+		return func(rows *sql.Rows, destPtr interface{}) error {
+			var value sql.NullInt32
+			if err := rows.Scan(&value); err != nil || !value.Valid {
+				return err
+			}
+			dest := destPtr.(*[]int32)
+			*dest = append(*dest, value.Int32)
+			return nil
+		}
+	case *[]int:
+		return func(rows *sql.Rows, destPtr interface{}) error {
+			var value sql.NullInt64
+			if err := rows.Scan(&value); err != nil || !value.Valid {
+				return err
+			}
+			dest := destPtr.(*[]int)
+			*dest = append(*dest, int(value.Int64))
+			return nil
+		}
+	case *[]float64:
+		return func(rows *sql.Rows, destPtr interface{}) error {
+			var value sql.NullFloat64
+			if err := rows.Scan(&value); err != nil || !value.Valid {
+				return err
+			}
+			dest := destPtr.(*[]float64)
+			*dest = append(*dest, value.Float64)
+			return nil
+		}
+	case *[]string:
+		return func(rows *sql.Rows, destPtr interface{}) error {
+			var value sql.NullString
+			if err := rows.Scan(&value); err != nil || !value.Valid {
+				return err
+			}
+			dest := destPtr.(*[]string)
+			*dest = append(*dest, value.String)
+			return nil
+		}
+	case *[]bool:
+		return func(rows *sql.Rows, destPtr interface{}) error {
+			var value sql.NullBool
+			if err := rows.Scan(&value); err != nil || !value.Valid {
+				return err
+			}
+			dest := destPtr.(*[]bool)
+			*dest = append(*dest, value.Bool)
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDriver returns the same result set, columns and rows, for every query
+type fakeDriver struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) { return &fakeConn{driver: d}, nil }
+
+type fakeConn struct{ driver *fakeDriver }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{driver: c.driver}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, driver.ErrSkip }
+
+type fakeStmt struct{ driver *fakeDriver }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{driver: s.driver}, nil
+}
+
+type fakeRows struct {
+	driver *fakeDriver
+	index  int
+}
+
+func (r *fakeRows) Columns() []string { return r.driver.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.driver.rows) {
+		return io.EOF
+	}
+	copy(dest, r.driver.rows[r.index])
+	r.index++
+	return nil
+}
+
+var fakeDriverInstance = &fakeDriver{}
+
+func init() {
+	sql.Register("qrm_fake", fakeDriverInstance)
+}
+
+func openFakeDB(t *testing.T, columns []string, rows ...[]driver.Value) *sql.DB {
+	fakeDriverInstance.columns = columns
+	fakeDriverInstance.rows = rows
+
+	db, err := sql.Open("qrm_fake", "")
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestQueryPrimitiveSliceFastPath(t *testing.T) {
+	db := openFakeDB(t, []string{"actor.actor_id"}, []driver.Value{int64(1)}, []driver.Value{nil}, []driver.Value{int64(3)})
+	defer db.Close()
+
+	var ids []int32
+	rowsProcessed, err := Query(context.Background(), db, "SELECT", nil, &ids)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), rowsProcessed)
+	require.Equal(t, []int32{1, 3}, ids)
+}
+
+type fastActor struct {
+	ActorID   int32
+	FirstName string
+}
+
+func TestQueryRegisteredRowScanner(t *testing.T) {
+	scanActor := func(rows *sql.Rows, actor *fastActor) error {
+		return rows.Scan(&actor.ActorID, &actor.FirstName)
+	}
+
+	var factoryColumns []string
+
+	RegisterRowScanner(&[]fastActor{}, func(columns []string) (RowScanner, bool) {
+		factoryColumns = columns
+		if len(columns) != 2 {
+			return nil, false
+		}
+		return func(rows *sql.Rows, destPtr interface{}) error {
+			var actor fastActor
+			if err := scanActor(rows, &actor); err != nil {
+				return err
+			}
+			dest := destPtr.(*[]fastActor)
+			*dest = append(*dest, actor)
+			return nil
+		}, true
+	})
+	defer RegisterRowScanner(&[]fastActor{}, nil)
+
+	RegisterRowScanner(&fastActor{}, func(columns []string) (RowScanner, bool) {
+		return func(rows *sql.Rows, destPtr interface{}) error {
+			return scanActor(rows, destPtr.(*fastActor))
+		}, true
+	})
+	defer RegisterRowScanner(&fastActor{}, nil)
+
+	t.Run("slice", func(t *testing.T) {
+		db := openFakeDB(t, []string{"fastActor.actor_id", "fastActor.first_name"},
+			[]driver.Value{int64(1), "John"}, []driver.Value{int64(1), "Johnny"})
+		defer db.Close()
+
+		var dest []fastActor
+		_, err := Query(context.Background(), db, "SELECT", nil, &dest)
+		require.NoError(t, err)
+		require.Equal(t, []string{"fastActor.actor_id", "fastActor.first_name"}, factoryColumns)
+		// no grouping by primary key, one element per row
+		require.Equal(t, []fastActor{{1, "John"}, {1, "Johnny"}}, dest)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		db := openFakeDB(t, []string{"fastActor.actor_id", "fastActor.first_name"},
+			[]driver.Value{int64(2), "Jane"}, []driver.Value{int64(3), "Joe"})
+		defer db.Close()
+
+		var dest fastActor
+		rowsProcessed, err := Query(context.Background(), db, "SELECT", nil, &dest)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), rowsProcessed)
+		require.Equal(t, fastActor{2, "Jane"}, dest)
+	})
+
+	t.Run("struct no rows", func(t *testing.T) {
+		db := openFakeDB(t, []string{"fastActor.actor_id", "fastActor.first_name"})
+		defer db.Close()
+
+		var dest fastActor
+		_, err := Query(context.Background(), db, "SELECT", nil, &dest)
+		require.Equal(t, ErrNoRows, err)
+	})
+
+	t.Run("fallback to reflection", func(t *testing.T) {
+		db := openFakeDB(t, []string{"fastActor.actor_id"}, []driver.Value{int64(4)})
+		defer db.Close()
+
+		var dest []fastActor
+		_, err := Query(context.Background(), db, "SELECT", nil, &dest)
+		require.NoError(t, err)
+		require.Equal(t, []fastActor{{ActorID: 4}}, dest)
+	})
+}
+
+type scannerActor struct {
+	ActorID   int32
+	FirstName string
+}
+
+var scanRowCalls int
+
+func (m *scannerActor) ScanColumns() []string {
+	return []string{"scannerActor.actor_id", "scannerActor.first_name"}
+}
+
+func (m *scannerActor) ScanRow(rows *sql.Rows) error {
+	scanRowCalls++
+	return rows.Scan(&m.ActorID, &m.FirstName)
+}
+
+func TestQueryFastModelScanner(t *testing.T) {
+	scanRowCalls = 0
+
+	db := openFakeDB(t, []string{"scannerActor.actor_id", "scannerActor.first_name"},
+		[]driver.Value{int64(1), "John"}, []driver.Value{int64(2), "Jane"})
+	defer db.Close()
+
+	var dest []scannerActor
+	rowsProcessed, err := QueryFast(context.Background(), db, "SELECT", nil, &dest)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rowsProcessed)
+	require.Equal(t, []scannerActor{{1, "John"}, {2, "Jane"}}, dest)
+	require.Equal(t, 2, scanRowCalls)
+
+	var actor scannerActor
+	_, err = QueryFast(context.Background(), db, "SELECT", nil, &actor)
+	require.NoError(t, err)
+	require.Equal(t, scannerActor{1, "John"}, actor)
+	require.Equal(t, 3, scanRowCalls)
+
+	// Query does not use model scanners
+	dest = nil
+	_, err = Query(context.Background(), db, "SELECT", nil, &dest)
+	require.NoError(t, err)
+	require.Equal(t, []scannerActor{{1, "John"}, {2, "Jane"}}, dest)
+	require.Equal(t, 3, scanRowCalls)
+}
+
+func TestQueryFastModelScannerColumnsMismatch(t *testing.T) {
+	scanRowCalls = 0
+
+	db := openFakeDB(t, []string{"scannerActor.first_name", "scannerActor.actor_id"}, []driver.Value{"John", int64(1)})
+	defer db.Close()
+
+	var dest []scannerActor
+	_, err := QueryFast(context.Background(), db, "SELECT", nil, &dest)
+	require.NoError(t, err)
+	require.Equal(t, []scannerActor{{1, "John"}}, dest)
+	require.Equal(t, 0, scanRowCalls)
+}
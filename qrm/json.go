@@ -0,0 +1,137 @@
+package qrm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// isJSONField returns true if field value should be unmarshalled from json column (`sql:"json"` tag).
+func isJSONField(field reflect.StructField) bool {
+	return field.Tag.Get("sql") == "json"
+}
+
+// unmarshalJSON unmarshal json column value into destination. Json object keys are matched with destination struct
+// fields the same way query result columns are, so object keys like 'film_id' are assigned to 'FilmID' field.
+func unmarshalJSON(value interface{}, destination reflect.Value) error {
+	var data []byte
+
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("json column value has to be string or []byte, got %T", value)
+	}
+
+	var jsonValue interface{}
+
+	if err := json.Unmarshal(data, &jsonValue); err != nil {
+		return err
+	}
+
+	normalizedData, err := json.Marshal(normalizeJSON(jsonValue, destination.Type()))
+
+	if err != nil {
+		return err
+	}
+
+	initializeValueIfNilPtr(destination)
+
+	return json.Unmarshal(normalizedData, destination.Addr().Interface())
+}
+
+// normalizeJSON renames object keys to destination struct field names, and converts database timestamp formats
+// to RFC3339, so that json value can be unmarshalled using standard library.
+func normalizeJSON(jsonValue interface{}, destType reflect.Type) interface{} {
+	destType = indirectType(destType)
+
+	switch value := jsonValue.(type) {
+	case map[string]interface{}:
+		if destType.Kind() != reflect.Struct {
+			return value
+		}
+
+		ret := map[string]interface{}{}
+
+		for key, fieldValue := range value {
+			field, jsonKey, ok := findJSONField(destType, key)
+
+			if !ok {
+				continue
+			}
+
+			ret[jsonKey] = normalizeJSON(fieldValue, field.Type)
+		}
+
+		return ret
+
+	case []interface{}:
+		if destType.Kind() != reflect.Slice {
+			return value
+		}
+
+		for i := range value {
+			value[i] = normalizeJSON(value[i], destType.Elem())
+		}
+
+		return value
+
+	case string:
+		if destType != timeType {
+			return value
+		}
+
+		for _, layout := range jsonTimeLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t.Format(time.RFC3339Nano)
+			}
+		}
+
+		return value
+	}
+
+	return jsonValue
+}
+
+var jsonTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// findJSONField returns destination struct field matching json object key, and the key standard library expects
+func findJSONField(structType reflect.Type, key string) (field reflect.StructField, jsonKey string, found bool) {
+	keyParts := strings.Split(key, ".") // projection aliases are in the form of 'table.column'
+	commonKey := toCommonIdentifier(keyParts[len(keyParts)-1])
+
+	for i := 0; i < structType.NumField(); i++ {
+		field = structType.Field(i)
+
+		if field.PkgPath != "" { // private field
+			continue
+		}
+
+		fieldName, jsonKey := field.Name, field.Name
+
+		if jsonTag := strings.Split(field.Tag.Get("json"), ",")[0]; jsonTag == "-" {
+			continue
+		} else if jsonTag != "" {
+			fieldName, jsonKey = jsonTag, jsonTag
+		} else if aliasTag := field.Tag.Get("alias"); aliasTag != "" {
+			aliasParts := strings.Split(aliasTag, ".")
+			fieldName = aliasParts[len(aliasParts)-1]
+		}
+
+		if toCommonIdentifier(fieldName) == commonKey {
+			return field, jsonKey, true
+		}
+	}
+
+	return reflect.StructField{}, "", false
+}
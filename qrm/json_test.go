@@ -0,0 +1,55 @@
+package qrm
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type jsonFilm struct {
+	FilmID     int32
+	Title      string
+	LastUpdate time.Time
+	Rating     *string `json:"film_rating"`
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var films []jsonFilm
+
+	err := unmarshalJSON([]byte(`[
+		{"film_id": 1, "title": "Academy Dinosaur", "last_update": "2013-05-26T14:50:58.951", "film_rating": "PG"},
+		{"film_id": 2, "title": "Ace Goldfinger", "last_update": "2013-05-26T14:50:58.951", "unknown_column": 1}
+	]`), reflect.ValueOf(&films).Elem())
+
+	require.NoError(t, err)
+	require.Len(t, films, 2)
+	require.Equal(t, int32(1), films[0].FilmID)
+	require.Equal(t, "Academy Dinosaur", films[0].Title)
+	require.Equal(t, time.Date(2013, 5, 26, 14, 50, 58, 951000000, time.UTC), films[0].LastUpdate)
+	require.Equal(t, "PG", *films[0].Rating)
+	require.Equal(t, int32(2), films[1].FilmID)
+	require.Nil(t, films[1].Rating)
+}
+
+func TestUnmarshalJSONPtr(t *testing.T) {
+	var film *jsonFilm
+
+	err := unmarshalJSON(`{"film_id": 3, "title": "Adaptation Holes"}`, reflect.ValueOf(&film).Elem())
+
+	require.NoError(t, err)
+	require.Equal(t, "Adaptation Holes", film.Title)
+
+	err = unmarshalJSON(int64(11), reflect.ValueOf(&film).Elem())
+	require.EqualError(t, err, "json column value has to be string or []byte, got int64")
+}
+
+func TestUnmarshalJSONProjectionAliases(t *testing.T) {
+	var films []jsonFilm
+
+	err := unmarshalJSON(`[{"film.film_id": 4, "film.title": "Affair Prejudice"}]`, reflect.ValueOf(&films).Elem())
+
+	require.NoError(t, err)
+	require.Equal(t, []jsonFilm{{FilmID: 4, Title: "Affair Prejudice"}}, films)
+}
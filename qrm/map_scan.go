@@ -0,0 +1,100 @@
+package qrm
+
+import (
+	"database/sql"
+	"strings"
+)
+
+// isMapDestination returns true if destination is pointer to map or pointer to slice of maps
+func isMapDestination(destPtr interface{}) bool {
+	switch destPtr.(type) {
+	case *map[string]interface{}, *[]map[string]interface{}:
+		return true
+	}
+
+	return false
+}
+
+// mapDestinationKeys returns map key for each result set column. Column aliases in `table.column` format are
+// keyed by the column name only, unless the same column name appears in more than one table.
+func mapDestinationKeys(aliases []string) []string {
+	columnNameCount := make(map[string]int, len(aliases))
+
+	for _, alias := range aliases {
+		columnNameCount[mapColumnName(alias)]++
+	}
+
+	keys := make([]string, len(aliases))
+
+	for i, alias := range aliases {
+		columnName := mapColumnName(alias)
+
+		if columnNameCount[columnName] > 1 {
+			keys[i] = alias
+		} else {
+			keys[i] = columnName
+		}
+	}
+
+	return keys
+}
+
+func mapColumnName(alias string) string {
+	if index := strings.LastIndex(alias, "."); index >= 0 {
+		return alias[index+1:]
+	}
+
+	return alias
+}
+
+// scanRowsToMaps scans each row of the result set into a map keyed by column name, and appends it to the slice
+// destination, or sets the first row as map destination. Byte slice values are converted to string.
+func scanRowsToMaps(rows *sql.Rows, destPtr interface{}, limiter *resultLimiter) (rowsProcessed int64, err error) {
+	aliases, err := rows.Columns()
+
+	if err != nil {
+		return 0, err
+	}
+
+	keys := mapDestinationKeys(aliases)
+	row := createScanSlice(len(aliases))
+
+	for rows.Next() {
+		if err = rows.Scan(row...); err != nil {
+			return rowsProcessed, err
+		}
+
+		rowsProcessed++
+
+		if err = limiter.add(row); err != nil {
+			return rowsProcessed, err
+		}
+
+		rowMap := make(map[string]interface{}, len(keys))
+
+		for i, key := range keys {
+			value := *(row[i].(*interface{}))
+
+			if bytes, ok := value.([]byte); ok {
+				value = string(bytes)
+			}
+
+			rowMap[key] = value
+		}
+
+		switch dest := destPtr.(type) {
+		case *[]map[string]interface{}:
+			*dest = append(*dest, rowMap)
+		case *map[string]interface{}:
+			if rowsProcessed == 1 {
+				*dest = rowMap
+			}
+		}
+	}
+
+	if err = rows.Close(); err != nil {
+		return rowsProcessed, err
+	}
+
+	return rowsProcessed, rows.Err()
+}
@@ -0,0 +1,119 @@
+package qrm
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// maxMappingPlans is the maximum number of cached mapping plans. Cache is reset when the limit is reached, so
+// that services constructing statements with a large number of distinct projections do not grow it indefinitely.
+const maxMappingPlans = 1000
+
+// mappingPlan contains destination types mapping information for a single result set projection (list of column
+// aliases). Mapping information depends only on destination types and projection, so it is computed once
+// and shared between all the queries with the same projection.
+type mappingPlan struct {
+	commonIdentToColumnIndex map[string]int
+
+	mutex         sync.RWMutex
+	typeInfos     map[mappingPlanKey]typeInfo
+	groupKeyInfos map[mappingPlanKey]groupKeyInfo
+}
+
+// mappingPlanKey identifies destination type mapping, which also depends on the parent struct field
+// (its alias and primary key tags).
+type mappingPlanKey struct {
+	structType  reflect.Type
+	parentField string
+}
+
+var mappingPlans = struct {
+	sync.Mutex
+	plans map[string]*mappingPlan
+}{
+	plans: map[string]*mappingPlan{},
+}
+
+// getMappingPlan returns cached mapping plan for the result set column aliases, or creates a new one
+func getMappingPlan(aliases []string) *mappingPlan {
+	projection := strings.Join(aliases, ",")
+
+	mappingPlans.Lock()
+	defer mappingPlans.Unlock()
+
+	if plan, ok := mappingPlans.plans[projection]; ok {
+		return plan
+	}
+
+	if len(mappingPlans.plans) >= maxMappingPlans {
+		mappingPlans.plans = map[string]*mappingPlan{}
+	}
+
+	plan := &mappingPlan{
+		commonIdentToColumnIndex: newCommonIdentToColumnIndex(aliases),
+		typeInfos:                map[mappingPlanKey]typeInfo{},
+		groupKeyInfos:            map[mappingPlanKey]groupKeyInfo{},
+	}
+
+	mappingPlans.plans[projection] = plan
+
+	return plan
+}
+
+func newCommonIdentToColumnIndex(aliases []string) map[string]int {
+	commonIdentToColumnIndex := map[string]int{}
+
+	for i, alias := range aliases {
+		names := strings.SplitN(alias, ".", 2)
+		commonIdentifier := toCommonIdentifier(names[0])
+
+		if len(names) > 1 {
+			commonIdentifier = concat(commonIdentifier, ".", toCommonIdentifier(names[1]))
+		}
+
+		commonIdentToColumnIndex[commonIdentifier] = i
+	}
+
+	return commonIdentToColumnIndex
+}
+
+func newMappingPlanKey(structType reflect.Type, parentField *reflect.StructField) mappingPlanKey {
+	key := mappingPlanKey{structType: structType}
+
+	if parentField != nil {
+		key.parentField = concat(parentField.Type.String(), string(parentField.Tag))
+	}
+
+	return key
+}
+
+func (p *mappingPlan) getTypeInfo(key mappingPlanKey) (typeInfo, bool) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	info, ok := p.typeInfos[key]
+	return info, ok
+}
+
+func (p *mappingPlan) setTypeInfo(key mappingPlanKey, info typeInfo) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.typeInfos[key] = info
+}
+
+func (p *mappingPlan) getGroupKeyInfo(key mappingPlanKey) (groupKeyInfo, bool) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	info, ok := p.groupKeyInfos[key]
+	return info, ok
+}
+
+func (p *mappingPlan) setGroupKeyInfo(key mappingPlanKey, info groupKeyInfo) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.groupKeyInfos[key] = info
+}
@@ -0,0 +1,53 @@
+package qrm
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type planActor struct {
+	ActorID   int32 `sql:"primary_key"`
+	FirstName string
+}
+
+func TestMappingPlanSharedBetweenQueries(t *testing.T) {
+	columns := []string{"planActor.actor_id", "planActor.first_name"}
+
+	db := openFakeDB(t, columns, []driver.Value{int64(1), "John"}, []driver.Value{int64(2), "Jane"})
+	defer db.Close()
+
+	var dest []planActor
+	_, err := Query(context.Background(), db, "SELECT", nil, &dest)
+	require.NoError(t, err)
+	require.Equal(t, []planActor{{1, "John"}, {2, "Jane"}}, dest)
+
+	plan := getMappingPlan(columns)
+	planKey := newMappingPlanKey(reflect.TypeOf(planActor{}), nil)
+
+	typeInfo, ok := plan.getTypeInfo(planKey)
+	require.True(t, ok)
+	require.Equal(t, 0, typeInfo.fieldMappings[0].rowIndex)
+	require.Equal(t, 1, typeInfo.fieldMappings[1].rowIndex)
+
+	groupKeyInfo, ok := plan.getGroupKeyInfo(planKey)
+	require.True(t, ok)
+	require.Equal(t, []int{0}, groupKeyInfo.indexes)
+
+	var dest2 []planActor
+	_, err = Query(context.Background(), db, "SELECT", nil, &dest2)
+	require.NoError(t, err)
+	require.Equal(t, dest, dest2)
+	require.True(t, getMappingPlan(columns) == plan)
+}
+
+func TestMappingPlanCacheLimit(t *testing.T) {
+	for i := 0; i < maxMappingPlans+1; i++ {
+		getMappingPlan([]string{"actor.actor_id", string(rune('a' + i%26)), string(rune(i))})
+	}
+
+	require.True(t, len(mappingPlans.plans) <= maxMappingPlans)
+}
@@ -17,49 +17,111 @@ var ErrNoRows = errors.New("qrm: no rows in result set")
 // using context `ctx` into destination `destPtr`.
 // Destination can be either pointer to struct or pointer to slice of structs.
 // If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
-func Query(ctx context.Context, db DB, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
+// Destination can also be pointer to map[string]interface{} or pointer to slice of map[string]interface{}, in which
+// case each row is scanned into a map keyed by column name. Column names appearing in more than one table are
+// qualified with the table alias (`table.column`).
+func Query(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
+	return queryToDest(ctx, db, query, args, destPtr, false)
+}
+
+// QueryFast is the same as Query, except that destination models implementing ModelScanner interface (generated
+// with model scanner option) are scanned without reflection. If query result set columns do not match model
+// scan columns, query result is mapped using reflection.
+func QueryFast(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
+	return queryToDest(ctx, db, query, args, destPtr, true)
+}
+
+func queryToDest(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{},
+	useModelScanner bool) (rowsProcessed int64, err error) {
 
 	utils.MustBeInitializedPtr(db, "jet: db is nil")
 	utils.MustBeInitializedPtr(destPtr, "jet: destination is nil")
 	utils.MustBe(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice or pointer to struct")
 
 	destinationPtrType := reflect.TypeOf(destPtr)
+	mapDestination := isMapDestination(destPtr)
+
+	if kind := destinationPtrType.Elem().Kind(); !mapDestination && kind != reflect.Slice && kind != reflect.Struct {
+		panic("jet: destination has to be a pointer to slice or pointer to struct")
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return 0, fmt.Errorf("jet: %w", err)
+	}
+	defer rows.Close()
+
+	limiter := newResultLimiter(ctx)
+
+	if mapDestination {
+		rowsProcessed, err = scanRowsToMaps(rows, destPtr, limiter)
 
-	if destinationPtrType.Elem().Kind() == reflect.Slice {
-		rowsProcessed, err := queryToSlice(ctx, db, query, args, destPtr)
 		if err != nil {
 			return rowsProcessed, fmt.Errorf("jet: %w", err)
 		}
+
+		if rowsProcessed == 0 && destinationPtrType.Elem().Kind() == reflect.Map {
+			return 0, ErrNoRows
+		}
+
 		return rowsProcessed, nil
-	} else if destinationPtrType.Elem().Kind() == reflect.Struct {
-		tempSlicePtrValue := reflect.New(reflect.SliceOf(destinationPtrType))
-		tempSliceValue := tempSlicePtrValue.Elem()
+	}
 
-		rowsProcessed, err := queryToSlice(ctx, db, query, args, tempSlicePtrValue.Interface())
+	// row values are not available to the fast row scanners, so result size limit is checked using reflection
+	scanner, ok := fastRowScanner(rows, destPtr, useModelScanner)
+
+	if ok && !limiter.countsBytes() {
+		rowsProcessed, err = scanRowsFast(rows, scanner, destPtr, limiter)
 
 		if err != nil {
 			return rowsProcessed, fmt.Errorf("jet: %w", err)
 		}
 
-		if rowsProcessed == 0 {
+		if rowsProcessed == 0 && destinationPtrType.Elem().Kind() == reflect.Struct {
 			return 0, ErrNoRows
 		}
 
-		// edge case when row result set contains only NULLs.
-		if tempSliceValue.Len() == 0 {
-			return rowsProcessed, nil
+		return rowsProcessed, nil
+	}
+
+	if destinationPtrType.Elem().Kind() == reflect.Slice {
+		rowsProcessed, err := scanRowsToSlice(ctx, rows, destPtr, limiter)
+		if err != nil {
+			return rowsProcessed, fmt.Errorf("jet: %w", err)
 		}
+		return rowsProcessed, nil
+	}
 
-		structValue := reflect.ValueOf(destPtr).Elem()
-		firstTempStruct := tempSliceValue.Index(0).Elem()
+	tempSlicePtrValue := reflect.New(reflect.SliceOf(destinationPtrType))
+	tempSliceValue := tempSlicePtrValue.Elem()
 
-		if structValue.Type().AssignableTo(firstTempStruct.Type()) {
-			structValue.Set(tempSliceValue.Index(0).Elem())
-		}
+	rowsProcessed, err = scanRowsToSlice(ctx, rows, tempSlicePtrValue.Interface(), limiter)
+
+	if err != nil {
+		return rowsProcessed, fmt.Errorf("jet: %w", err)
+	}
+
+	if rowsProcessed == 0 {
+		return 0, ErrNoRows
+	}
+
+	// edge case when row result set contains only NULLs.
+	if tempSliceValue.Len() == 0 {
 		return rowsProcessed, nil
-	} else {
-		panic("jet: destination has to be a pointer to slice or pointer to struct")
 	}
+
+	structValue := reflect.ValueOf(destPtr).Elem()
+	firstTempStruct := tempSliceValue.Index(0).Elem()
+
+	if structValue.Type().AssignableTo(firstTempStruct.Type()) {
+		structValue.Set(tempSliceValue.Index(0).Elem())
+	}
+	return rowsProcessed, nil
 }
 
 // ScanOneRowToDest will scan one row into struct destination
@@ -77,6 +139,11 @@ func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interfac
 		return fmt.Errorf("jet: rows scan error, %w", err)
 	}
 
+	// each row is mapped into destination independently, so group keys of the previous rows are not needed
+	if len(scanContext.uniqueDestObjectsMap) > 0 {
+		scanContext.uniqueDestObjectsMap = make(map[string]int)
+	}
+
 	destValuePtr := reflect.ValueOf(destPtr)
 
 	_, err = mapRowToStruct(scanContext, "", destValuePtr, nil)
@@ -88,19 +155,42 @@ func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interfac
 	return nil
 }
 
-func queryToSlice(ctx context.Context, db DB, query string, args []interface{}, slicePtr interface{}) (rowsProcessed int64, err error) {
-	if ctx == nil {
-		ctx = context.Background()
-	}
+// ScanEachRowToDest scans rows one at a time into struct destination, and calls fn after each row is scanned.
+// Destination is set to zero value before each row scan, and only the current row is kept in memory, so result sets
+// of any size are processed in constant memory. Iteration stops on the first fn error, which is returned.
+// Rows are closed before return.
+func ScanEachRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interface{}, fn func() error) error {
+	defer rows.Close()
 
-	rows, err := db.QueryContext(ctx, query, args...)
+	utils.MustBeInitializedPtr(destPtr, "jet: destination is nil")
+	utils.MustBe(destPtr, reflect.Ptr, "jet: destination has to be a pointer to struct")
 
-	if err != nil {
-		return
+	destValue := reflect.ValueOf(destPtr).Elem()
+	utils.ValueMustBe(destValue, reflect.Struct, "jet: destination has to be a pointer to struct")
+
+	zeroValue := reflect.Zero(destValue.Type())
+
+	for rows.Next() {
+		destValue.Set(zeroValue)
+
+		if err := ScanOneRowToDest(scanContext, rows, destPtr); err != nil {
+			return err
+		}
+
+		if err := fn(); err != nil {
+			return err
+		}
 	}
-	defer rows.Close()
 
-	scanContext, err := NewScanContext(rows)
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	return rows.Err()
+}
+
+func scanRowsToSlice(ctx context.Context, rows *sql.Rows, slicePtr interface{}, limiter *resultLimiter) (rowsProcessed int64, err error) {
+	scanContext, err := NewScanContextWithContext(ctx, rows)
 
 	if err != nil {
 		return
@@ -121,6 +211,10 @@ func queryToSlice(ctx context.Context, db DB, query string, args []interface{},
 
 		scanContext.rowNum++
 
+		if err = limiter.add(scanContext.row); err != nil {
+			return scanContext.rowNum, err
+		}
+
 		_, err = mapRowToSlice(scanContext, "", slicePtrValue, nil)
 
 		if err != nil {
@@ -128,6 +222,8 @@ func queryToSlice(ctx context.Context, db DB, query string, args []interface{},
 		}
 	}
 
+	scanContext.logUnmappedColumns()
+
 	err = rows.Close()
 	if err != nil {
 		return scanContext.rowNum, err
@@ -153,6 +249,11 @@ func mapRowToSlice(
 
 	structGroupKey := scanContext.getGroupKey(sliceElemType, field)
 
+	// slices of the same element type in the same parent object (e.g. self-joined table aliases) must not share objects
+	if field != nil {
+		structGroupKey = concat(field.Name, ":", structGroupKey)
+	}
+
 	groupKey = concat(groupKey, ",", structGroupKey)
 
 	index, ok := scanContext.uniqueDestObjectsMap[groupKey]
@@ -262,7 +363,13 @@ func mapRowToStruct(
 
 			updated = true
 
-			if fieldMap.implementsScanner {
+			if fieldMap.jsonColumn {
+				value := scannedValue.Interface()
+
+				if err := unmarshalJSON(value, fieldValue); err != nil {
+					return updated, fmt.Errorf(`can't unmarshal json %T(%q) to '%s %s': %w`, value, value, field.Name, field.Type.String(), err)
+				}
+			} else if fieldMap.implementsScanner {
 				initializeValueIfNilPtr(fieldValue)
 				fieldScanner := getScanner(fieldValue)
 
@@ -276,7 +383,11 @@ func mapRowToStruct(
 			} else {
 				err := assign(scannedValue, fieldValue)
 
-				if err != nil {
+				if err != nil && scanContext.mismatchLogger != nil {
+					scanContext.logMismatch(`qrm: can't assign %T to '%s.%s %s', value skipped`, scannedValue.Interface(),
+						structType.String(), field.Name, field.Type.String())
+					setZeroValue(fieldValue)
+				} else if err != nil {
 					return updated, fmt.Errorf(`can't assign %T(%q) to '%s %s': %w`, scannedValue.Interface(), scannedValue.Interface(),
 						field.Name, field.Type.String(), err)
 				}
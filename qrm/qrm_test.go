@@ -0,0 +1,198 @@
+package qrm
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type eachFilm struct {
+	FilmID int32 `sql:"primary_key"`
+	Title  string
+	Actors []struct {
+		ActorID   int32 `sql:"primary_key"`
+		FirstName string
+	} `alias:"actor"`
+}
+
+func TestScanEachRowToDest(t *testing.T) {
+	db := openFakeDB(t, []string{"eachFilm.film_id", "eachFilm.title", "actor.actor_id", "actor.first_name"},
+		[]driver.Value{int64(1), "Alien", int64(10), "Sigourney"},
+		[]driver.Value{int64(1), "Alien", int64(11), "Tom"},
+		[]driver.Value{int64(2), "Heat", int64(12), "Al"},
+	)
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT")
+	require.NoError(t, err)
+
+	scanContext, err := NewScanContext(rows)
+	require.NoError(t, err)
+
+	var film eachFilm
+	var titles []string
+	var actors []string
+
+	err = ScanEachRowToDest(scanContext, rows, &film, func() error {
+		require.Len(t, film.Actors, 1)
+		titles = append(titles, film.Title)
+		actors = append(actors, film.Actors[0].FirstName)
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"Alien", "Alien", "Heat"}, titles)
+	require.Equal(t, []string{"Sigourney", "Tom", "Al"}, actors)
+	require.Len(t, scanContext.uniqueDestObjectsMap, 1) // only the last row group keys
+}
+
+func TestScanEachRowToDestStopsOnError(t *testing.T) {
+	db := openFakeDB(t, []string{"eachFilm.film_id"}, []driver.Value{int64(1)}, []driver.Value{int64(2)})
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT")
+	require.NoError(t, err)
+
+	scanContext, err := NewScanContext(rows)
+	require.NoError(t, err)
+
+	stopErr := errors.New("stop")
+	calls := 0
+
+	var film eachFilm
+
+	err = ScanEachRowToDest(scanContext, rows, &film, func() error {
+		calls++
+		return stopErr
+	})
+
+	require.Equal(t, stopErr, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestScanEachRowToDestInvalidDestination(t *testing.T) {
+	db := openFakeDB(t, []string{"eachFilm.film_id"})
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT")
+	require.NoError(t, err)
+
+	scanContext, err := NewScanContext(rows)
+	require.NoError(t, err)
+
+	var films []eachFilm
+
+	require.PanicsWithValue(t, "jet: destination has to be a pointer to struct", func() {
+		_ = ScanEachRowToDest(scanContext, rows, &films, func() error { return nil })
+	})
+}
+
+func TestQueryRenamedFieldAlias(t *testing.T) {
+	type Users struct {
+		UserID   int64  `sql:"primary_key"`
+		UserName string `alias:"usr_nm"`
+	}
+
+	db := openFakeDB(t, []string{"users.user_id", "users.usr_nm"},
+		[]driver.Value{int64(1), "john"},
+		[]driver.Value{int64(2), "jane"},
+	)
+	defer db.Close()
+
+	var users []Users
+	_, err := Query(context.Background(), db, "SELECT", nil, &users)
+	require.NoError(t, err)
+	require.Equal(t, []Users{{UserID: 1, UserName: "john"}, {UserID: 2, UserName: "jane"}}, users)
+}
+
+func TestQueryMapDestination(t *testing.T) {
+	db := openFakeDB(t, []string{"film.film_id", "film.title", "actor.actor_id", "language.name", "actor.name", "count"},
+		[]driver.Value{int64(1), []byte("Alien"), int64(10), "English", "Sigourney", int64(2)},
+		[]driver.Value{int64(2), "Heat", nil, "English", "Al", int64(1)},
+	)
+	defer db.Close()
+
+	var dest []map[string]interface{}
+	rowsProcessed, err := Query(context.Background(), db, "SELECT", nil, &dest)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rowsProcessed)
+	require.Equal(t, []map[string]interface{}{
+		{"film_id": int64(1), "title": "Alien", "actor_id": int64(10), "language.name": "English", "actor.name": "Sigourney", "count": int64(2)},
+		{"film_id": int64(2), "title": "Heat", "actor_id": nil, "language.name": "English", "actor.name": "Al", "count": int64(1)},
+	}, dest)
+
+	var row map[string]interface{}
+	rowsProcessed, err = Query(context.Background(), db, "SELECT", nil, &row)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rowsProcessed)
+	require.Equal(t, "Alien", row["title"])
+}
+
+func TestQueryMapDestinationNoRows(t *testing.T) {
+	db := openFakeDB(t, []string{"film.film_id"})
+	defer db.Close()
+
+	var row map[string]interface{}
+	_, err := Query(context.Background(), db, "SELECT", nil, &row)
+	require.Equal(t, ErrNoRows, err)
+
+	var rows []map[string]interface{}
+	rowsProcessed, err := Query(context.Background(), db, "SELECT", nil, &rows)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), rowsProcessed)
+	require.Nil(t, rows)
+}
+
+func TestQueryAliasedSelfJoin(t *testing.T) {
+	type Employee struct {
+		ID   int64 `sql:"primary_key"`
+		Name string
+	}
+
+	type Report struct {
+		Employee   `alias:"report.*"`
+		SubReports []Employee `alias:"sub_report.*"`
+	}
+
+	type Manager struct {
+		Employee
+		Boss    *Employee `alias:"boss.*"`
+		Reports []Report
+		Peers   []Employee `alias:"peer.*"`
+	}
+
+	db := openFakeDB(t, []string{"employee.id", "employee.name", "boss.id", "boss.name",
+		"report.id", "report.name", "sub_report.id", "sub_report.name", "peer.id", "peer.name"},
+		[]driver.Value{int64(1), "Ann", int64(9), "Zed", int64(2), "Bob", int64(4), "Dan", int64(3), "Cid"},
+		[]driver.Value{int64(1), "Ann", int64(9), "Zed", int64(2), "Bob", int64(5), "Eve", int64(2), "Bob"},
+		[]driver.Value{int64(1), "Ann", int64(9), "Zed", int64(3), "Cid", nil, nil, int64(2), "Bob"},
+		[]driver.Value{int64(3), "Cid", int64(1), "Ann", int64(4), "Dan", nil, nil, nil, nil},
+	)
+	defer db.Close()
+
+	var dest []Manager
+	_, err := Query(context.Background(), db, "SELECT", nil, &dest)
+	require.NoError(t, err)
+
+	require.Equal(t, []Manager{
+		{
+			Employee: Employee{ID: 1, Name: "Ann"},
+			Boss:     &Employee{ID: 9, Name: "Zed"},
+			Reports: []Report{
+				{Employee: Employee{ID: 2, Name: "Bob"}, SubReports: []Employee{{ID: 4, Name: "Dan"}, {ID: 5, Name: "Eve"}}},
+				{Employee: Employee{ID: 3, Name: "Cid"}},
+			},
+			Peers: []Employee{{ID: 3, Name: "Cid"}, {ID: 2, Name: "Bob"}},
+		},
+		{
+			Employee: Employee{ID: 3, Name: "Cid"},
+			Boss:     &Employee{ID: 1, Name: "Ann"},
+			Reports:  []Report{{Employee: Employee{ID: 4, Name: "Dan"}}},
+		},
+	}, dest)
+}
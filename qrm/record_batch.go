@@ -0,0 +1,243 @@
+package qrm
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BatchType is a column type of the record batch
+type BatchType int
+
+// Record batch column types. Each type maps to a single arrow (and parquet) logical type.
+const (
+	BatchString    BatchType = iota // utf8, values are string
+	BatchInt64                      // int64, values are int64
+	BatchFloat64                    // float64, values are float64
+	BatchBool                       // boolean, values are bool
+	BatchBinary                     // binary, values are []byte
+	BatchTimestamp                  // timestamp, values are time.Time
+)
+
+// BatchField describes record batch column
+type BatchField struct {
+	Name         string
+	Type         BatchType
+	Nullable     bool
+	DatabaseType string
+}
+
+// RecordBatch is a columnar chunk of the query result set. Each column is a slice of NumRows values of the column
+// type, or nil for NULL values. Record batch layout mirrors Apache Arrow record batch, so it can be appended to arrow
+// array builders (and written to parquet files) column by column, without qrm depending on arrow libraries.
+type RecordBatch struct {
+	Fields  []BatchField
+	Columns [][]interface{}
+	NumRows int
+}
+
+// ScanRecordBatches reads rows into record batches of at most batchSize rows, and calls fn for each batch.
+// Column types are derived from result set column types: integers, floats, booleans, binaries and timestamps have
+// their own batch types, while numeric and decimal columns, to preserve precision, and all the other column types
+// are read as strings. Batch is reused between fn calls, so fn should not retain it after return.
+func ScanRecordBatches(rows *sql.Rows, batchSize int, fn func(batch *RecordBatch) error) error {
+	if batchSize <= 0 {
+		return fmt.Errorf("jet: record batch size has to be greater than 0, got %d", batchSize)
+	}
+
+	columnTypes, err := rows.ColumnTypes()
+
+	if err != nil {
+		return err
+	}
+
+	batch := &RecordBatch{
+		Fields:  make([]BatchField, len(columnTypes)),
+		Columns: make([][]interface{}, len(columnTypes)),
+	}
+
+	for i, columnType := range columnTypes {
+		nullable, ok := columnType.Nullable()
+
+		batch.Fields[i] = BatchField{
+			Name:         columnType.Name(),
+			Type:         batchType(columnType.DatabaseTypeName()),
+			Nullable:     nullable || !ok,
+			DatabaseType: columnType.DatabaseTypeName(),
+		}
+		batch.Columns[i] = make([]interface{}, 0, batchSize)
+	}
+
+	values := make([]interface{}, len(columnTypes))
+	valuePtrs := make([]interface{}, len(columnTypes))
+
+	for i := range values {
+		valuePtrs[i] = &values[i]
+	}
+
+	flush := func() error {
+		if batch.NumRows == 0 {
+			return nil
+		}
+
+		if err := fn(batch); err != nil {
+			return err
+		}
+
+		for i := range batch.Columns {
+			batch.Columns[i] = batch.Columns[i][:0]
+		}
+		batch.NumRows = 0
+
+		return nil
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return err
+		}
+
+		for i, value := range values {
+			batchValue, err := toBatchValue(value, batch.Fields[i].Type)
+
+			if err != nil {
+				return fmt.Errorf("jet: can't convert column '%s' value: %w", batch.Fields[i].Name, err)
+			}
+
+			batch.Columns[i] = append(batch.Columns[i], batchValue)
+		}
+
+		batch.NumRows++
+
+		if batch.NumRows == batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return flush()
+}
+
+func batchType(databaseTypeName string) BatchType {
+	typeName := strings.ToUpper(databaseTypeName)
+
+	if index := strings.Index(typeName, "("); index > 0 { // sqlite declared types, like VARCHAR(20)
+		typeName = strings.TrimSpace(typeName[:index])
+	}
+
+	typeName = strings.TrimPrefix(typeName, "UNSIGNED ")
+
+	switch typeName {
+	case "INT", "INTEGER", "INT2", "INT4", "INT8", "SMALLINT", "BIGINT", "TINYINT", "MEDIUMINT", "YEAR",
+		"SERIAL", "BIGSERIAL", "SMALLSERIAL":
+		return BatchInt64
+	case "REAL", "FLOAT", "FLOAT4", "FLOAT8", "DOUBLE", "DOUBLE PRECISION":
+		return BatchFloat64
+	case "BOOL", "BOOLEAN":
+		return BatchBool
+	case "BYTEA", "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB", "BINARY", "VARBINARY":
+		return BatchBinary
+	case "DATE", "DATETIME", "TIMESTAMP", "TIMESTAMPTZ":
+		return BatchTimestamp
+	}
+
+	return BatchString
+}
+
+func toBatchValue(value interface{}, batchType BatchType) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch batchType {
+	case BatchInt64:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case uint64: // mysql unsigned bigint
+			if v > math.MaxInt64 {
+				return nil, fmt.Errorf("value %d overflows int64", v)
+			}
+			return int64(v), nil
+		case []byte:
+			return strconv.ParseInt(string(v), 10, 64)
+		case string:
+			return strconv.ParseInt(v, 10, 64)
+		}
+	case BatchFloat64:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case float32:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case uint64:
+			return float64(v), nil
+		case []byte:
+			return strconv.ParseFloat(string(v), 64)
+		case string:
+			return strconv.ParseFloat(v, 64)
+		}
+	case BatchBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			return v != 0, nil
+		case uint64:
+			return v != 0, nil
+		case []byte:
+			return strconv.ParseBool(string(v))
+		case string:
+			return strconv.ParseBool(v)
+		}
+	case BatchBinary:
+		switch v := value.(type) {
+		case []byte:
+			return cloneBytes(v), nil
+		case string:
+			return []byte(v), nil
+		}
+	case BatchTimestamp:
+		switch v := value.(type) {
+		case time.Time:
+			return v, nil
+		case []byte:
+			return parseBatchTime(string(v))
+		case string:
+			return parseBatchTime(v)
+		}
+	case BatchString:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case []byte:
+			return string(v), nil
+		case time.Time:
+			return v.Format(time.RFC3339Nano), nil
+		default:
+			return fmt.Sprint(v), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected value type %T", value)
+}
+
+func parseBatchTime(value string) (time.Time, error) {
+	for _, layout := range jsonTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("can't parse time '%s'", value)
+}
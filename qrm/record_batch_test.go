@@ -0,0 +1,53 @@
+package qrm
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchType(t *testing.T) {
+	require.Equal(t, BatchInt64, batchType("INT4"))
+	require.Equal(t, BatchInt64, batchType("UNSIGNED BIGINT"))
+	require.Equal(t, BatchFloat64, batchType("double precision"))
+	require.Equal(t, BatchBool, batchType("BOOL"))
+	require.Equal(t, BatchBinary, batchType("BYTEA"))
+	require.Equal(t, BatchTimestamp, batchType("TIMESTAMPTZ"))
+	require.Equal(t, BatchString, batchType("VARCHAR(20)"))
+	require.Equal(t, BatchString, batchType("NUMERIC"))
+	require.Equal(t, BatchString, batchType(""))
+}
+
+func TestToBatchValue(t *testing.T) {
+	testValue := func(value interface{}, batchType BatchType, expected interface{}) {
+		batchValue, err := toBatchValue(value, batchType)
+		require.NoError(t, err)
+		require.Equal(t, expected, batchValue)
+	}
+
+	testValue(nil, BatchInt64, nil)
+	testValue(int64(11), BatchInt64, int64(11))
+	testValue([]byte("11"), BatchInt64, int64(11))
+	testValue(uint64(11), BatchInt64, int64(11))
+	testValue(uint64(3), BatchFloat64, float64(3))
+	testValue(uint64(0), BatchBool, false)
+	testValue(float32(1.5), BatchFloat64, float64(1.5))
+	testValue([]byte("1.25"), BatchFloat64, 1.25)
+	testValue(int64(1), BatchBool, true)
+	testValue([]byte("false"), BatchBool, false)
+	testValue("abc", BatchBinary, []byte("abc"))
+	testValue([]byte("2020-02-03 10:20:30"), BatchTimestamp, time.Date(2020, 2, 3, 10, 20, 30, 0, time.UTC))
+	testValue([]byte("12.345"), BatchString, "12.345")
+	testValue(int64(7), BatchString, "7")
+
+	_, err := toBatchValue([]byte("abc"), BatchInt64)
+	require.Error(t, err)
+
+	_, err = toBatchValue(uint64(math.MaxUint64), BatchInt64)
+	require.EqualError(t, err, "value 18446744073709551615 overflows int64")
+
+	_, err = toBatchValue(true, BatchTimestamp)
+	require.EqualError(t, err, "unexpected value type bool")
+}
@@ -0,0 +1,94 @@
+package qrm
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrResultLimitExceeded is returned by Query when query result set exceeds result limit set with WithResultLimit
+var ErrResultLimitExceeded = errors.New("qrm: query result exceeds result limit")
+
+// ResultLimit limits the size of query result set mapped into destination
+type ResultLimit struct {
+	// MaxRows is the maximum number of result set rows, or 0 if the number of rows is not limited
+	MaxRows int64
+	// MaxBytes is the maximum size of result set values in bytes, or 0 if the size is not limited. Size is
+	// approximated by the length of string and byte slice values, and 8 bytes for any other non NULL value.
+	MaxBytes int64
+}
+
+type resultLimitKey struct{}
+
+// WithResultLimit returns context, which limits the size of result sets of queries executed with it. Query stops
+// scanning rows and returns ErrResultLimitExceeded as soon as the limit is exceeded, leaving destination partially
+// filled. Result sets of unbounded size should be processed row by row instead (for instance using statement Rows
+// method), which does not apply the limit.
+func WithResultLimit(ctx context.Context, limit ResultLimit) context.Context {
+	return context.WithValue(ctx, resultLimitKey{}, limit)
+}
+
+// newResultLimiter returns limiter of the result set size set with WithResultLimit, or nil if result set is not limited
+func newResultLimiter(ctx context.Context) *resultLimiter {
+	limit, ok := ctx.Value(resultLimitKey{}).(ResultLimit)
+
+	if !ok || (limit.MaxRows <= 0 && limit.MaxBytes <= 0) {
+		return nil
+	}
+
+	return &resultLimiter{limit: limit}
+}
+
+type resultLimiter struct {
+	limit ResultLimit
+	rows  int64
+	bytes int64
+}
+
+// add counts scanned row, and returns ErrResultLimitExceeded if result set exceeded the limit. Row values are
+// counted only if row is not nil.
+func (l *resultLimiter) add(row []interface{}) error {
+	if l == nil {
+		return nil
+	}
+
+	l.rows++
+
+	if l.limit.MaxRows > 0 && l.rows > l.limit.MaxRows {
+		return ErrResultLimitExceeded
+	}
+
+	if l.limit.MaxBytes <= 0 {
+		return nil
+	}
+
+	for _, value := range row {
+		l.bytes += valueSize(*(value.(*interface{})))
+	}
+
+	if l.bytes > l.limit.MaxBytes {
+		return ErrResultLimitExceeded
+	}
+
+	return nil
+}
+
+// countsBytes returns true if limiter needs scanned row values
+func (l *resultLimiter) countsBytes() bool {
+	return l != nil && l.limit.MaxBytes > 0
+}
+
+func valueSize(value interface{}) int64 {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case string:
+		return int64(len(v))
+	case []byte:
+		return int64(len(v))
+	case time.Time:
+		return 24
+	}
+
+	return 8
+}
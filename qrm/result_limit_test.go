@@ -0,0 +1,67 @@
+package qrm
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryResultLimit(t *testing.T) {
+	db := openFakeDB(t, []string{"actor.actor_id", "actor.first_name"},
+		[]driver.Value{int64(1), "Penelope"},
+		[]driver.Value{int64(2), "Nick"},
+		[]driver.Value{int64(3), "Ed"},
+	)
+	defer db.Close()
+
+	type actor struct {
+		ActorID   int32
+		FirstName string
+	}
+
+	var actors []actor
+
+	ctx := WithResultLimit(context.Background(), ResultLimit{MaxRows: 3})
+	_, err := Query(ctx, db, "SELECT", nil, &actors)
+	require.NoError(t, err)
+	require.Len(t, actors, 3)
+
+	actors = nil
+	ctx = WithResultLimit(context.Background(), ResultLimit{MaxRows: 2})
+	rowsProcessed, err := Query(ctx, db, "SELECT", nil, &actors)
+	require.True(t, errors.Is(err, ErrResultLimitExceeded))
+	require.Equal(t, int64(3), rowsProcessed)
+	require.Len(t, actors, 2)
+
+	actors = nil
+	ctx = WithResultLimit(context.Background(), ResultLimit{MaxBytes: 30})
+	_, err = Query(ctx, db, "SELECT", nil, &actors)
+	require.True(t, errors.Is(err, ErrResultLimitExceeded))
+	require.Len(t, actors, 2) // 16 + 12 bytes, third row exceeds the limit with 10 more bytes
+
+	var rows []map[string]interface{}
+	ctx = WithResultLimit(context.Background(), ResultLimit{MaxRows: 1})
+	_, err = Query(ctx, db, "SELECT", nil, &rows)
+	require.True(t, errors.Is(err, ErrResultLimitExceeded))
+}
+
+func TestQueryFastResultLimit(t *testing.T) {
+	db := openFakeDB(t, []string{"actor.actor_id"}, []driver.Value{int64(1)}, []driver.Value{int64(2)})
+	defer db.Close()
+
+	var ids []int64
+
+	ctx := WithResultLimit(context.Background(), ResultLimit{MaxRows: 1})
+	_, err := Query(ctx, db, "SELECT", nil, &ids)
+	require.True(t, errors.Is(err, ErrResultLimitExceeded))
+	require.Equal(t, []int64{1}, ids)
+
+	ids = nil
+	ctx = WithResultLimit(context.Background(), ResultLimit{MaxBytes: 16})
+	_, err = Query(ctx, db, "SELECT", nil, &ids)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, ids)
+}
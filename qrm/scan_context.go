@@ -1,6 +1,7 @@
 package qrm
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"reflect"
@@ -18,10 +19,24 @@ type ScanContext struct {
 	typeInfoMap              map[string]typeInfo
 
 	typesVisited typeStack // to prevent circular dependency scan
+
+	// mapping plan shared between queries with the same projection, nil if not shared
+	plan *mappingPlan
+
+	// tolerant mode
+	mismatchLogger   MismatchLogger
+	columnNames      []string
+	mappedColumns    map[int]bool
+	loggedMismatches map[string]bool
 }
 
 // NewScanContext creates new ScanContext from rows
 func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
+	return NewScanContextWithContext(context.Background(), rows)
+}
+
+// NewScanContextWithContext creates new ScanContext from rows, using tolerant mode logger of the context ctx
+func NewScanContextWithContext(ctx context.Context, rows *sql.Rows) (*ScanContext, error) {
 	aliases, err := rows.Columns()
 
 	if err != nil {
@@ -34,17 +49,17 @@ func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
 		return nil, err
 	}
 
-	commonIdentToColumnIndex := map[string]int{}
+	var plan *mappingPlan
+	var commonIdentToColumnIndex map[string]int
 
-	for i, alias := range aliases {
-		names := strings.SplitN(alias, ".", 2)
-		commonIdentifier := toCommonIdentifier(names[0])
+	tolerantModeLogger := mismatchLogger(ctx)
 
-		if len(names) > 1 {
-			commonIdentifier = concat(commonIdentifier, ".", toCommonIdentifier(names[1]))
-		}
-
-		commonIdentToColumnIndex[commonIdentifier] = i
+	// in tolerant mode mismatches are logged while destination mapping is computed, so mapping plan is not shared
+	if tolerantModeLogger == nil {
+		plan = getMappingPlan(aliases)
+		commonIdentToColumnIndex = plan.commonIdentToColumnIndex
+	} else {
+		commonIdentToColumnIndex = newCommonIdentToColumnIndex(aliases)
 	}
 
 	return &ScanContext{
@@ -57,6 +72,13 @@ func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
 		typeInfoMap: make(map[string]typeInfo),
 
 		typesVisited: newTypeStack(),
+
+		plan: plan,
+
+		mismatchLogger:   tolerantModeLogger,
+		columnNames:      aliases,
+		mappedColumns:    make(map[int]bool),
+		loggedMismatches: make(map[string]bool),
 	}, nil
 }
 
@@ -79,6 +101,7 @@ type fieldMapping struct {
 	complexType       bool // slice and struct are complex types
 	rowIndex          int  // index in ScanContext.row
 	implementsScanner bool
+	jsonColumn        bool // complex type unmarshalled from json column
 }
 
 func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.StructField) typeInfo {
@@ -93,6 +116,17 @@ func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.
 		return typeInfo
 	}
 
+	var planKey mappingPlanKey
+
+	if s.plan != nil {
+		planKey = newMappingPlanKey(structType, parentField)
+
+		if typeInfo, ok := s.plan.getTypeInfo(planKey); ok {
+			s.typeInfoMap[typeMapKey] = typeInfo
+			return typeInfo
+		}
+	}
+
 	typeName := getTypeName(structType, parentField)
 
 	newTypeInfo := typeInfo{}
@@ -109,15 +143,23 @@ func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.
 
 		if implementsScannerType(field.Type) {
 			fieldMap.implementsScanner = true
+		} else if isJSONField(field) {
+			fieldMap.jsonColumn = true
 		} else if !isSimpleModelType(field.Type) {
 			fieldMap.complexType = true
 		}
 
+		s.logFieldMapping(structType, field, fieldMap)
+
 		newTypeInfo.fieldMappings = append(newTypeInfo.fieldMappings, fieldMap)
 	}
 
 	s.typeInfoMap[typeMapKey] = newTypeInfo
 
+	if s.plan != nil {
+		s.plan.setTypeInfo(planKey, newTypeInfo)
+	}
+
 	return newTypeInfo
 }
 
@@ -129,21 +171,37 @@ type groupKeyInfo struct {
 
 func (s *ScanContext) getGroupKey(structType reflect.Type, structField *reflect.StructField) string {
 
-	mapKey := structType.Name()
+	mapKey := structType.String()
 
+	// the same struct type can be mapped from different table aliases (self-joins), so the field tag is part of the key
 	if structField != nil {
-		mapKey = concat(mapKey, structField.Type.String())
+		mapKey = concat(mapKey, structField.Type.String(), string(structField.Tag))
 	}
 
 	if groupKeyInfo, ok := s.groupKeyInfoCache[mapKey]; ok {
 		return s.constructGroupKey(groupKeyInfo)
 	}
 
+	var planKey mappingPlanKey
+
+	if s.plan != nil {
+		planKey = newMappingPlanKey(structType, structField)
+
+		if groupKeyInfo, ok := s.plan.getGroupKeyInfo(planKey); ok {
+			s.groupKeyInfoCache[mapKey] = groupKeyInfo
+			return s.constructGroupKey(groupKeyInfo)
+		}
+	}
+
 	tempTypeStack := newTypeStack()
 	groupKeyInfo := s.getGroupKeyInfo(structType, structField, &tempTypeStack)
 
 	s.groupKeyInfoCache[mapKey] = groupKeyInfo
 
+	if s.plan != nil {
+		s.plan.setGroupKeyInfo(planKey, groupKeyInfo)
+	}
+
 	return s.constructGroupKey(groupKeyInfo)
 }
 
@@ -171,7 +229,7 @@ func (s *ScanContext) getGroupKeyInfo(
 	parentField *reflect.StructField,
 	typeVisited *typeStack) groupKeyInfo {
 
-	ret := groupKeyInfo{typeName: structType.Name()}
+	ret := groupKeyInfo{typeName: getTypeName(structType, parentField)}
 
 	if typeVisited.contains(&structType) {
 		return ret
@@ -182,11 +240,16 @@ func (s *ScanContext) getGroupKeyInfo(
 
 	typeName := getTypeName(structType, parentField)
 	primaryKeyOverwrites := parentFieldPrimaryKeyOverwrite(parentField)
+	primaryKeyIncomplete := false
 
 	for i := 0; i < structType.NumField(); i++ {
 		field := structType.Field(i)
 		fieldType := indirectType(field.Type)
 
+		if isJSONField(field) {
+			continue
+		}
+
 		if !isSimpleModelType(fieldType) {
 			if fieldType.Kind() != reflect.Struct {
 				continue
@@ -204,6 +267,7 @@ func (s *ScanContext) getGroupKeyInfo(
 				index := s.typeToColumnIndex(newTypeName, fieldName)
 
 				if index < 0 {
+					primaryKeyIncomplete = true
 					continue
 				}
 
@@ -212,6 +276,12 @@ func (s *ScanContext) getGroupKeyInfo(
 		}
 	}
 
+	// grouping by a part of composite primary key would merge unrelated rows, so if some of the primary key
+	// columns are not in the result set, rows are not grouped by this type
+	if primaryKeyIncomplete {
+		ret.indexes = nil
+	}
+
 	return ret
 }
 
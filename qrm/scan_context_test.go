@@ -0,0 +1,38 @@
+package qrm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type filmActor struct {
+	ActorID int32 `sql:"primary_key"`
+	FilmID  int32 `sql:"primary_key"`
+	Rating  int32
+}
+
+func TestGetGroupKeyInfoCompositePrimaryKey(t *testing.T) {
+	newScanContext := func(columns ...string) *ScanContext {
+		commonIdentToColumnIndex := map[string]int{}
+
+		for i, column := range columns {
+			commonIdentToColumnIndex[column] = i
+		}
+
+		return &ScanContext{
+			commonIdentToColumnIndex: commonIdentToColumnIndex,
+			groupKeyInfoCache:        make(map[string]groupKeyInfo),
+			typesVisited:             newTypeStack(),
+		}
+	}
+
+	filmActorType := reflect.TypeOf(filmActor{})
+
+	scanContext := newScanContext("filmactor.actorid", "filmactor.filmid", "filmactor.rating")
+	require.Equal(t, []int{0, 1}, scanContext.getGroupKeyInfo(filmActorType, nil, &scanContext.typesVisited).indexes)
+
+	scanContext = newScanContext("filmactor.actorid", "filmactor.rating")
+	require.Nil(t, scanContext.getGroupKeyInfo(filmActorType, nil, &scanContext.typesVisited).indexes)
+}
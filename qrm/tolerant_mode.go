@@ -0,0 +1,89 @@
+package qrm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+)
+
+// MismatchLogger logs a mismatch between query result set and destination, found in tolerant mode
+type MismatchLogger func(mismatch string)
+
+// tolerantMode is stored in atomic value, because atomic value can not store nil logger
+type tolerantMode struct {
+	logger MismatchLogger
+}
+
+var globalTolerantMode atomic.Value
+
+type tolerantModeKey struct{}
+
+// SetTolerantMode enables tolerant mode of query result mapping, for the periods when code and database schema
+// versions might briefly diverge (for instance during deploys). In tolerant mode result set columns not mapped
+// to any destination field, destination fields without result set column and values that can not be assigned to
+// destination fields are reported to logger, and values that can not be assigned are skipped instead of failing the
+// query. Each mismatch is reported once per query. Tolerant mode is disabled if logger is nil.
+// It is safe to call SetTolerantMode concurrently with query execution.
+func SetTolerantMode(logger MismatchLogger) {
+	globalTolerantMode.Store(tolerantMode{logger: logger})
+}
+
+// WithTolerantMode returns context, which enables tolerant mode with logger for queries executed with it, instead
+// of the tolerant mode set with SetTolerantMode. Tolerant mode is not overridden if logger is nil.
+func WithTolerantMode(ctx context.Context, logger MismatchLogger) context.Context {
+	return context.WithValue(ctx, tolerantModeKey{}, logger)
+}
+
+// mismatchLogger returns tolerant mode logger set with WithTolerantMode, or with SetTolerantMode if ctx does not
+// override it
+func mismatchLogger(ctx context.Context) MismatchLogger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(tolerantModeKey{}).(MismatchLogger); ok && logger != nil {
+			return logger
+		}
+	}
+
+	mode, _ := globalTolerantMode.Load().(tolerantMode)
+
+	return mode.logger
+}
+
+func (s *ScanContext) logMismatch(format string, args ...interface{}) {
+	if s.mismatchLogger == nil {
+		return
+	}
+
+	mismatch := fmt.Sprintf(format, args...)
+
+	if s.loggedMismatches[mismatch] {
+		return
+	}
+
+	s.loggedMismatches[mismatch] = true
+	s.mismatchLogger(mismatch)
+}
+
+func (s *ScanContext) logFieldMapping(structType reflect.Type, field reflect.StructField, fieldMap fieldMapping) {
+	if s.mismatchLogger == nil || fieldMap.complexType || field.PkgPath != "" {
+		return
+	}
+
+	if fieldMap.rowIndex == -1 {
+		s.logMismatch("qrm: destination field '%s.%s' has no result set column", structType.String(), field.Name)
+	} else {
+		s.mappedColumns[fieldMap.rowIndex] = true
+	}
+}
+
+func (s *ScanContext) logUnmappedColumns() {
+	if s.mismatchLogger == nil {
+		return
+	}
+
+	for i, columnName := range s.columnNames {
+		if !s.mappedColumns[i] {
+			s.logMismatch("qrm: result set column '%s' is not mapped to destination", columnName)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package qrm
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type actor struct {
+	ActorID   int32
+	FirstName string
+	LastName  string
+}
+
+func TestTolerantMode(t *testing.T) {
+	var mismatches []string
+
+	SetTolerantMode(func(mismatch string) {
+		mismatches = append(mismatches, mismatch)
+	})
+	defer SetTolerantMode(nil)
+
+	newScanContext := func(values ...interface{}) *ScanContext {
+		scanContext := &ScanContext{
+			row: createScanSlice(len(values)),
+			commonIdentToColumnIndex: map[string]int{
+				"actor.actorid":   0,
+				"actor.firstname": 1,
+				"actor.nickname":  2,
+			},
+			uniqueDestObjectsMap: make(map[string]int),
+			groupKeyInfoCache:    make(map[string]groupKeyInfo),
+			typeInfoMap:          make(map[string]typeInfo),
+			typesVisited:         newTypeStack(),
+			mismatchLogger:       mismatchLogger(context.Background()),
+			columnNames:          []string{"actor.actor_id", "actor.first_name", "actor.nickname"},
+			mappedColumns:        make(map[int]bool),
+			loggedMismatches:     make(map[string]bool),
+		}
+
+		for i, value := range values {
+			*(scanContext.row[i].(*interface{})) = value
+		}
+
+		return scanContext
+	}
+
+	var dest actor
+	scanContext := newScanContext(int64(1), []byte("John"), "Johnny")
+
+	_, err := mapRowToStruct(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.NoError(t, err)
+	scanContext.logUnmappedColumns()
+
+	require.Equal(t, actor{ActorID: 1, FirstName: "John"}, dest)
+	require.Equal(t, []string{
+		"qrm: destination field 'qrm.actor.LastName' has no result set column",
+		"qrm: result set column 'actor.nickname' is not mapped to destination",
+	}, mismatches)
+
+	mismatches = nil
+	scanContext = newScanContext(true, "John", "Johnny")
+
+	_, err = mapRowToStruct(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.NoError(t, err)
+	require.Equal(t, actor{ActorID: 0, FirstName: "John"}, dest)
+	require.Equal(t, []string{
+		"qrm: destination field 'qrm.actor.LastName' has no result set column",
+		"qrm: can't assign bool to 'qrm.actor.ActorID int32', value skipped",
+	}, mismatches)
+
+	SetTolerantMode(nil)
+	scanContext = newScanContext(true, "John", "Johnny")
+	scanContext.mismatchLogger = nil
+
+	_, err = mapRowToStruct(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.Error(t, err)
+}
+
+func TestTolerantModeContext(t *testing.T) {
+	var global, local []string
+
+	SetTolerantMode(func(mismatch string) { global = append(global, mismatch) })
+	defer SetTolerantMode(nil)
+
+	mismatchLogger(context.Background())("global")
+	mismatchLogger(WithTolerantMode(context.Background(), nil))("global")
+	mismatchLogger(WithTolerantMode(context.Background(), func(mismatch string) { local = append(local, mismatch) }))("local")
+
+	require.Equal(t, []string{"global", "global"}, global)
+	require.Equal(t, []string{"local"}, local)
+
+	SetTolerantMode(nil)
+	require.Nil(t, mismatchLogger(context.Background()))
+}
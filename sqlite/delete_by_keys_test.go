@@ -0,0 +1,60 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteByKeys(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec("CREATE TABLE item (id INTEGER PRIMARY KEY)")
+	require.NoError(t, err)
+
+	for i := 1; i <= 10; i++ {
+		_, err = db.Exec("INSERT INTO item VALUES (?)", i)
+		require.NoError(t, err)
+	}
+
+	itemID := IntegerColumn("id")
+	item := NewTable("", "item", "", itemID)
+
+	var progress []DeleteProgress
+
+	rowsDeleted, err := DeleteByKeys(context.Background(), db, item, itemID, []int64{1, 2, 3, 4, 5, 6, 7, 42}, 3,
+		DeleteByKeysOptions{
+			TxPerBatch: true,
+			Progress: func(p DeleteProgress) {
+				progress = append(progress, p)
+			},
+		})
+
+	require.NoError(t, err)
+	require.Equal(t, int64(7), rowsDeleted)
+	require.Equal(t, []DeleteProgress{
+		{KeysProcessed: 3, KeysTotal: 8, RowsDeleted: 3},
+		{KeysProcessed: 6, KeysTotal: 8, RowsDeleted: 6},
+		{KeysProcessed: 8, KeysTotal: 8, RowsDeleted: 7},
+	}, progress)
+
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM item").Scan(&count))
+	require.Equal(t, 3, count)
+
+	rowsDeleted, err = DeleteByKeys(context.Background(), db, item, itemID, []int{}, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), rowsDeleted)
+}
+
+func TestIsRetryableError(t *testing.T) {
+	require.True(t, isRetryableError(errors.New("database is locked")))
+	require.False(t, isRetryableError(errors.New("no such table: item")))
+	require.False(t, isRetryableError(nil))
+}
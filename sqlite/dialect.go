@@ -5,6 +5,10 @@ import (
 )
 
 // Dialect is implementation of SQL Builder for SQLite databases.
+// Dialect targets the latest database version. To target older database version, Dialect can be replaced with
+// versioned dialect at program start, before any statement is created:
+//
+//	sqlite.Dialect = sqlite.Dialect.WithVersion(3, 24)
 var Dialect = newDialect()
 
 func newDialect() jet.Dialect {
@@ -12,6 +16,8 @@ func newDialect() jet.Dialect {
 	operatorSerializeOverrides["IS DISTINCT FROM"] = sqlite_IS_DISTINCT_FROM
 	operatorSerializeOverrides["IS NOT DISTINCT FROM"] = sqlite_IS_NOT_DISTINCT_FROM
 	operatorSerializeOverrides["#"] = sqliteBitXOR
+	operatorSerializeOverrides["+"] = jet.RelativeIntervalOperator("+", sqliteDateArithmetic)
+	operatorSerializeOverrides["-"] = jet.RelativeIntervalOperator("-", sqliteDateArithmetic)
 
 	mySQLDialectParams := jet.DialectParams{
 		Name:                       "SQLite",
@@ -23,6 +29,15 @@ func newDialect() jet.Dialect {
 			return "?"
 		},
 		ReservedWords: reservedWords2,
+		FeatureVersions: map[jet.Feature]jet.Version{
+			jet.FeatureCommonTableExpressions: {Major: 3, Minor: 8},
+			jet.FeatureOnConflict:             {Major: 3, Minor: 24},
+			jet.FeatureWindowFunctions:        {Major: 3, Minor: 25},
+			jet.FeatureWindowFrameGroups:      {Major: 3, Minor: 28},
+			jet.FeatureWindowFrameExclusion:   {Major: 3, Minor: 28},
+			jet.FeatureFilterClause:           {Major: 3, Minor: 30},
+			jet.FeatureNullsOrdering:          {Major: 3, Minor: 30},
+		},
 	}
 
 	return jet.NewDialect(mySQLDialectParams)
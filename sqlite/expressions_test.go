@@ -3,6 +3,7 @@ package sqlite
 import (
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestRaw(t *testing.T) {
@@ -50,3 +51,11 @@ func TestRawType(t *testing.T) {
 	assertSerialize(t, RawString("table.colStr || str", RawArgs{"str": "doe"}).EQ(String("john doe")),
 		"((table.colStr || ?) = ?)", "doe", "john doe")
 }
+
+func TestBUCKET_BY(t *testing.T) {
+	assertSerialize(t, BUCKET_BY(table1ColTimestamp, time.Hour),
+		"DATETIME((strftime(?, table1.col_timestamp) / ?) * ?, ?)", "%s", int64(3600), int64(3600), "unixepoch")
+
+	assertPanicErr(t, func() { BUCKET_BY(table1ColTimestamp, time.Millisecond) },
+		"jet: BUCKET_BY bucket width has to be a positive whole number of seconds, got 1ms")
+}
@@ -15,6 +15,16 @@ var (
 	OR = jet.OR
 )
 
+// ConditionChain accumulates optional conditions, and combines them with AND or OR operator
+type ConditionChain = jet.ConditionChain
+
+var (
+	// AllOf creates new condition chain, which combines conditions with AND operator
+	AllOf = jet.AllOf
+	// AnyOf creates new condition chain, which combines conditions with OR operator
+	AnyOf = jet.AnyOf
+)
+
 // ROW is construct one table row from list of expressions.
 func ROW(expressions ...Expression) Expression {
 	return jet.NewFunc("", expressions, nil)
@@ -315,6 +325,15 @@ func STRFTIME(format StringExpression, timeValue interface{}, modifiers ...Expre
 	return jet.NewStringFunc("strftime", exprList...)
 }
 
+// BUCKET_BY groups time-values into buckets of bucketWidth duration, counting from unix epoch. Bucket width has to
+// be a positive whole number of seconds.
+func BUCKET_BY(timeValue interface{}, bucketWidth time.Duration) DateTimeExpression {
+	seconds := Int(jet.BucketWidthSeconds(bucketWidth))
+	epoch := IntExp(STRFTIME(String("%s"), timeValue))
+
+	return DATETIME(epoch.DIV(seconds).MUL(seconds), UNIXEPOCH)
+}
+
 func getFuncExprList(timeValue interface{}, modifiers ...Expression) []Expression {
 	return append([]Expression{getTimeValueExpression(timeValue)}, modifiers...)
 }
@@ -347,5 +366,9 @@ func UNIX_TIMESTAMP(str StringExpression) TimestampExpression {
 // EXISTS checks for existence of the rows in subQuery
 var EXISTS = jet.EXISTS
 
+// OVERLAPS_PERIOD returns true if half-open period [start, end) overlaps half-open period [from, to). Period start
+// or end NULL value is unbounded, and nil from or to is unbounded as well.
+var OVERLAPS_PERIOD = jet.OVERLAPS_PERIOD
+
 // CASE create CASE operator with optional list of expressions
 var CASE = jet.CASE
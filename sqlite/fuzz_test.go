@@ -0,0 +1,162 @@
+package sqlite
+
+import (
+	"database/sql"
+	"math/rand"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// expressionGenerator generates random, but valid, expression trees. Trees are generated from the seed, so
+// failed fuzz case can be reproduced with the same seed.
+type expressionGenerator struct {
+	rand     *rand.Rand
+	maxDepth int
+}
+
+func newExpressionGenerator(seed int64) *expressionGenerator {
+	return &expressionGenerator{
+		rand:     rand.New(rand.NewSource(seed)),
+		maxDepth: 4,
+	}
+}
+
+func (g *expressionGenerator) expression() Expression {
+	switch g.rand.Intn(3) {
+	case 0:
+		return g.intExpression(0)
+	case 1:
+		return g.stringExpression(0)
+	default:
+		return g.boolExpression(0)
+	}
+}
+
+func (g *expressionGenerator) leaf(depth int) bool {
+	return depth >= g.maxDepth || g.rand.Intn(3) == 0
+}
+
+func (g *expressionGenerator) intExpression(depth int) IntegerExpression {
+	if g.leaf(depth) {
+		return Int(int64(g.rand.Intn(100) - 50))
+	}
+
+	lhs, rhs := g.intExpression(depth+1), g.intExpression(depth+1)
+
+	switch g.rand.Intn(9) {
+	case 0:
+		return lhs.ADD(rhs)
+	case 1:
+		return lhs.SUB(rhs)
+	case 2:
+		return lhs.MUL(rhs)
+	case 3:
+		return lhs.DIV(rhs)
+	case 4:
+		return lhs.MOD(rhs)
+	case 5:
+		return lhs.BIT_AND(rhs).BIT_OR(lhs.BIT_XOR(rhs))
+	case 6:
+		return ABSi(lhs)
+	case 7:
+		return CAST(g.stringExpression(depth + 1)).AS_INTEGER()
+	default:
+		return IntExp(CASE().WHEN(g.boolExpression(depth + 1)).THEN(lhs).ELSE(rhs))
+	}
+}
+
+var fuzzStrings = []string{"", "a", "Jet", "it's", `back\slash`, "?", "$1", "--", "/*", "ćevapi", "\"quoted\""}
+
+func (g *expressionGenerator) stringExpression(depth int) StringExpression {
+	if g.leaf(depth) {
+		return String(fuzzStrings[g.rand.Intn(len(fuzzStrings))])
+	}
+
+	str := g.stringExpression(depth + 1)
+
+	switch g.rand.Intn(6) {
+	case 0:
+		return str.CONCAT(g.stringExpression(depth + 1))
+	case 1:
+		return LOWER(str)
+	case 2:
+		return UPPER(str)
+	case 3:
+		return REPLACE(str, g.stringExpression(depth+1), g.stringExpression(depth+1))
+	case 4:
+		return CAST(g.intExpression(depth + 1)).AS_TEXT()
+	default:
+		return StringExp(CASE().WHEN(g.boolExpression(depth + 1)).THEN(str).ELSE(g.stringExpression(depth + 1)))
+	}
+}
+
+func (g *expressionGenerator) boolExpression(depth int) BoolExpression {
+	if g.leaf(depth) {
+		return Bool(g.rand.Intn(2) == 0)
+	}
+
+	switch g.rand.Intn(11) {
+	case 0:
+		return g.boolExpression(depth + 1).AND(g.boolExpression(depth + 1))
+	case 1:
+		return g.boolExpression(depth + 1).OR(g.boolExpression(depth + 1))
+	case 2:
+		return NOT(g.boolExpression(depth + 1))
+	case 3:
+		return g.intExpression(depth + 1).EQ(g.intExpression(depth + 1))
+	case 4:
+		return g.intExpression(depth + 1).LT(g.intExpression(depth + 1))
+	case 5:
+		return g.intExpression(depth+1).BETWEEN(g.intExpression(depth+1), g.intExpression(depth+1))
+	case 6:
+		return g.intExpression(depth+1).IN(g.intExpression(depth+1), g.intExpression(depth+1))
+	case 7:
+		return g.stringExpression(depth + 1).IS_DISTINCT_FROM(g.stringExpression(depth + 1))
+	case 8:
+		return g.stringExpression(depth + 1).LIKE(g.stringExpression(depth + 1))
+	case 9:
+		return g.boolExpression(depth + 1).IS_NOT_TRUE()
+	default:
+		return g.intExpression(depth + 1).IS_NULL()
+	}
+}
+
+// assertExpressionRoundTrip asserts that statement selecting random expression is valid sql, that argument
+// placeholders match statement arguments, and that both parametrized and debug sql evaluate to the same value.
+func assertExpressionRoundTrip(t *testing.T, db *sql.DB, seed int64) {
+	stmt := SELECT(newExpressionGenerator(seed).expression().AS("result"))
+
+	query, args := stmt.Sql()
+	require.Equal(t, strings.Count(query, "?"), len(args), "seed: %d\n%s", seed, query)
+
+	var result, debugResult interface{}
+
+	err := db.QueryRow(query, args...).Scan(&result)
+	require.NoError(t, err, "seed: %d\n%s", seed, query)
+
+	debugQuery := stmt.DebugSql()
+
+	err = db.QueryRow(debugQuery).Scan(&debugResult)
+	require.NoError(t, err, "seed: %d\n%s", seed, debugQuery)
+
+	require.Equal(t, result, debugResult, "seed: %d\n%s", seed, debugQuery)
+}
+
+func openFuzzDB(t testing.TB) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestExpressionSerializerFuzz(t *testing.T) {
+	db := openFuzzDB(t)
+	defer db.Close()
+
+	for seed := int64(0); seed < 500; seed++ {
+		assertExpressionRoundTrip(t, db, seed)
+	}
+}
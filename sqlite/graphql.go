@@ -0,0 +1,22 @@
+package sqlite
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// GraphQLType maps GraphQL object type to a database table
+type GraphQLType = jet.GraphQLType
+
+// GraphQLRelation is a nested GraphQL object type, joined with the parent object type table using On condition
+type GraphQLRelation = jet.GraphQLRelation
+
+// GraphQLSelect returns projection list and FROM clause table for a GraphQL selection set of object type, so that
+// resolvers fetch only requested columns with a single query. Selection set is a list of field paths, where nested
+// object fields are separated with '.', for instance: []string{"firstName", "films.title", "films.language.name"}.
+// Only relations with requested fields are joined (LEFT JOIN). Unknown fields are ignored. Object type and relation
+// tables have to be ReadableTable.
+func GraphQLSelect(object GraphQLType, selection []string) (ProjectionList, ReadableTable) {
+	projections, from := object.Select(selection, func(from, table jet.Serializer, onCondition BoolExpression) jet.Serializer {
+		return from.(ReadableTable).LEFT_JOIN(table.(ReadableTable), onCondition)
+	})
+
+	return projections, from.(ReadableTable)
+}
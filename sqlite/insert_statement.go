@@ -1,6 +1,16 @@
 package sqlite
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// maxParameters is the maximum number of parameters SQLite (3.32+) accepts in a single statement
+const maxParameters = 32766
 
 // InsertStatement is interface for SQL INSERT statements
 type InsertStatement interface {
@@ -9,11 +19,24 @@ type InsertStatement interface {
 	VALUES(value interface{}, values ...interface{}) InsertStatement
 	MODEL(data interface{}) InsertStatement
 	MODELS(data interface{}) InsertStatement
+	// MODELS_CHUNKED inserts rows of values extracted from the slice of structures data, like MODELS. When executed,
+	// rows are split into chunks of at most chunkSize rows, each inserted with a separate statement, so that the
+	// statement parameter limit is not exceeded. If chunkSize is 0, or too big for the parameter limit, the maximum
+	// chunk size is used. If db is *sql.DB, chunks are inserted in a new transaction. Exec returns the total number
+	// of rows affected, and Query stores rows returned by all the chunks into destination slice.
+	MODELS_CHUNKED(data interface{}, chunkSize int) InsertStatement
 	QUERY(selectStatement SelectStatement) InsertStatement
 	DEFAULT_VALUES() InsertStatement
 
 	ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict
+	// ON_CONFLICT_EXPRESSION sets conflict target to the list of index columns and expressions, matching unique
+	// expression index, for instance ON_CONFLICT_EXPRESSION(LOWER(User.Email))
+	ON_CONFLICT_EXPRESSION(indexExpressions ...Expression) onConflict
 	RETURNING(projections ...Projection) InsertStatement
+
+	// ExecAndReturnID executes single row INSERT statement, and returns rowid (INTEGER PRIMARY KEY column value) of
+	// the inserted row
+	ExecAndReturnID(ctx context.Context, db qrm.Executable) (int64, error)
 }
 
 func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
@@ -44,6 +67,8 @@ type insertStatementImpl struct {
 	DefaultValues jet.ClauseOptional
 	OnConflict    onConflictClause
 	Returning     jet.ClauseReturning
+
+	chunks jet.InsertChunks
 }
 
 func (is *insertStatementImpl) VALUES(value interface{}, values ...interface{}) InsertStatement {
@@ -63,6 +88,42 @@ func (is *insertStatementImpl) MODELS(data interface{}) InsertStatement {
 	return is
 }
 
+func (is *insertStatementImpl) MODELS_CHUNKED(data interface{}, chunkSize int) InsertStatement {
+	is.MODELS(data)
+	is.chunks.Size = jet.ChunkSize(chunkSize, len(is.Insert.GetColumns()), maxParameters)
+	return is
+}
+
+func (is *insertStatementImpl) ExecAndReturnID(ctx context.Context, db qrm.Executable) (int64, error) {
+	if len(is.ValuesQuery.Rows) > 1 {
+		return 0, errors.New("jet: ExecAndReturnID requires single row INSERT statement")
+	}
+
+	res, err := is.ExecContext(ctx, db)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return res.LastInsertId()
+}
+
+func (is *insertStatementImpl) Exec(db qrm.Executable) (sql.Result, error) {
+	return is.ExecContext(context.Background(), db)
+}
+
+func (is *insertStatementImpl) ExecContext(ctx context.Context, db qrm.Executable) (sql.Result, error) {
+	return is.chunks.ExecInChunks(ctx, db, is.SerializerStatement, &is.ValuesQuery.ClauseValues)
+}
+
+func (is *insertStatementImpl) Query(db qrm.Queryable, destination interface{}) error {
+	return is.QueryContext(context.Background(), db, destination)
+}
+
+func (is *insertStatementImpl) QueryContext(ctx context.Context, db qrm.Queryable, destination interface{}) error {
+	return is.chunks.QueryInChunks(ctx, db, is.SerializerStatement, &is.ValuesQuery.ClauseValues, destination)
+}
+
 func (is *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertStatement {
 	is.ValuesQuery.Query = selectStatement
 	return is
@@ -79,6 +140,14 @@ func (is *insertStatementImpl) RETURNING(projections ...jet.Projection) InsertSt
 }
 
 func (is *insertStatementImpl) ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict {
+	is.OnConflict = onConflictClause{
+		insertStatement:  is,
+		indexExpressions: jet.ColumnListToExpressionList(indexExpressions),
+	}
+	return &is.OnConflict
+}
+
+func (is *insertStatementImpl) ON_CONFLICT_EXPRESSION(indexExpressions ...Expression) onConflict {
 	is.OnConflict = onConflictClause{
 		insertStatement:  is,
 		indexExpressions: indexExpressions,
@@ -1,6 +1,8 @@
 package sqlite
 
 import (
+	"context"
+	"database/sql"
 	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
@@ -148,3 +150,38 @@ RETURNING table1.col1 AS "table1.col1",
           table1.col_bool AS "table1.col_bool";
 `)
 }
+
+func TestInsert_ON_CONFLICT_EXPRESSION(t *testing.T) {
+	stmt := table2.INSERT(table2ColInt, table2ColStr).
+		VALUES(1, "Email@Example.com").
+		ON_CONFLICT_EXPRESSION(table2ColInt, LOWER(table2ColStr)).DO_NOTHING()
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table2 (col_int, col_str)
+VALUES (?, ?)
+ON CONFLICT (col_int, (LOWER(table2.col_str))) DO NOTHING;
+`, 1, "Email@Example.com")
+}
+
+func TestInsertExecAndReturnID(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("CREATE TABLE item (id INTEGER PRIMARY KEY, name TEXT)")
+	require.NoError(t, err)
+
+	itemName := StringColumn("name")
+	item := NewTable("", "item", "", IntegerColumn("id"), itemName)
+
+	id, err := item.INSERT(itemName).VALUES("first").ExecAndReturnID(context.Background(), db)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), id)
+
+	id, err = item.INSERT(itemName).VALUES("second").ExecAndReturnID(context.Background(), db)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), id)
+
+	_, err = item.INSERT(itemName).VALUES("a").VALUES("b").ExecAndReturnID(context.Background(), db)
+	require.EqualError(t, err, "jet: ExecAndReturnID requires single row INSERT statement")
+}
@@ -0,0 +1,24 @@
+package sqlite
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// References is a read-only description of tables, columns and predicates statement references
+type References = jet.References
+
+// TableReference is a database table referenced by the statement
+type TableReference = jet.TableReference
+
+// ColumnReference is a table column referenced by the statement
+type ColumnReference = jet.ColumnReference
+
+// ConjunctFilter is a column compared for equality with literal values in the top level AND conjuncts of WHERE condition
+type ConjunctFilter = jet.ConjunctFilter
+
+// Predicate is a column comparison used in the statement conditions
+type Predicate = jet.Predicate
+
+// Inspect returns tables referenced, columns read and written, and predicates used by the statement, without
+// parsing sql text. It can be used by middleware to construct cache keys, check authorization or fingerprint queries.
+func Inspect(statement PrintableStatement) References {
+	return jet.StatementReferences(Dialect, statement)
+}
@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"fmt"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// Interval is representation of relative interval, which can be added to or subtracted from date and time
+// expressions. For example: CURRENT_TIMESTAMP().SUB(Days(30)) is serialized as DATETIME(CURRENT_TIMESTAMP, '-30 DAYS')
+type Interval = jet.Interval
+
+// Years creates interval of years
+func Years(years int64) Interval {
+	return jet.NewRelativeInterval(years, "YEARS")
+}
+
+// Months creates interval of months
+func Months(months int64) Interval {
+	return jet.NewRelativeInterval(months, "MONTHS")
+}
+
+// Weeks creates interval of weeks
+func Weeks(weeks int64) Interval {
+	return jet.NewRelativeInterval(7*weeks, "DAYS")
+}
+
+// Days creates interval of days
+func Days(days int64) Interval {
+	return jet.NewRelativeInterval(days, "DAYS")
+}
+
+// Hours creates interval of hours
+func Hours(hours int64) Interval {
+	return jet.NewRelativeInterval(hours, "HOURS")
+}
+
+// Minutes creates interval of minutes
+func Minutes(minutes int64) Interval {
+	return jet.NewRelativeInterval(minutes, "MINUTES")
+}
+
+// Seconds creates interval of seconds
+func Seconds(seconds int64) Interval {
+	return jet.NewRelativeInterval(seconds, "SECONDS")
+}
+
+// sqliteDateArithmetic adds interval to date with DATETIME time-value modifier
+func sqliteDateArithmetic(date Expression, interval jet.RelativeInterval) jet.Serializer {
+	return DATETIME(date, String(fmt.Sprintf("%+d %s", interval.Quantity, interval.Unit)))
+}
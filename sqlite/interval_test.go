@@ -0,0 +1,40 @@
+package sqlite
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelativeInterval(t *testing.T) {
+	assertSerialize(t, CURRENT_TIMESTAMP().SUB(Days(30)), "(DATETIME(CURRENT_TIMESTAMP, ?))", "-30 DAYS")
+	assertDebugSerialize(t, CURRENT_DATE().ADD(Months(1)), "(DATETIME(CURRENT_DATE, '+1 MONTHS'))")
+	assertDebugSerialize(t, table1ColTimestamp.ADD(Years(2)), "(DATETIME(table1.col_timestamp, '+2 YEARS'))")
+	assertDebugSerialize(t, table1ColDate.SUB(Weeks(2)), "(DATETIME(table1.col_date, '-14 DAYS'))")
+	assertDebugSerialize(t, table1ColTimestamp.ADD(Hours(3)).ADD(Minutes(4)).SUB(Seconds(5)),
+		"(DATETIME(DATETIME(DATETIME(table1.col_timestamp, '+3 HOURS'), '+4 MINUTES'), '-5 SECONDS'))")
+
+	assertSerialize(t, table1ColInt.ADD(Int(1)).SUB(Int(2)), "((table1.col_int + ?) - ?)", int64(1), int64(2))
+	assertSerializeErr(t, Days(1), "jet: SQLite interval can only be added to or subtracted from date and time expressions")
+}
+
+func TestRelativeIntervalQuery(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	var dest struct {
+		MonthLater string
+		WeekBefore string
+	}
+
+	err = SELECT(
+		DateTime(2024, 1, 31, 10, 0, 0).ADD(Months(1)).AS("month_later"),
+		DateTime(2024, 1, 31, 10, 0, 0).SUB(Weeks(1)).AS("week_before"),
+	).Query(db, &dest)
+
+	require.NoError(t, err)
+	require.Equal(t, "2024-03-02 10:00:00", dest.MonthLater)
+	require.Equal(t, "2024-01-24 10:00:00", dest.WeekBefore)
+}
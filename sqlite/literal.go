@@ -54,6 +54,12 @@ var String = jet.String
 // value can be any uuid type with a String method
 var UUID = jet.UUID
 
+// FixedLiteral creates new literal expression which value is inlined directly into the sql query, instead
+// of being passed as a parametrized argument. Useful for stable constants (enum discriminators, booleans,
+// small integers) that should be visible to the query planner, for instance to match a partial index.
+// Use it only for values known at compile time, never for user input.
+var FixedLiteral = jet.FixedLiteral
+
 // Date creates new date literal expression
 func Date(year int, month time.Month, day int) DateExpression {
 	return DATE(jet.Date(year, month, day))
@@ -78,3 +78,10 @@ func TestDateTime(t *testing.T) {
 	assertSerialize(t, DateTime(2010, time.March, 30, 10, 15, 30), `DATETIME(?)`, "2010-03-30 10:15:30")
 	assertSerialize(t, DATETIME(testTime), `DATETIME(?)`, testTime)
 }
+
+func TestFixedLiteral(t *testing.T) {
+	assertSerialize(t, FixedLiteral(true), `TRUE`)
+	assertSerialize(t, FixedLiteral(11), `11`)
+	assertSerialize(t, FixedLiteral("active"), `'active'`)
+	assertSerialize(t, table1ColBool.EQ(BoolExp(FixedLiteral(false))), "(table1.col_bool = FALSE)")
+}
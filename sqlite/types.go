@@ -23,6 +23,10 @@ type OrderByClause = jet.OrderByClause
 // GroupByClause interface to use as input for GROUP_BY
 type GroupByClause = jet.GroupByClause
 
+// SelectClauses is a set of SELECT statement clauses, which can be constructed and merged separately from the
+// statement, and applied to the statement with SelectStatement APPLY method
+type SelectClauses = jet.SelectClauses
+
 // SetLogger sets automatic statement logging.
 // Deprecated: use SetQueryLogger instead.
 var SetLogger = jet.SetLoggerFunc
@@ -30,5 +34,160 @@ var SetLogger = jet.SetLoggerFunc
 // SetQueryLogger sets automatic query logging function.
 var SetQueryLogger = jet.SetQueryLogger
 
+// WithQueryLogger returns context, which makes statements executed with it call query logger function after
+// execution, in addition to the query logger set with SetQueryLogger.
+var WithQueryLogger = jet.WithQueryLogger
+
 // QueryInfo contains information about executed query
 type QueryInfo = jet.QueryInfo
+
+// Tracer starts a span around each statement execution. It can be implemented over OpenTelemetry or any other
+// tracing library.
+type Tracer = jet.Tracer
+
+// Span is a statement execution span started by Tracer
+type Span = jet.Span
+
+// SpanInfo contains information about statement to be executed
+type SpanInfo = jet.SpanInfo
+
+// SetTracer sets tracer used to start a span around each statement execution
+var SetTracer = jet.SetTracer
+
+// NewConnector wraps database driver connector, so that all the queries executed over its connections, including
+// queries not built with jet, are reported to the global query logger and tracer.
+var NewConnector = jet.NewConnector
+
+// NewDriverConnector is the same as NewConnector, for drivers opened with data source name.
+var NewDriverConnector = jet.NewDriverConnector
+
+// DeleteByKeysOptions contains optional settings of DeleteByKeys
+type DeleteByKeysOptions = jet.DeleteByKeysOptions
+
+// DeleteProgress contains progress information of DeleteByKeys, reported with DeleteByKeysOptions Progress function
+type DeleteProgress = jet.DeleteProgress
+
+// SetColumnAccessPolicy sets column access policy applied on statement execution. Columns caller can not access are
+// excluded from SELECT projections, and statements writing into such columns are rejected with an error.
+var SetColumnAccessPolicy = jet.SetColumnAccessPolicy
+
+// SetInjectionAudit turns sql injection audit on or off. When turned on, execution of statements containing values
+// marked as tainted (see Taint) in raw sql fragments is rejected with an error.
+var SetInjectionAudit = jet.SetInjectionAudit
+
+// SetStatementLocation turns statement location capture on or off. When turned on, errors returned from statement
+// execution are annotated with file and line where the statement is constructed.
+var SetStatementLocation = jet.SetStatementLocation
+
+// Taint returns new context in which values are marked as tainted (user supplied), so they are not allowed to appear
+// in raw sql fragments of statements executed with this context, if injection audit is turned on.
+var Taint = jet.Taint
+
+// WithSchemaParams returns new context with values of schema name placeholders (for instance "{{tenant}}"), which
+// are replaced in schema names of tables and views of statements executed with this context.
+var WithSchemaParams = jet.WithSchemaParams
+
+// DeadlineLimit wraps db connection or transaction, so that top level SELECT statements executed over it with a
+// context which deadline expires in less than threshold, return at most safetyLimit rows.
+var DeadlineLimit = jet.DeadlineLimit
+
+// StmtCache is a db connection or transaction wrapper, which prepares each distinct query once and reuses prepared
+// statement for all subsequent executions of the same query
+type StmtCache = jet.StmtCache
+
+// StmtCacheStats contains prepared statement cache metrics
+type StmtCacheStats = jet.StmtCacheStats
+
+// WithStmtCache wraps db connection or transaction, so that statements executed over it are prepared once and
+// reused, keyed by the statement sql text. At most maxSize statements are kept prepared, least recently used
+// statement is closed when the limit is reached.
+var WithStmtCache = jet.WithStmtCache
+
+// QueryRecordBatches executes statement and streams result set into columnar record batches, which can be handed
+// to apache arrow builders or parquet writers.
+var QueryRecordBatches = jet.QueryRecordBatches
+
+// SerializeError is returned by statement execution methods, instead of panic, when statement can not be serialized
+// into sql query
+type SerializeError = jet.SerializeError
+
+// Config contains statement execution settings, like loggers and column access policy
+type Config = jet.Config
+
+// WithConfig wraps db connection or transaction, so that statements executed over it use config instead of global
+// configuration.
+var WithConfig = jet.WithConfig
+
+// GlobalConfig returns configuration used to execute statements over db connections not wrapped with WithConfig
+var GlobalConfig = jet.GlobalConfig
+
+// SetGlobalConfig replaces configuration used to execute statements over db connections not wrapped with WithConfig
+var SetGlobalConfig = jet.SetGlobalConfig
+
+// TxOptions contains defaults (timeout, sql comment and interceptors) applied to all the statements executed in a
+// transaction wrapped with WrapTx
+type TxOptions = jet.TxOptions
+
+// StatementInterceptor is called before statement execution. Statement is not executed if interceptor returns an error.
+type StatementInterceptor = jet.StatementInterceptor
+
+// Tx binds statements to a transaction, for instance: WrapTx(tx, TxOptions{...}).Wrap(stmt).Query(ctx, &dest)
+type Tx = jet.Tx
+
+// WrapTx creates new Tx over transaction tx with options applied to all the statements executed in the transaction
+var WrapTx = jet.WrapTx
+
+// RewritePlugin transforms statement clauses before statement serialization
+type RewritePlugin = jet.RewritePlugin
+
+// RegisterRewritePlugin registers statement rewrite plugin applied to all the statements of this dialect.
+// Registering a plugin with the name of already registered plugin replaces it.
+func RegisterRewritePlugin(plugin RewritePlugin) {
+	jet.RegisterRewritePlugin(Dialect, plugin)
+}
+
+// UnregisterRewritePlugin removes statement rewrite plugin with the name
+func UnregisterRewritePlugin(name string) {
+	jet.UnregisterRewritePlugin(Dialect, name)
+}
+
+// SetIdentifierQuoting overrides quoting of identifier name (table, schema or column name). If quote is true,
+// identifier is always quoted, and if it is false identifier is never quoted, regardless of the dialect reserved
+// words list and the default quoting rules. Name is matched case-sensitively.
+func SetIdentifierQuoting(name string, quote bool) {
+	jet.SetIdentifierQuoting(Dialect, name, quote)
+}
+
+// ResetIdentifierQuoting removes quoting override of identifier name
+func ResetIdentifierQuoting(name string) {
+	jet.ResetIdentifierQuoting(Dialect, name)
+}
+
+// StatementType is type of the SQL statement, passed to rewrite plugins
+type StatementType = jet.StatementType
+
+// Statement types
+const (
+	SelectStatementType = jet.SelectStatementType
+	InsertStatementType = jet.InsertStatementType
+	UpdateStatementType = jet.UpdateStatementType
+	DeleteStatementType = jet.DeleteStatementType
+)
+
+// Clause is a part of the statement, like SELECT, FROM or WHERE clause, which rewrite plugins can transform
+type Clause = jet.Clause
+
+// ClauseSelect is SELECT clause
+type ClauseSelect = jet.ClauseSelect
+
+// ClauseFrom is FROM clause
+type ClauseFrom = jet.ClauseFrom
+
+// ClauseWhere is WHERE clause
+type ClauseWhere = jet.ClauseWhere
+
+// ClauseOrderBy is ORDER BY clause
+type ClauseOrderBy = jet.ClauseOrderBy
+
+// ClauseLimit is LIMIT clause
+type ClauseLimit = jet.ClauseLimit
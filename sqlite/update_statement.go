@@ -1,6 +1,11 @@
 package sqlite
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
 
 // UpdateStatement is interface of SQL UPDATE statement
 type UpdateStatement interface {
@@ -76,3 +81,41 @@ func (u *updateStatementImpl) RETURNING(projections ...Projection) UpdateStateme
 	u.Returning.ProjectionList = projections
 	return u
 }
+
+// copy returns new update statement with the copy of the statement clauses, so that the clauses of the new statement
+// can be changed without affecting the original statement
+func (u *updateStatementImpl) copy() *updateStatementImpl {
+	update := &updateStatementImpl{
+		Update:    u.Update,
+		From:      u.From,
+		Set:       u.Set,
+		SetNew:    u.SetNew,
+		Where:     u.Where,
+		Returning: u.Returning,
+	}
+	update.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, update,
+		&update.Update,
+		&update.Set,
+		&update.SetNew,
+		&update.From,
+		&update.Where,
+		&update.Returning)
+
+	return update
+}
+
+// UpdateAndReload executes update statement with RETURNING clause listing all the columns of the updated table, and
+// refreshes model (pointer to struct, or pointer to slice for multiple rows update) with the updated row values.
+// Statement RETURNING clause, if any, is ignored.
+func UpdateAndReload(ctx context.Context, db qrm.Queryable, stmt UpdateStatement, model interface{}) error {
+	update, ok := stmt.(*updateStatementImpl)
+
+	if !ok {
+		panic("jet: UpdateAndReload requires UPDATE statement created with table UPDATE method")
+	}
+
+	reload := update.copy()
+	reload.Returning.ProjectionList = update.Update.Projections()
+
+	return reload.QueryContext(ctx, db, model)
+}
@@ -0,0 +1,31 @@
+package sqlserver
+
+import (
+	"context"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// maxParameters is the maximum number of parameters SQL Server accepts in a single statement
+const maxParameters = 2100
+
+// DeleteByKeys deletes table rows which keyColumn value is one of keys (a slice of values or expressions), with
+// DELETE statements of at most batchSize keys each. If batchSize is 0, or if it exceeds the number of parameters
+// SQL Server accepts in a single statement, the maximum number of keys is used. All the batches are deleted in a new
+// transaction (or in a transaction per batch, with options TxPerBatch set), retried on deadlock (1205) or lock
+// request timeout (1222) error up to options MaxRetries times. Returns the number of rows deleted, including batches
+// committed before an error.
+func DeleteByKeys(ctx context.Context, db qrm.DB, table Table, keyColumn Column, keys interface{}, batchSize int,
+	options ...DeleteByKeysOptions) (rowsDeleted int64, err error) {
+
+	newDelete := func(keys []jet.Expression) jet.Statement {
+		return table.DELETE().WHERE(keyColumn.IN(keys...))
+	}
+
+	return jet.DeleteByKeys(ctx, db, keys, jet.ChunkSize(batchSize, 1, maxParameters), newDelete, isRetryableError, options...)
+}
+
+func isRetryableError(err error) bool {
+	return jet.IsSQLStateError(err, nil, []string{"was deadlocked on lock", "Lock request time out period exceeded"})
+}
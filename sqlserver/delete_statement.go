@@ -0,0 +1,47 @@
+package sqlserver
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// DeleteStatement is interface for SQL Server DELETE statement
+type DeleteStatement interface {
+	Statement
+
+	// OUTPUT returns projections of deleted rows. Columns are read from DELETED pseudo table.
+	OUTPUT(projections ...Projection) DeleteStatement
+	WHERE(expression BoolExpression) DeleteStatement
+}
+
+type deleteStatementImpl struct {
+	jet.SerializerStatement
+
+	Delete jet.ClauseStatementBegin
+	Output jet.ClauseOutput
+	Where  jet.ClauseWhere
+}
+
+func newDeleteStatement(table Table) DeleteStatement {
+	newDelete := &deleteStatementImpl{
+		Output: jet.ClauseOutput{Prefix: "DELETED"},
+	}
+	newDelete.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DeleteStatementType, newDelete,
+		&newDelete.Delete,
+		&newDelete.Output,
+		&newDelete.Where,
+	)
+
+	newDelete.Delete.Name = "DELETE FROM"
+	newDelete.Delete.Tables = append(newDelete.Delete.Tables, table)
+	newDelete.Where.Mandatory = true
+
+	return newDelete
+}
+
+func (d *deleteStatementImpl) OUTPUT(projections ...Projection) DeleteStatement {
+	d.Output.ProjectionList = projections
+	return d
+}
+
+func (d *deleteStatementImpl) WHERE(expression BoolExpression) DeleteStatement {
+	d.Where.Condition = expression
+	return d
+}
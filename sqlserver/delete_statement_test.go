@@ -0,0 +1,18 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestDeleteUnconditionally(t *testing.T) {
+	assertStatementSqlErr(t, table1.DELETE(), `jet: WHERE clause not set`)
+}
+
+func TestDeleteOutput(t *testing.T) {
+	assertStatementSql(t, table1.DELETE().OUTPUT(ColumnList{table1Col1, table1ColString}).WHERE(table1Col1.EQ(Int(1))), `
+DELETE FROM dbo.table1
+OUTPUT DELETED.col1 AS [table1.col1],
+       DELETED.col_string AS [table1.col_string]
+WHERE table1.col1 = @p1;
+`, int64(1))
+}
@@ -0,0 +1,278 @@
+package sqlserver
+
+import (
+	"strconv"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// Dialect is implementation of SQL Builder for SQL Server databases.
+var Dialect = newDialect()
+
+func newDialect() jet.Dialect {
+	operatorSerializeOverrides := map[string]jet.SerializeOverride{}
+	operatorSerializeOverrides[jet.StringConcatOperator] = sqlserverCONCAToperator
+	operatorSerializeOverrides["#"] = sqlserverBitXor
+	operatorSerializeOverrides["IS TRUE"] = sqlserverBitTest("= 1")
+	operatorSerializeOverrides["IS NOT TRUE"] = sqlserverBitTest("IS NULL OR", "= 0")
+	operatorSerializeOverrides["IS FALSE"] = sqlserverBitTest("= 0")
+	operatorSerializeOverrides["IS NOT FALSE"] = sqlserverBitTest("IS NULL OR", "= 1")
+	operatorSerializeOverrides["IS UNKNOWN"] = sqlserverBitTest("IS NULL")
+	operatorSerializeOverrides["IS NOT UNKNOWN"] = sqlserverBitTest("IS NOT NULL")
+	operatorSerializeOverrides["+"] = jet.RelativeIntervalOperator("+", sqlserverDateArithmetic)
+	operatorSerializeOverrides["-"] = jet.RelativeIntervalOperator("-", sqlserverDateArithmetic)
+
+	sqlServerDialectParams := jet.DialectParams{
+		Name:                       "SQLServer",
+		PackageName:                "sqlserver",
+		OperatorSerializeOverrides: operatorSerializeOverrides,
+		AliasQuoteChar:             '[',
+		IdentifierQuoteChar:        '[',
+		ArgumentPlaceholder: func(ord int) string {
+			return "@p" + strconv.Itoa(ord)
+		},
+		ReservedWords:       reservedWords,
+		UnsupportedFeatures: []jet.Feature{jet.FeatureNullsOrdering, jet.FeatureBoolLiterals},
+	}
+
+	return jet.NewDialect(sqlServerDialectParams)
+}
+
+func sqlserverCONCAToperator(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator CONCAT")
+		}
+
+		out.WriteString("CONCAT(")
+		jet.Serialize(expressions[0], statement, out, options...)
+		out.WriteString(", ")
+		jet.Serialize(expressions[1], statement, out, options...)
+		out.WriteString(")")
+	}
+}
+
+func sqlserverBitXor(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator XOR")
+		}
+
+		jet.Serialize(expressions[0], statement, out, options...)
+		out.WriteString("^")
+		jet.Serialize(expressions[1], statement, out, options...)
+	}
+}
+
+// sqlserverBitTest serializes IS TRUE, IS FALSE and IS UNKNOWN operators, which T-SQL does not support, as
+// comparisons of BIT expression. With two tests, expression is tested with both and the tests are joined.
+func sqlserverBitTest(tests ...string) jet.SerializeOverride {
+	return func(expressions ...jet.Serializer) jet.SerializerFunc {
+		return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+			if len(expressions) < 1 {
+				panic("jet: invalid number of expressions for operator")
+			}
+
+			if len(tests) > 1 {
+				out.WriteByte('(')
+			}
+
+			for _, test := range tests {
+				jet.Serialize(expressions[0], statement, out, options...)
+				out.WriteString(test)
+			}
+
+			if len(tests) > 1 {
+				out.WriteByte(')')
+			}
+		}
+	}
+}
+
+var reservedWords = []string{
+	"ADD",
+	"ALL",
+	"ALTER",
+	"AND",
+	"ANY",
+	"AS",
+	"ASC",
+	"AUTHORIZATION",
+	"BACKUP",
+	"BEGIN",
+	"BETWEEN",
+	"BREAK",
+	"BROWSE",
+	"BULK",
+	"BY",
+	"CASCADE",
+	"CASE",
+	"CHECK",
+	"CHECKPOINT",
+	"CLOSE",
+	"CLUSTERED",
+	"COALESCE",
+	"COLLATE",
+	"COLUMN",
+	"COMMIT",
+	"COMPUTE",
+	"CONSTRAINT",
+	"CONTAINS",
+	"CONTAINSTABLE",
+	"CONTINUE",
+	"CONVERT",
+	"CREATE",
+	"CROSS",
+	"CURRENT",
+	"CURRENT_DATE",
+	"CURRENT_TIME",
+	"CURRENT_TIMESTAMP",
+	"CURRENT_USER",
+	"CURSOR",
+	"DATABASE",
+	"DBCC",
+	"DEALLOCATE",
+	"DECLARE",
+	"DEFAULT",
+	"DELETE",
+	"DENY",
+	"DESC",
+	"DISK",
+	"DISTINCT",
+	"DISTRIBUTED",
+	"DOUBLE",
+	"DROP",
+	"DUMP",
+	"ELSE",
+	"END",
+	"ERRLVL",
+	"ESCAPE",
+	"EXCEPT",
+	"EXEC",
+	"EXECUTE",
+	"EXISTS",
+	"EXIT",
+	"EXTERNAL",
+	"FETCH",
+	"FILE",
+	"FILLFACTOR",
+	"FOR",
+	"FOREIGN",
+	"FREETEXT",
+	"FREETEXTTABLE",
+	"FROM",
+	"FULL",
+	"FUNCTION",
+	"GOTO",
+	"GRANT",
+	"GROUP",
+	"HAVING",
+	"HOLDLOCK",
+	"IDENTITY",
+	"IDENTITY_INSERT",
+	"IDENTITYCOL",
+	"IF",
+	"IN",
+	"INDEX",
+	"INNER",
+	"INSERT",
+	"INTERSECT",
+	"INTO",
+	"IS",
+	"JOIN",
+	"KEY",
+	"KILL",
+	"LEFT",
+	"LIKE",
+	"LINENO",
+	"LOAD",
+	"MERGE",
+	"NATIONAL",
+	"NOCHECK",
+	"NONCLUSTERED",
+	"NOT",
+	"NULL",
+	"NULLIF",
+	"OF",
+	"OFF",
+	"OFFSETS",
+	"ON",
+	"OPEN",
+	"OPENDATASOURCE",
+	"OPENQUERY",
+	"OPENROWSET",
+	"OPENXML",
+	"OPTION",
+	"OR",
+	"ORDER",
+	"OUTER",
+	"OVER",
+	"PERCENT",
+	"PIVOT",
+	"PLAN",
+	"PRECISION",
+	"PRIMARY",
+	"PRINT",
+	"PROC",
+	"PROCEDURE",
+	"PUBLIC",
+	"RAISERROR",
+	"READ",
+	"READTEXT",
+	"RECONFIGURE",
+	"REFERENCES",
+	"REPLICATION",
+	"RESTORE",
+	"RESTRICT",
+	"RETURN",
+	"REVERT",
+	"REVOKE",
+	"RIGHT",
+	"ROLLBACK",
+	"ROWCOUNT",
+	"ROWGUIDCOL",
+	"RULE",
+	"SAVE",
+	"SCHEMA",
+	"SECURITYAUDIT",
+	"SELECT",
+	"SEMANTICKEYPHRASETABLE",
+	"SEMANTICSIMILARITYDETAILSTABLE",
+	"SEMANTICSIMILARITYTABLE",
+	"SESSION_USER",
+	"SET",
+	"SETUSER",
+	"SHUTDOWN",
+	"SOME",
+	"STATISTICS",
+	"SYSTEM_USER",
+	"TABLE",
+	"TABLESAMPLE",
+	"TEXTSIZE",
+	"THEN",
+	"TO",
+	"TOP",
+	"TRAN",
+	"TRANSACTION",
+	"TRIGGER",
+	"TRUNCATE",
+	"TRY_CONVERT",
+	"TSEQUAL",
+	"UNION",
+	"UNIQUE",
+	"UNPIVOT",
+	"UPDATE",
+	"UPDATETEXT",
+	"USE",
+	"USER",
+	"VALUES",
+	"VARYING",
+	"VIEW",
+	"WAITFOR",
+	"WHEN",
+	"WHERE",
+	"WHILE",
+	"WITH",
+	"WITHIN GROUP",
+	"WRITETEXT",
+}
@@ -0,0 +1,57 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestArgumentPlaceholders(t *testing.T) {
+	assertSerialize(t, table1ColInt.ADD(Int(2)).MUL(Int(3)), "((table1.col_int + @p1) * @p2)", int64(2), int64(3))
+}
+
+func TestQuotedIdentifiers(t *testing.T) {
+	userColumn := IntegerColumn("User")
+	table := NewTable("dbo", "Order", "", userColumn)
+
+	assertStatementSql(t, SELECT(userColumn).FROM(table), `
+SELECT [Order].[User] AS [Order.User]
+FROM dbo.[Order];
+`)
+}
+
+func TestStringConcat(t *testing.T) {
+	assertSerialize(t, table1ColString.CONCAT(String("suffix")), "(CONCAT(table1.col_string, @p1))", "suffix")
+}
+
+func TestIntExpressionBIT_XOR(t *testing.T) {
+	assertSerialize(t, table1ColInt.BIT_XOR(table2ColInt), "(table1.col_int ^ table2.col_int)")
+}
+
+func TestDateTimeLiteral(t *testing.T) {
+	assertDebugSerialize(t, DateTime(2020, 2, 3, 4, 5, 6), "CAST('2020-02-03 04:05:06' AS DATETIME2)")
+}
+
+func TestRelativeInterval(t *testing.T) {
+	assertSerialize(t, GETDATE().SUB(Days(30)), "(DATEADD(DAY, @p1, GETDATE()))", int64(-30))
+	assertDebugSerialize(t, table1ColTimestamp.ADD(Months(1)), "(DATEADD(MONTH, 1, table1.col_timestamp))")
+	assertDebugSerialize(t, table1ColTimestamp.ADD(Years(2)).SUB(Weeks(3)),
+		"(DATEADD(WEEK, -3, DATEADD(YEAR, 2, table1.col_timestamp)))")
+	assertDebugSerialize(t, table1ColTimestamp.ADD(Hours(1)).ADD(Minutes(2)).ADD(Seconds(3)),
+		"(DATEADD(SECOND, 3, DATEADD(MINUTE, 2, DATEADD(HOUR, 1, table1.col_timestamp))))")
+	assertSerialize(t, table1ColInt.ADD(Int(1)), "(table1.col_int + @p1)", int64(1))
+}
+
+func TestBoolExpressionIsTrue(t *testing.T) {
+	assertSerialize(t, table1ColBool.IS_TRUE(), "table1.col_bool = 1")
+	assertSerialize(t, table1ColBool.IS_NOT_TRUE(), "(table1.col_bool IS NULL OR table1.col_bool = 0)")
+	assertSerialize(t, table1ColBool.IS_FALSE(), "table1.col_bool = 0")
+	assertSerialize(t, table1ColBool.IS_NOT_FALSE(), "(table1.col_bool IS NULL OR table1.col_bool = 1)")
+	assertSerialize(t, table1ColBool.IS_UNKNOWN(), "table1.col_bool IS NULL")
+	assertSerialize(t, table1ColBool.IS_NOT_UNKNOWN(), "table1.col_bool IS NOT NULL")
+}
+
+func TestBoolLiteral(t *testing.T) {
+	assertSerialize(t, table1ColBool.EQ(Bool(true)), "(table1.col_bool = @p1)", true)
+	assertDebugSerialize(t, table1ColBool.EQ(Bool(true)), "(table1.col_bool = 1)")
+	assertDebugSerialize(t, table1ColBool.EQ(Bool(false)), "(table1.col_bool = 0)")
+	assertSerialize(t, table1ColBool.EQ(BoolExp(FixedLiteral(true))), "(table1.col_bool = 1)")
+}
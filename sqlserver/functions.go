@@ -0,0 +1,221 @@
+package sqlserver
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// This functions can be used, instead of its method counterparts, to have a better indentation of a complex condition
+// in the Go code and in the generated SQL.
+var (
+	// AND function adds AND operator between expressions.
+	AND = jet.AND
+	// OR function adds OR operator between expressions.
+	OR = jet.OR
+)
+
+// ConditionChain accumulates optional conditions, and combines them with AND or OR operator
+type ConditionChain = jet.ConditionChain
+
+var (
+	// AllOf creates new condition chain, which combines conditions with AND operator
+	AllOf = jet.AllOf
+	// AnyOf creates new condition chain, which combines conditions with OR operator
+	AnyOf = jet.AnyOf
+)
+
+// ------------------ Mathematical functions ---------------//
+
+// ABSf calculates absolute value from float expression
+var ABSf = jet.ABSf
+
+// ABSi calculates absolute value from int expression
+var ABSi = jet.ABSi
+
+// POWER calculates power of base with exponent
+var POWER = jet.POWER
+
+// SQRT calculates square root of numeric expression
+var SQRT = jet.SQRT
+
+// CEILING calculates ceiling of float expression
+func CEILING(floatExpression FloatExpression) FloatExpression {
+	return jet.NewFloatFunc("CEILING", floatExpression)
+}
+
+// FLOOR calculates floor of float expression
+var FLOOR = jet.FLOOR
+
+// ROUND calculates round of a float expressions with optional precision
+var ROUND = jet.ROUND
+
+// SIGN returns sign of float expression
+var SIGN = jet.SIGN
+
+// LOG calculates natural logarithm of float expression
+var LOG = jet.LOG
+
+// ----------------- Aggregate functions  -------------------//
+
+// AVG is aggregate function used to calculate avg value from numeric expression
+var AVG = jet.AVG
+
+// COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
+var COUNT = jet.COUNT
+
+// MAX is aggregate function. Returns maximum value of expression across all input values
+var MAX = jet.MAX
+
+// MAXi is aggregate function. Returns maximum value of int expression across all input values
+var MAXi = jet.MAXi
+
+// MAXf is aggregate function. Returns maximum value of float expression across all input values
+var MAXf = jet.MAXf
+
+// MIN is aggregate function. Returns minimum value of int expression across all input values
+var MIN = jet.MIN
+
+// MINi is aggregate function. Returns minimum value of int expression across all input values
+var MINi = jet.MINi
+
+// MINf is aggregate function. Returns minimum value of float expression across all input values
+var MINf = jet.MINf
+
+// SUM is aggregate function. Returns sum of all expressions
+var SUM = jet.SUM
+
+// SUMi is aggregate function. Returns sum of integer expression.
+var SUMi = jet.SUMi
+
+// SUMf is aggregate function. Returns sum of float expression.
+var SUMf = jet.SUMf
+
+// -------------------- Window functions -----------------------//
+
+// ROW_NUMBER returns number of the current row within its partition, counting from 1
+var ROW_NUMBER = jet.ROW_NUMBER
+
+// RANK of the current row with gaps; same as row_number of its first peer
+var RANK = jet.RANK
+
+// DENSE_RANK returns rank of the current row without gaps; this function counts peer groups
+var DENSE_RANK = jet.DENSE_RANK
+
+// PERCENT_RANK calculates relative rank of the current row: (rank - 1) / (total partition rows - 1)
+var PERCENT_RANK = jet.PERCENT_RANK
+
+// CUME_DIST calculates cumulative distribution: (number of partition rows preceding or peer with current row) / total partition rows
+var CUME_DIST = jet.CUME_DIST
+
+// NTILE returns integer ranging from 1 to the argument value, dividing the partition as equally as possible
+var NTILE = jet.NTILE
+
+// LAG returns value evaluated at the row that is offset rows before the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+// Both offset and default are evaluated with respect to the current row.
+// If omitted, offset defaults to 1 and default to null
+var LAG = jet.LAG
+
+// LEAD returns value evaluated at the row that is offset rows after the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+// Both offset and default are evaluated with respect to the current row.
+// If omitted, offset defaults to 1 and default to null
+var LEAD = jet.LEAD
+
+// FIRST_VALUE returns value evaluated at the row that is the first row of the window frame
+var FIRST_VALUE = jet.FIRST_VALUE
+
+// LAST_VALUE returns value evaluated at the row that is the last row of the window frame
+var LAST_VALUE = jet.LAST_VALUE
+
+// NTH_VALUE returns value evaluated at the row that is the nth row of the window frame (counting from 1); null if no such row
+var NTH_VALUE = jet.NTH_VALUE
+
+//--------------------- String functions ------------------//
+
+// LOWER returns string expression in lower case
+var LOWER = jet.LOWER
+
+// UPPER returns string expression in upper case
+var UPPER = jet.UPPER
+
+// LTRIM removes the longest string containing only characters
+// from characters (a space by default) from the start of string
+var LTRIM = jet.LTRIM
+
+// RTRIM removes the longest string containing only characters
+// from characters (a space by default) from the end of string
+var RTRIM = jet.RTRIM
+
+// CONCAT adds two or more expressions together
+var CONCAT = jet.CONCAT
+
+// LEN returns number of characters in string expression, excluding trailing spaces
+func LEN(str StringExpression) IntegerExpression {
+	return IntExp(jet.Func("LEN", str))
+}
+
+// SUBSTRING extracts a substring of length characters starting from start position (1 based)
+func SUBSTRING(str StringExpression, start, length IntegerExpression) StringExpression {
+	return jet.NewStringFunc("SUBSTRING", str, start, length)
+}
+
+// REPLACE replaces all occurrences in string of substring from with substring to
+var REPLACE = jet.REPLACE
+
+// REVERSE returns reversed string.
+var REVERSE = jet.REVERSE
+
+//----------------- Date/Time Functions and Operators ------------//
+
+// CURRENT_TIMESTAMP returns current database system timestamp
+func CURRENT_TIMESTAMP() DateTimeExpression {
+	return DateTimeExp(jet.CURRENT_TIMESTAMP())
+}
+
+// GETDATE returns current database system timestamp as DATETIME value
+func GETDATE() DateTimeExpression {
+	return jet.NewTimestampFunc("GETDATE")
+}
+
+// SYSDATETIME returns current database system timestamp as DATETIME2 value
+func SYSDATETIME() DateTimeExpression {
+	return jet.NewTimestampFunc("SYSDATETIME")
+}
+
+// DATEADD returns date expression with number of dateparts (year, month, day, hour, ...) added to it
+func DATEADD(datepart string, number IntegerExpression, date Expression) DateTimeExpression {
+	return jet.NewTimestampFunc("DATEADD", jet.Raw(datepart), number, date)
+}
+
+// DATEDIFF returns number of datepart (year, month, day, hour, ...) boundaries crossed between start and end date
+func DATEDIFF(datepart string, startDate, endDate Expression) IntegerExpression {
+	return IntExp(jet.Func("DATEDIFF", jet.Raw(datepart), startDate, endDate))
+}
+
+//----------- Comparison operators ---------------//
+
+// EXISTS checks for existence of the rows in subQuery
+var EXISTS = jet.EXISTS
+
+// OVERLAPS_PERIOD returns true if half-open period [start, end) overlaps half-open period [from, to). Period start
+// or end NULL value is unbounded, and nil from or to is unbounded as well.
+var OVERLAPS_PERIOD = jet.OVERLAPS_PERIOD
+
+// CASE create CASE operator with optional list of expressions
+var CASE = jet.CASE
+
+//----------- Conditional expressions ---------------//
+
+// COALESCE function returns the first of its arguments that is not null.
+var COALESCE = jet.COALESCE
+
+// NULLIF function returns a null value if value1 equals value2; otherwise it returns value1.
+var NULLIF = jet.NULLIF
+
+// ISNULL function returns replacement if expression is null; otherwise it returns expression.
+func ISNULL(expression, replacement Expression) Expression {
+	return jet.Func("ISNULL", expression, replacement)
+}
+
+// NEWID creates new unique value of uniqueidentifier type
+func NEWID() StringExpression {
+	return jet.NewStringFunc("NEWID")
+}
@@ -0,0 +1,28 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestInsertOutput(t *testing.T) {
+	stmt := table1.INSERT(table1ColInt, table1ColString).
+		VALUES(1, "one").
+		VALUES(2, "two").
+		OUTPUT(table1Col1, table1ColString)
+
+	assertStatementSql(t, stmt, `
+INSERT INTO dbo.table1 (col_int, col_string)
+OUTPUT INSERTED.col1 AS [table1.col1],
+       INSERTED.col_string AS [table1.col_string]
+VALUES (@p1, @p2),
+       (@p3, @p4);
+`, 1, "one", 2, "two")
+}
+
+func TestInsertDefaultValues(t *testing.T) {
+	assertStatementSql(t, table1.INSERT().OUTPUT(table1Col1).DEFAULT_VALUES(), `
+INSERT INTO dbo.table1
+OUTPUT INSERTED.col1 AS [table1.col1]
+DEFAULT VALUES;
+`)
+}
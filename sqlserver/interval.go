@@ -0,0 +1,47 @@
+package sqlserver
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// Interval is representation of relative interval, which can be added to or subtracted from date and time
+// expressions. For example: GETDATE().SUB(Days(30)) is serialized as DATEADD(DAY, -30, GETDATE())
+type Interval = jet.Interval
+
+// Years creates interval of years
+func Years(years int64) Interval {
+	return jet.NewRelativeInterval(years, "YEAR")
+}
+
+// Months creates interval of months
+func Months(months int64) Interval {
+	return jet.NewRelativeInterval(months, "MONTH")
+}
+
+// Weeks creates interval of weeks
+func Weeks(weeks int64) Interval {
+	return jet.NewRelativeInterval(weeks, "WEEK")
+}
+
+// Days creates interval of days
+func Days(days int64) Interval {
+	return jet.NewRelativeInterval(days, "DAY")
+}
+
+// Hours creates interval of hours
+func Hours(hours int64) Interval {
+	return jet.NewRelativeInterval(hours, "HOUR")
+}
+
+// Minutes creates interval of minutes
+func Minutes(minutes int64) Interval {
+	return jet.NewRelativeInterval(minutes, "MINUTE")
+}
+
+// Seconds creates interval of seconds
+func Seconds(seconds int64) Interval {
+	return jet.NewRelativeInterval(seconds, "SECOND")
+}
+
+// sqlserverDateArithmetic adds interval to date with DATEADD function
+func sqlserverDateArithmetic(date Expression, interval jet.RelativeInterval) jet.Serializer {
+	return DATEADD(interval.Unit, Int(interval.Quantity), date)
+}
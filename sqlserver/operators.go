@@ -0,0 +1,22 @@
+package sqlserver
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// NOT returns negation of bool expression result
+var NOT = jet.NOT
+
+// BIT_NOT inverts every bit in integer expression result
+var BIT_NOT = jet.BIT_NOT
+
+// DISTINCT operator can be used to return distinct values of expr
+var DISTINCT = jet.DISTINCT
+
+// NullSafeEq returns true if lhs and rhs are equal, treating two NULL values as equal and NULL value as not equal to
+// any non NULL value
+var NullSafeEq = jet.NullSafeEq
+
+// NULLS_FIRST sorts NULL values of order by clause before non NULL values
+var NULLS_FIRST = jet.NULLS_FIRST
+
+// NULLS_LAST sorts NULL values of order by clause after non NULL values
+var NULLS_LAST = jet.NULLS_LAST
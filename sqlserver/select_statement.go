@@ -0,0 +1,228 @@
+package sqlserver
+
+import (
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// Window function clauses
+var (
+	PARTITION_BY = jet.PARTITION_BY
+	ORDER_BY     = jet.ORDER_BY
+	UNBOUNDED    = jet.UNBOUNDED
+	CURRENT_ROW  = jet.CURRENT_ROW
+)
+
+// PRECEDING window frame clause
+func PRECEDING(offset interface{}) jet.FrameExtent {
+	return jet.PRECEDING(toJetFrameOffset(offset))
+}
+
+// FOLLOWING window frame clause
+func FOLLOWING(offset interface{}) jet.FrameExtent {
+	return jet.FOLLOWING(toJetFrameOffset(offset))
+}
+
+// SelectStatement is interface for SQL Server SELECT statement
+type SelectStatement interface {
+	Statement
+	jet.HasProjections
+	Expression
+
+	DISTINCT() SelectStatement
+	TOP(count int64) SelectStatement
+	FROM(tables ...ReadableTable) SelectStatement
+	WHERE(expression BoolExpression) SelectStatement
+	GROUP_BY(groupByClauses ...GroupByClause) SelectStatement
+	// GROUP_BY_NON_AGGREGATED groups by all the projections referencing columns and not containing aggregate or
+	// window functions, followed by additional groupByClauses. Aliased projections are grouped by their expression.
+	GROUP_BY_NON_AGGREGATED(groupByClauses ...GroupByClause) SelectStatement
+	HAVING(boolExpression BoolExpression) SelectStatement
+	ORDER_BY(orderByClauses ...OrderByClause) SelectStatement
+	// APPLY appends projections, group by and order by lists of the clauses to the statement, and joins where and
+	// having conditions of the clauses with the statement conditions using AND operator
+	APPLY(clauses ...SelectClauses) SelectStatement
+	OFFSET(offset int64) SelectStatement
+	FETCH_NEXT(count int64) SelectStatement
+
+	UNION(rhs SelectStatement) setStatement
+	UNION_ALL(rhs SelectStatement) setStatement
+	INTERSECT(rhs SelectStatement) setStatement
+	EXCEPT(rhs SelectStatement) setStatement
+
+	AsTable(alias string) SelectTable
+}
+
+//SELECT creates new SelectStatement with list of projections
+func SELECT(projection Projection, projections ...Projection) SelectStatement {
+	return newSelectStatement(nil, append([]Projection{projection}, projections...))
+}
+
+func newSelectStatement(table ReadableTable, projections []Projection) SelectStatement {
+	newSelect := &selectStatementImpl{}
+	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect, &newSelect.Select,
+		&newSelect.From, &newSelect.Where, &newSelect.GroupBy, &newSelect.Having, &newSelect.OrderBy, &newSelect.OffsetFetch)
+
+	newSelect.Select.ProjectionList = projections
+	if table != nil {
+		newSelect.From.Tables = []jet.Serializer{table}
+	}
+	newSelect.Select.Top = -1
+	newSelect.OffsetFetch.Offset = -1
+	newSelect.OffsetFetch.Fetch = -1
+
+	newSelect.setOperatorsImpl.parent = newSelect
+
+	return newSelect
+}
+
+type selectStatementImpl struct {
+	jet.ExpressionStatement
+	setOperatorsImpl
+
+	Select      clauseSelect
+	From        jet.ClauseFrom
+	Where       jet.ClauseWhere
+	GroupBy     jet.ClauseGroupBy
+	Having      jet.ClauseHaving
+	OrderBy     jet.ClauseOrderBy
+	OffsetFetch clauseOffsetFetch
+}
+
+func (s *selectStatementImpl) DISTINCT() SelectStatement {
+	s.Select.Distinct = true
+	return s
+}
+
+func (s *selectStatementImpl) TOP(count int64) SelectStatement {
+	s.Select.Top = count
+	return s
+}
+
+func (s *selectStatementImpl) FROM(tables ...ReadableTable) SelectStatement {
+	s.From.Tables = readableTablesToSerializerList(tables)
+	return s
+}
+
+func (s *selectStatementImpl) WHERE(condition BoolExpression) SelectStatement {
+	s.Where.Condition = condition
+	return s
+}
+
+func (s *selectStatementImpl) GROUP_BY(groupByClauses ...GroupByClause) SelectStatement {
+	s.GroupBy.List = groupByClauses
+	s.GroupBy.NonAggregatedProjections = nil
+	return s
+}
+
+func (s *selectStatementImpl) GROUP_BY_NON_AGGREGATED(groupByClauses ...GroupByClause) SelectStatement {
+	s.GroupBy.List = groupByClauses
+	s.GroupBy.NonAggregatedProjections = s.Select.ProjectionList
+	return s
+}
+
+func (s *selectStatementImpl) HAVING(boolExpression BoolExpression) SelectStatement {
+	s.Having.Condition = boolExpression
+	return s
+}
+
+func (s *selectStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) SelectStatement {
+	s.OrderBy.List = orderByClauses
+	return s
+}
+
+func (s *selectStatementImpl) APPLY(clauses ...SelectClauses) SelectStatement {
+	for _, clause := range clauses {
+		clause.ApplyTo(&s.Select.ClauseSelect, &s.Where, &s.GroupBy, &s.Having, &s.OrderBy)
+	}
+	return s
+}
+
+func (s *selectStatementImpl) OFFSET(offset int64) SelectStatement {
+	s.OffsetFetch.Offset = offset
+	return s
+}
+
+func (s *selectStatementImpl) FETCH_NEXT(count int64) SelectStatement {
+	s.OffsetFetch.Fetch = count
+	return s
+}
+
+func (s *selectStatementImpl) AsTable(alias string) SelectTable {
+	return newSelectTable(s, alias)
+}
+
+//-----------------------------------------------------
+
+// clauseSelect is SELECT clause with optional TOP row limit, which in SQL Server precedes projection list
+type clauseSelect struct {
+	jet.ClauseSelect
+	Top int64
+}
+
+func (s *clauseSelect) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	out.NewLine()
+	out.WriteString("SELECT")
+
+	if s.Distinct {
+		out.WriteString("DISTINCT")
+	}
+
+	if s.Top >= 0 {
+		out.WriteString("TOP (")
+		jet.Serialize(jet.Int(s.Top), statementType, out)
+		out.WriteString(")")
+	}
+
+	if len(s.ProjectionList) == 0 {
+		panic("jet: SELECT clause has to have at least one projection")
+	}
+
+	out.WriteProjections(statementType, s.ProjectionList)
+}
+
+// clauseOffsetFetch is SQL Server replacement for LIMIT and OFFSET clauses. OFFSET is mandatory when FETCH NEXT
+// is used, so it is set to 0 if only FETCH NEXT row count is specified.
+type clauseOffsetFetch struct {
+	Offset int64
+	Fetch  int64
+}
+
+func (o *clauseOffsetFetch) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if o.Offset < 0 && o.Fetch < 0 {
+		return
+	}
+
+	offset := o.Offset
+
+	if offset < 0 {
+		offset = 0
+	}
+
+	out.NewLine()
+	out.WriteString("OFFSET")
+	jet.Serialize(jet.Int(offset), statementType, out)
+	out.WriteString("ROWS")
+
+	if o.Fetch >= 0 {
+		out.NewLine()
+		out.WriteString("FETCH NEXT")
+		jet.Serialize(jet.Int(o.Fetch), statementType, out)
+		out.WriteString("ROWS ONLY")
+	}
+}
+
+func toJetFrameOffset(offset interface{}) jet.Serializer {
+	if offset == UNBOUNDED {
+		return jet.UNBOUNDED
+	}
+
+	return jet.FixedLiteral(offset)
+}
+
+func readableTablesToSerializerList(tables []ReadableTable) []jet.Serializer {
+	var ret []jet.Serializer
+	for _, table := range tables {
+		ret = append(ret, table)
+	}
+	return ret
+}
@@ -0,0 +1,73 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestInvalidSelect(t *testing.T) {
+	assertStatementSqlErr(t, SELECT(nil), "jet: Projection is nil")
+}
+
+func TestSelectTop(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt, table2ColStr).DISTINCT().TOP(10).
+		FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+		WHERE(table1ColBool.IS_TRUE()), `
+SELECT DISTINCT TOP (@p1) table1.col_int AS [table1.col_int],
+     table2.col_str AS [table2.col_str]
+FROM dbo.table1
+     INNER JOIN dbo.table2 ON (table1.col_int = table2.col_int)
+WHERE table1.col_bool = 1;
+`, int64(10))
+}
+
+func TestSelectOffsetFetch(t *testing.T) {
+	assertStatementSql(t, table1.SELECT(table1ColInt).ORDER_BY(table1ColInt.DESC()).OFFSET(20).FETCH_NEXT(10), `
+SELECT table1.col_int AS [table1.col_int]
+FROM dbo.table1
+ORDER BY table1.col_int DESC
+OFFSET @p1 ROWS
+FETCH NEXT @p2 ROWS ONLY;
+`, int64(20), int64(10))
+
+	assertStatementSql(t, table1.SELECT(table1ColInt).ORDER_BY(table1ColInt).FETCH_NEXT(5), `
+SELECT table1.col_int AS [table1.col_int]
+FROM dbo.table1
+ORDER BY table1.col_int
+OFFSET @p1 ROWS
+FETCH NEXT @p2 ROWS ONLY;
+`, int64(0), int64(5))
+}
+
+func TestSelectSubQuery(t *testing.T) {
+	subQuery := table1.SELECT(table1ColInt).TOP(1).AsTable("sub")
+
+	assertStatementSql(t, SELECT(table1ColInt.From(subQuery)).FROM(subQuery), `
+SELECT sub.[table1.col_int] AS [table1.col_int]
+FROM (
+          SELECT TOP (@p1) table1.col_int AS [table1.col_int]
+          FROM dbo.table1
+     ) AS sub;
+`, int64(1))
+}
+
+func TestSelectExcept(t *testing.T) {
+	assertStatementSql(t, table1.SELECT(table1ColInt).EXCEPT(table2.SELECT(table2ColInt)), `
+
+SELECT table1.col_int AS [table1.col_int]
+FROM dbo.table1
+
+EXCEPT
+
+SELECT table2.col_int AS [table2.col_int]
+FROM dbo.table2;
+`)
+}
+
+func TestSelectUnboundedRange(t *testing.T) {
+	assertStatementSql(t, table1.SELECT(table1ColInt).
+		WHERE(table1ColTimestamp.IN_RANGE(nil, nil).AND(OVERLAPS_PERIOD(table1ColTimestamp, table1ColTimestamp, nil, nil))), `
+SELECT table1.col_int AS [table1.col_int]
+FROM dbo.table1
+WHERE (1 = 1) AND (1 = 1);
+`)
+}
@@ -0,0 +1,35 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestUpdateOutput(t *testing.T) {
+	stmt := table1.UPDATE().
+		SET(table1ColString.SET(String("new"))).
+		OUTPUT(table1Col1, table1ColString).
+		WHERE(table1Col1.EQ(Int(2)))
+
+	assertStatementSql(t, stmt, `
+UPDATE dbo.table1
+SET col_string = @p1
+OUTPUT INSERTED.col1 AS [table1.col1],
+       INSERTED.col_string AS [table1.col_string]
+WHERE table1.col1 = @p2;
+`, "new", int64(2))
+}
+
+func TestUpdateFrom(t *testing.T) {
+	stmt := table1.UPDATE(table1ColString).
+		SET(table2ColStr).
+		FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+		WHERE(table2Col3.GT(Int(0)))
+
+	assertStatementSql(t, stmt, `
+UPDATE dbo.table1
+SET col_string = table2.col_str
+FROM dbo.table1
+     INNER JOIN dbo.table2 ON (table1.col_int = table2.col_int)
+WHERE table2.col3 > @p1;
+`, int64(0))
+}
@@ -0,0 +1,33 @@
+package sqlserver
+
+import (
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/internal/testutils"
+	"testing"
+)
+
+var table1Col1 = IntegerColumn("col1")
+var table1ColBool = BoolColumn("col_bool")
+var table1ColInt = IntegerColumn("col_int")
+var table1ColFloat = FloatColumn("col_float")
+var table1ColString = StringColumn("col_string")
+var table1ColTimestamp = TimestampColumn("col_timestamp")
+
+var table1 = NewTable("dbo", "table1", "", table1Col1, table1ColInt, table1ColFloat, table1ColString, table1ColBool, table1ColTimestamp)
+
+var table2Col3 = IntegerColumn("col3")
+var table2ColInt = IntegerColumn("col_int")
+var table2ColStr = StringColumn("col_str")
+
+var table2 = NewTable("dbo", "table2", "", table2Col3, table2ColInt, table2ColStr)
+
+func assertSerialize(t *testing.T, clause jet.Serializer, query string, args ...interface{}) {
+	testutils.AssertSerialize(t, Dialect, clause, query, args...)
+}
+
+func assertDebugSerialize(t *testing.T, clause jet.Serializer, query string, args ...interface{}) {
+	testutils.AssertDebugSerialize(t, Dialect, clause, query, args...)
+}
+
+var assertStatementSql = testutils.AssertStatementSql
+var assertStatementSqlErr = testutils.AssertStatementSqlErr
@@ -0,0 +1,71 @@
+package sqlserver
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// CommonTableExpression defines set of interface methods for SQL Server CTEs
+type CommonTableExpression interface {
+	SelectTable
+
+	AS(statement jet.SerializerStatement) CommonTableExpression
+	// ALIAS is used to create another alias of the CTE, if a CTE needs to appear multiple times in the main query.
+	ALIAS(alias string) SelectTable
+	// SELF returns references to the CTE columns, to be used in recursive CTE definition, for instance:
+	//
+	//	tree.SELF().Integer("depth").ADD(Int(1))
+	SELF() jet.CTESelf
+
+	internalCTE() *jet.CommonTableExpression
+}
+
+type commonTableExpression struct {
+	readableTableInterfaceImpl
+	jet.CommonTableExpression
+}
+
+// WITH function creates new WITH statement from list of common table expressions.
+// SQL Server does not have RECURSIVE keyword, recursive common table expressions are defined with WITH as well.
+func WITH(cte ...CommonTableExpression) func(statement jet.Statement) Statement {
+	return jet.WITH(Dialect, false, toInternalCTE(cte)...)
+}
+
+// CTE creates new named commonTableExpression
+func CTE(name string, columns ...jet.ColumnExpression) CommonTableExpression {
+	cte := &commonTableExpression{
+		readableTableInterfaceImpl: readableTableInterfaceImpl{},
+		CommonTableExpression:      jet.CTE(name, columns...),
+	}
+
+	cte.parent = cte
+
+	return cte
+}
+
+// AS is used to define a CTE query
+func (c *commonTableExpression) AS(statement jet.SerializerStatement) CommonTableExpression {
+	c.CommonTableExpression.Statement = statement
+	return c
+}
+
+func (c *commonTableExpression) internalCTE() *jet.CommonTableExpression {
+	return &c.CommonTableExpression
+}
+
+// ALIAS is used to create another alias of the CTE, if a CTE needs to appear multiple times in the main query.
+func (c *commonTableExpression) ALIAS(name string) SelectTable {
+	return newSelectTable(c, name)
+}
+
+// SELF returns references to the CTE columns, to be used in recursive CTE definition
+func (c *commonTableExpression) SELF() jet.CTESelf {
+	return jet.NewCTESelf(c)
+}
+
+func toInternalCTE(ctes []CommonTableExpression) []*jet.CommonTableExpression {
+	var ret []*jet.CommonTableExpression
+
+	for _, cte := range ctes {
+		ret = append(ret, cte.internalCTE())
+	}
+
+	return ret
+}
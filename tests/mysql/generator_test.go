@@ -236,6 +236,16 @@ func (a ActorTable) FromSchema(schemaName string) ActorTable {
 	return newActorTable(schemaName, a.TableName(), a.Alias())
 }
 
+// PrimaryKey returns primary key columns of ActorTable
+func (a ActorTable) PrimaryKey() mysql.ColumnList {
+	return mysql.ColumnList{a.ActorID}
+}
+
+// ByPrimaryKey returns condition matching primary key columns with values, listed in the primary key column order
+func (a ActorTable) ByPrimaryKey(values ...mysql.Expression) mysql.BoolExpression {
+	return a.PrimaryKey().EQ(values...)
+}
+
 func newActorTable(schemaName, tableName, alias string) ActorTable {
 	var (
 		ActorIDColumn    = mysql.IntegerColumn("actor_id")
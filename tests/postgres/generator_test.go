@@ -313,6 +313,16 @@ func (a ActorTable) FromSchema(schemaName string) *ActorTable {
 	return newActorTable(schemaName, a.TableName(), a.Alias())
 }
 
+// PrimaryKey returns primary key columns of ActorTable
+func (a actorTable) PrimaryKey() postgres.ColumnList {
+	return postgres.ColumnList{a.ActorID}
+}
+
+// ByPrimaryKey returns condition matching primary key columns with values, listed in the primary key column order
+func (a actorTable) ByPrimaryKey(values ...postgres.Expression) postgres.BoolExpression {
+	return a.PrimaryKey().EQ(values...)
+}
+
 func newActorTable(schemaName, tableName, alias string) *ActorTable {
 	return &ActorTable{
 		actorTable: newActorTableImpl(schemaName, tableName, alias),
@@ -675,8 +685,8 @@ type allTypesTable struct {
 	XML                  postgres.ColumnString
 	JSONPtr              postgres.ColumnString
 	JSON                 postgres.ColumnString
-	JsonbPtr             postgres.ColumnString
-	Jsonb                postgres.ColumnString
+	JsonbPtr             postgres.ColumnJSONB
+	Jsonb                postgres.ColumnJSONB
 	IntegerArrayPtr      postgres.ColumnString
 	IntegerArray         postgres.ColumnString
 	TextArrayPtr         postgres.ColumnString
@@ -766,8 +776,8 @@ func newAllTypesTableImpl(schemaName, tableName, alias string) allTypesTable {
 		XMLColumn                  = postgres.StringColumn("xml")
 		JSONPtrColumn              = postgres.StringColumn("json_ptr")
 		JSONColumn                 = postgres.StringColumn("json")
-		JsonbPtrColumn             = postgres.StringColumn("jsonb_ptr")
-		JsonbColumn                = postgres.StringColumn("jsonb")
+		JsonbPtrColumn             = postgres.JSONBColumn("jsonb_ptr")
+		JsonbColumn                = postgres.JSONBColumn("jsonb")
 		IntegerArrayPtrColumn      = postgres.StringColumn("integer_array_ptr")
 		IntegerArrayColumn         = postgres.StringColumn("integer_array")
 		TextArrayPtrColumn         = postgres.StringColumn("text_array_ptr")
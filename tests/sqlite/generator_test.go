@@ -183,6 +183,16 @@ func (a ActorTable) FromSchema(schemaName string) *ActorTable {
 	return newActorTable(schemaName, a.TableName(), a.Alias())
 }
 
+// PrimaryKey returns primary key columns of ActorTable
+func (a actorTable) PrimaryKey() sqlite.ColumnList {
+	return sqlite.ColumnList{a.ActorID}
+}
+
+// ByPrimaryKey returns condition matching primary key columns with values, listed in the primary key column order
+func (a actorTable) ByPrimaryKey(values ...sqlite.Expression) sqlite.BoolExpression {
+	return a.PrimaryKey().EQ(values...)
+}
+
 func newActorTable(schemaName, tableName, alias string) *ActorTable {
 	return &ActorTable{
 		actorTable: newActorTableImpl(schemaName, tableName, alias),